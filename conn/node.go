@@ -83,6 +83,10 @@ type Node struct {
 
 	heartbeatsOut int64
 	heartbeatsIn  int64
+
+	// unsafe and skewed hold the last errors found by MonitorSafety.
+	unsafe atomic.Value
+	skewed atomic.Value
 }
 
 // NewNode returns a new Node instance.
@@ -90,6 +94,7 @@ func NewNode(rc *pb.RaftContext, store *raftwal.DiskStorage, tlsConfig *tls.Conf
 	snap, err := store.Snapshot()
 	x.Check(err)
 
+	opts := x.WorkerConfig.Raft
 	n := &Node{
 		StartTime: time.Now(),
 		Id:        rc.Id,
@@ -97,10 +102,10 @@ func NewNode(rc *pb.RaftContext, store *raftwal.DiskStorage, tlsConfig *tls.Conf
 		Store:     store,
 		Cfg: &raft.Config{
 			ID:                       rc.Id,
-			ElectionTick:             20, // 2s if we call Tick() every 100 ms.
-			HeartbeatTick:            1,  // 100ms if we call Tick() every 100 ms.
+			ElectionTick:             opts.ElectionTicks,
+			HeartbeatTick:            opts.HeartbeatTicks,
 			Storage:                  store,
-			MaxInflightMsgs:          256,
+			MaxInflightMsgs:          opts.MaxInflightMsgs,
 			MaxSizePerMsg:            256 << 10, // 256 KB should allow more batching.
 			MaxCommittedSizePerReady: 64 << 20,  // Avoid loading entire Raft log into memory.
 			// We don't need lease based reads. They cause issues because they
@@ -85,3 +85,39 @@ func TestProposal(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestCheckQuorum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := raftwal.Init(dir)
+	n := NewNode(&pb.RaftContext{Id: 1}, store, nil)
+	n.SetRaft(raft.StartNode(n.Cfg, []raft.Peer{{ID: n.Id}}))
+	var wg sync.WaitGroup
+	go n.run(&wg)
+
+	for {
+		if _, leader := n.isLeader(); leader {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// A leader alone in its group has a quorum.
+	n.SetConfState(&raftpb.ConfState{Nodes: []uint64{1}})
+	require.NoError(t, n.checkQuorum())
+
+	// It doesn't if it can't reach the two other members of the group.
+	n.SetConfState(&raftpb.ConfState{Nodes: []uint64{1, 2, 3}})
+	n.SetPeer(2, "localhost:1")
+	n.SetPeer(3, "localhost:2")
+	require.Error(t, n.checkQuorum())
+	require.NoError(t, n.checkLeaderTerm())
+
+	require.NoError(t, n.SafetyCheck())
+	n.setSafety(&n.unsafe, n.checkQuorum(), "Unsafe partition")
+	require.Error(t, n.UnsafePartition())
+	require.Error(t, n.SafetyCheck())
+	n.setSafety(&n.unsafe, nil, "Unsafe partition")
+	require.NoError(t, n.SafetyCheck())
+}
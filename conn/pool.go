@@ -31,7 +31,9 @@ import (
 	"go.opencensus.io/plugin/ocgrpc"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -40,6 +42,8 @@ var (
 	// ErrUnhealthyConnection indicates the connection to a node is unhealthy.
 	ErrUnhealthyConnection = errors.New("Unhealthy connection")
 	echoDuration           = 500 * time.Millisecond
+	// rttInterval is how often the round trip time to the node at the other end is measured.
+	rttInterval = time.Minute
 )
 
 // Pool is used to manage the grpc client connection(s) for communicating with other
@@ -54,6 +58,11 @@ type Pool struct {
 	Addr       string
 	closer     *z.Closer
 	healthInfo pb.HealthInfo
+	// clockSkew is how far ahead the clock of the node at the other end was from ours, as of
+	// its last heartbeat.
+	clockSkew time.Duration
+	// rtt is the last round trip time measured to the node at the other end.
+	rtt time.Duration
 }
 
 // Pools manages a concurrency-safe set of Pool.
@@ -228,10 +237,18 @@ func (p *Pool) listenToHeartbeat() error {
 	}
 
 	go func() {
-		select {
-		case <-ctx.Done():
-		case <-p.closer.HasBeenClosed():
-			cancel()
+		ticker := time.NewTicker(rttInterval)
+		defer ticker.Stop()
+		for {
+			p.measureRTT(ctx, c)
+			select {
+			case <-ctx.Done():
+				return
+			case <-p.closer.HasBeenClosed():
+				cancel()
+				return
+			case <-ticker.C:
+			}
 		}
 	}()
 
@@ -246,10 +263,34 @@ func (p *Pool) listenToHeartbeat() error {
 		p.Lock()
 		p.lastEcho = time.Now()
 		p.healthInfo = *res
+		if res.Clock != 0 {
+			// The clock was read when the heartbeat was sent, so it's behind ours by the time
+			// the heartbeat took to arrive, which is about half of the round trip.
+			p.clockSkew = time.Duration(res.Clock-p.lastEcho.UnixNano()) + p.rtt/2
+		}
 		p.Unlock()
 	}
 }
 
+// measureRTT times a call to the node at the other end, as the round trip time that its
+// heartbeats take half of to arrive. Any reply will do, so the call only asks whether an unknown
+// node is a peer.
+func (p *Pool) measureRTT(ctx context.Context, c pb.RaftClient) {
+	ctx, cancel := context.WithTimeout(ctx, 4*echoDuration)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.IsPeer(ctx, &pb.RaftContext{})
+	if ctx.Err() != nil || (err != nil && status.Code(err) == codes.Unavailable) {
+		return
+	}
+	rtt := time.Since(start)
+
+	p.Lock()
+	defer p.Unlock()
+	p.rtt = rtt
+}
+
 // MonitorHealth monitors the health of the connection via Echo. This function blocks forever.
 func (p *Pool) MonitorHealth() {
 	defer p.closer.Done()
@@ -283,6 +324,22 @@ func (p *Pool) IsHealthy() bool {
 	return time.Since(p.lastEcho) < 4*echoDuration
 }
 
+// ProtocolVersion returns the protocol version that the node at the other end sent in its last
+// heartbeat. It's zero if it hasn't sent any, or if it's of an older build that doesn't send it.
+func (p *Pool) ProtocolVersion() uint32 {
+	p.RLock()
+	defer p.RUnlock()
+	return p.healthInfo.ProtocolVersion
+}
+
+// ClockSkew returns how far ahead the clock of the node at the other end is from ours. It's
+// negative if the clock is behind, and zero if the node doesn't send its clock.
+func (p *Pool) ClockSkew() time.Duration {
+	p.RLock()
+	defer p.RUnlock()
+	return p.clockSkew
+}
+
 // HealthInfo returns the healthinfo.
 func (p *Pool) HealthInfo() pb.HealthInfo {
 	p.RLock()
@@ -288,6 +288,8 @@ func (w *RaftServer) Heartbeat(_ *api.Payload, stream pb.Raft_HeartbeatServer) e
 		Group:    strconv.Itoa(int(node.RaftContext.GetGroup())),
 		Version:  x.Version(),
 		Uptime:   int64(time.Since(node.StartTime) / time.Second),
+
+		ProtocolVersion: x.ProtocolVersion,
 	}
 	if info.Group == "0" {
 		info.Instance = "zero"
@@ -297,10 +299,16 @@ func (w *RaftServer) Heartbeat(_ *api.Payload, stream pb.Raft_HeartbeatServer) e
 
 	for {
 		info.Uptime = int64(time.Since(node.StartTime) / time.Second)
+		info.LastAppliedIndex = node.Applied.DoneUntil()
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-ticker.C:
+			if r := node.Raft(); r != nil {
+				st := r.Status()
+				info.RaftTerm, info.RaftLeader = st.Term, st.Lead == node.Id
+			}
+			info.Clock = time.Now().UnixNano()
 			if err := stream.Send(&info); err != nil {
 				return err
 			}
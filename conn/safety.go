@@ -0,0 +1,200 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conn
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/raft"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/ristretto/z"
+)
+
+// safetyError wraps the errors stored in the atomic values of Node, which must all be of the same
+// type, including when there's no error.
+type safetyError struct {
+	err error
+}
+
+// UnsafePartition returns an error if this node is a leader that the rest of its group may have
+// moved on from: it has been out of touch with a quorum of the group for longer than the
+// election timeout, or a peer is the leader of a later term. The node mustn't take writes then,
+// as it's on the side of a partition that could be diverging from the other.
+func (n *Node) UnsafePartition() error {
+	if v, ok := n.unsafe.Load().(safetyError); ok {
+		return v.err
+	}
+	return nil
+}
+
+// SafetyCheck returns UnsafePartition, or else ClockSkew.
+func (n *Node) SafetyCheck() error {
+	if err := n.UnsafePartition(); err != nil {
+		return err
+	}
+	return n.ClockSkew()
+}
+
+// ClockSkew returns an error if the clock of a node this node talks to is off from its own by
+// more than --max_clock_skew. It's only reported, as a restart of this node wouldn't fix it.
+func (n *Node) ClockSkew() error {
+	if v, ok := n.skewed.Load().(safetyError); ok {
+		return v.err
+	}
+	return nil
+}
+
+// MonitorSafety checks every second whether this node is in an unsafe partition, and whether the
+// clocks of the nodes it talks to are skewed, as told by their heartbeats. It logs the problems
+// it finds, which SafetyCheck returns. It runs until closer is signalled.
+func (n *Node) MonitorSafety(closer *z.Closer) {
+	defer closer.Done()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var lostQuorumAt time.Time
+	for {
+		select {
+		case <-closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+		}
+		var unsafe error
+		if err := n.checkQuorum(); err == nil {
+			lostQuorumAt = time.Time{}
+		} else if lostQuorumAt.IsZero() {
+			lostQuorumAt = time.Now()
+		} else if time.Since(lostQuorumAt) > electionTimeout() {
+			unsafe = err
+		}
+		if err := n.checkLeaderTerm(); err != nil {
+			unsafe = err
+		}
+		n.setSafety(&n.unsafe, unsafe, "Unsafe partition")
+		n.setSafety(&n.skewed, checkClockSkew(), "Clock skew")
+	}
+}
+
+func electionTimeout() time.Duration {
+	return time.Duration(x.WorkerConfig.Raft.ElectionTicks) * x.WorkerConfig.Raft.TickInterval
+}
+
+// setSafety stores err in v, and logs when a problem appears or goes away.
+func (n *Node) setSafety(v *atomic.Value, err error, what string) {
+	prev, _ := v.Load().(safetyError)
+	switch {
+	case err != nil && prev.err == nil:
+		glog.Errorf("%s detected at %#x: %v", what, n.Id, err)
+	case err == nil && prev.err != nil:
+		glog.Infof("%s resolved at %#x", what, n.Id)
+	}
+	v.Store(safetyError{err: err})
+}
+
+// voters returns the ids of the members of the group that vote, other than this node.
+func (n *Node) voters() []uint64 {
+	cs := n.ConfState()
+	if cs == nil {
+		return nil
+	}
+	var ids []uint64
+	for _, id := range cs.Nodes {
+		if id != n.Id {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// isLeader returns the Raft status of this node, and whether it leads its group.
+func (n *Node) isLeader() (raft.Status, bool) {
+	r := n.Raft()
+	if r == nil {
+		return raft.Status{}, false
+	}
+	st := r.Status()
+	return st, st.RaftState == raft.StateLeader
+}
+
+// checkQuorum returns an error if this node is the leader, but doesn't get heartbeats from a
+// quorum of its group.
+func (n *Node) checkQuorum() error {
+	if _, leader := n.isLeader(); !leader {
+		return nil
+	}
+	voters := n.voters()
+	reachable := 1 // This node.
+	for _, id := range voters {
+		if addr, ok := n.Peer(id); ok {
+			if pl, err := GetPools().Get(addr); err == nil && pl.IsHealthy() {
+				reachable++
+			}
+		}
+	}
+	if total := len(voters) + 1; reachable <= total/2 {
+		return errors.Errorf("this node is the leader of its group, but it only reaches %d of "+
+			"its %d members", reachable, total)
+	}
+	return nil
+}
+
+// checkLeaderTerm returns an error if this node is the leader, but a peer says that it's the
+// leader of a later term.
+func (n *Node) checkLeaderTerm() error {
+	st, leader := n.isLeader()
+	if !leader {
+		return nil
+	}
+	for _, id := range n.voters() {
+		addr, ok := n.Peer(id)
+		if !ok {
+			continue
+		}
+		pl, err := GetPools().Get(addr)
+		if err != nil || !pl.IsHealthy() {
+			continue
+		}
+		if info := pl.HealthInfo(); info.RaftLeader && info.RaftTerm > st.Term {
+			return errors.Errorf("this node is the leader of term %d, but %#x is the leader of "+
+				"term %d", st.Term, id, info.RaftTerm)
+		}
+	}
+	return nil
+}
+
+// checkClockSkew returns an error if the clock of a node that this node gets heartbeats from is
+// off from its own by more than --max_clock_skew.
+func checkClockSkew() error {
+	max := x.WorkerConfig.MaxClockSkew
+	if max <= 0 {
+		return nil
+	}
+	for _, pl := range GetPools().GetAll() {
+		if !pl.IsHealthy() {
+			continue
+		}
+		if skew := pl.ClockSkew(); skew > max || skew < -max {
+			return errors.Errorf("the clock of %s is off by %s, more than the maximum of %s",
+				pl.Addr, skew, max)
+		}
+	}
+	return nil
+}
@@ -17,12 +17,14 @@
 package alpha
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
 
+	"github.com/dgraph-io/dgraph/graphql/admin"
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/graphql/web"
 
@@ -45,7 +47,7 @@ func hasPoormansAuth(r *http.Request) bool {
 func allowedMethodsHandler(allowedMethods allowedMethods, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if _, ok := allowedMethods[r.Method]; !ok {
-			x.AddCorsHeaders(w)
+			x.AddAdminCorsHeaders(w, r.Header.Get("Origin"))
 			if r.Method == http.MethodOptions {
 				return
 			}
@@ -63,7 +65,7 @@ func allowedMethodsHandler(allowedMethods allowedMethods, next http.Handler) htt
 func adminAuthHandler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !hasPoormansAuth(r) {
-			x.AddCorsHeaders(w)
+			x.AddAdminCorsHeaders(w, r.Header.Get("Origin"))
 			x.SetStatus(w, x.ErrorUnauthorized, "Invalid X-Dgraph-AuthToken")
 			return
 		}
@@ -142,6 +144,7 @@ func exportHandler(w http.ResponseWriter, r *http.Request, adminServer web.IServ
 			response {
 			  code
 			}
+			taskId
 		  }
 		}`,
 		Variables: map[string]interface{}{},
@@ -151,10 +154,26 @@ func exportHandler(w http.ResponseWriter, r *http.Request, adminServer web.IServ
 		x.SetStatus(w, resp.Errors[0].Message, "Export failed.")
 		return
 	}
+	if err := waitForAdminTask(r.Context(), resp, "export"); err != nil {
+		x.SetStatus(w, err.Error(), "Export failed.")
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
 	x.Check2(w.Write([]byte(`{"code": "Success", "message": "Export completed."}`)))
 }
 
+// waitForAdminTask waits for the task started by the admin mutation that resp is the response
+// of, so that the HTTP endpoints only respond once the backup or export is done.
+func waitForAdminTask(ctx context.Context, resp *schema.Response, mutation string) error {
+	var data map[string]struct {
+		TaskId string
+	}
+	if err := json.Unmarshal(resp.Data.Bytes(), &data); err != nil {
+		return err
+	}
+	return admin.WaitForTask(ctx, data[mutation].TaskId)
+}
+
 func memoryLimitHandler(w http.ResponseWriter, r *http.Request, adminServer web.IServeGraphQL) {
 	switch r.Method {
 	case http.MethodGet:
@@ -61,6 +61,8 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"@filter",
 		"@if",
 		"@normalize",
+		"abs",
+		"acos",
 		"after",
 		"allofterms",
 		"alloftext",
@@ -68,12 +70,21 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"anyofterms",
 		"anyoftext",
 		"as",
+		"asin",
+		"atan",
+		"atan2",
 		"avg",
 		"ceil",
+		"concat",
 		"cond",
 		"contains",
+		"cos",
 		"count",
+		"dateadd",
+		"datepart",
+		"datetrunc",
 		"delete",
+		"distance",
 		"eq",
 		"exact",
 		"exp",
@@ -86,16 +97,19 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"gt",
 		"index",
 		"intersects",
+		"jsonpath",
 		"le",
 		"len",
 		"ln",
 		"logbase",
+		"lower",
 		"lt",
 		"math",
 		"max",
 		"min",
 		"mutation",
 		"near",
+		"nearest",
 		"not",
 		"offset",
 		"or",
@@ -107,13 +121,18 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"reverse",
 		"schema",
 		"since",
+		"sin",
 		"set",
 		"sqrt",
+		"substring",
 		"sum",
+		"tan",
 		"term",
 		"tokenizer",
+		"trim",
 		"type",
 		"uid",
+		"upper",
 		"within",
 		"upsert",
 	}
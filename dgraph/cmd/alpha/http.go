@@ -47,6 +47,18 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+const (
+	// bestEffortHeader and readOnlyHeader can be set on a query instead of its be and ro URL
+	// parameters, for clients that can't change the URL they query.
+	bestEffortHeader = "X-Dgraph-BestEffort"
+	readOnlyHeader   = "X-Dgraph-ReadOnly"
+	// queryTimeoutHeader can be set on a query instead of its timeout URL parameter. Like the
+	// parameter, it can only make the query's timeout shorter than --query_timeout.
+	queryTimeoutHeader = "X-Dgraph-Query-Timeout"
+	// quorumHeader can be set on a query instead of its quorum URL parameter.
+	quorumHeader = "X-Dgraph-Quorum"
+)
+
 func allowed(method string) bool {
 	return method == http.MethodPost || method == http.MethodPut
 }
@@ -130,6 +142,25 @@ func parseBool(r *http.Request, name string) (bool, error) {
 	return boolval, nil
 }
 
+// parseBoolOrHeader is like parseBool, but when the URL parameter isn't given it reads the
+// value from the given header of the request instead.
+func parseBoolOrHeader(r *http.Request, name, header string) (bool, error) {
+	if r.URL.Query().Get(name) != "" {
+		return parseBool(r, name)
+	}
+	value := r.Header.Get(header)
+	if value == "" {
+		return false, nil
+	}
+
+	boolval, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.Wrapf(err, "while parsing %s header as bool", header)
+	}
+
+	return boolval, nil
+}
+
 // parseDuration reads the value for given URL parameter from request and
 // parses it into time.Duration, empty string is converted into zero value
 func parseDuration(r *http.Request, name string) (time.Duration, error) {
@@ -146,6 +177,25 @@ func parseDuration(r *http.Request, name string) (time.Duration, error) {
 	return durationValue, nil
 }
 
+// parseDurationOrHeader is like parseDuration, but when the URL parameter isn't given it reads
+// the value from the given header of the request instead.
+func parseDurationOrHeader(r *http.Request, name, header string) (time.Duration, error) {
+	if r.URL.Query().Get(name) != "" {
+		return parseDuration(r, name)
+	}
+	value := r.Header.Get(header)
+	if value == "" {
+		return 0, nil
+	}
+
+	durationValue, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "while parsing %s header as time.Duration", header)
+	}
+
+	return durationValue, nil
+}
+
 // This method should just build the request and proxy it to the Query method of dgraph.Server.
 // It can then encode the response as appropriate before sending it back to the user.
 func queryHandler(w http.ResponseWriter, r *http.Request) {
@@ -158,7 +208,12 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
-	queryTimeout, err := parseDuration(r, "timeout")
+	isExplain, err := parseBool(r, "explain")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	queryTimeout, err := parseDurationOrHeader(r, "timeout", queryTimeoutHeader)
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
@@ -168,6 +223,11 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	isQuorum, err := parseBoolOrHeader(r, "quorum", quorumHeader)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 
 	body := readRequest(w, r)
 	if body == nil {
@@ -206,6 +266,8 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := context.WithValue(r.Context(), query.DebugKey, isDebugMode)
+	ctx = context.WithValue(ctx, query.ExplainKey, isExplain)
+	ctx = context.WithValue(ctx, query.QuorumKey, isQuorum)
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
 
@@ -223,7 +285,7 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 
 	if req.StartTs == 0 {
 		// If be is set, run this as a best-effort query.
-		isBestEffort, err := parseBool(r, "be")
+		isBestEffort, err := parseBoolOrHeader(r, "be", bestEffortHeader)
 		if err != nil {
 			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 			return
@@ -234,7 +296,7 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// If ro is set, run this as a readonly query.
-		isReadOnly, err := parseBool(r, "ro")
+		isReadOnly, err := parseBoolOrHeader(r, "ro", readOnlyHeader)
 		if err != nil {
 			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 			return
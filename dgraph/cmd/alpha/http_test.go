@@ -653,6 +653,43 @@ func TestAlterAllFieldsShouldBeSet(t *testing.T) {
 	require.Equal(t, qr.Errors[0].Extensions["code"], "Error")
 }
 
+func TestParseBoolOrHeader(t *testing.T) {
+	req := httptest.NewRequest("POST", "/query?be=false", nil)
+	req.Header.Set(bestEffortHeader, "true")
+	req.Header.Set(readOnlyHeader, "true")
+	be, err := parseBoolOrHeader(req, "be", bestEffortHeader)
+	require.NoError(t, err)
+	require.False(t, be)
+	ro, err := parseBoolOrHeader(req, "ro", readOnlyHeader)
+	require.NoError(t, err)
+	require.True(t, ro)
+
+	req = httptest.NewRequest("POST", "/query", nil)
+	req.Header.Set(bestEffortHeader, "maybe")
+	_, err = parseBoolOrHeader(req, "be", bestEffortHeader)
+	require.EqualError(t, err, `while parsing X-Dgraph-BestEffort header as bool: `+
+		`strconv.ParseBool: parsing "maybe": invalid syntax`)
+}
+
+func TestParseDurationOrHeader(t *testing.T) {
+	req := httptest.NewRequest("POST", "/query?timeout=2s", nil)
+	req.Header.Set(queryTimeoutHeader, "500ms")
+	timeout, err := parseDurationOrHeader(req, "timeout", queryTimeoutHeader)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, timeout)
+
+	req = httptest.NewRequest("POST", "/query", nil)
+	req.Header.Set(queryTimeoutHeader, "500ms")
+	timeout, err = parseDurationOrHeader(req, "timeout", queryTimeoutHeader)
+	require.NoError(t, err)
+	require.Equal(t, 500*time.Millisecond, timeout)
+
+	req.Header.Set(queryTimeoutHeader, "soon")
+	_, err = parseDurationOrHeader(req, "timeout", queryTimeoutHeader)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "while parsing X-Dgraph-Query-Timeout header as time.Duration")
+}
+
 func TestHttpCompressionSupport(t *testing.T) {
 	require.NoError(t, dropAll())
 	require.NoError(t, alterSchema(`name: string .`))
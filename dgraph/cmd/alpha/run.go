@@ -106,13 +106,37 @@ they form a Raft group and provide synchronous replication.
 	flag.String("tmp", "t", "Directory to store temporary buffers.")
 
 	// Options around how to set up Badger.
+	flag.String("badger.mode", "disk",
+		"[disk, memory] Where to keep the postings and the write-ahead log. memory keeps them"+
+			" in memory only, without writing anything to the postings and WAL directories,"+
+			" so that they're lost when the alpha exits. It's meant for tests.")
 	flag.String("badger.compression", "snappy",
 		"[none, zstd:level, snappy] Specifies the compression algorithm and the compression"+
 			"level (if applicable) for the postings directory. none would disable compression,"+
 			" while zstd:1 would set zstd compression at level 1.")
+	flag.Int("badger.block_size", 4<<10,
+		"Size in bytes of the blocks in the tables of the postings directory. Larger blocks"+
+			" compress better, but each read decompresses a whole block.")
+	flag.Float64("badger.bloom_false_positive", 0.01,
+		"False positive rate of the bloom filters of the tables in the postings directory."+
+			" A lower rate makes the filters larger but saves reads of keys that aren't there."+
+			" 0 disables the bloom filters.")
 	enc.RegisterFlags(flag)
 
+	// Options around rollups of posting lists.
+	flag.Int("rollup.batch_size", 16,
+		"Number of keys read since their last rollup that are batched before they're rolled"+
+			" up.")
+	flag.Duration("rollup.interval", 10*time.Second,
+		"Least time between two rollups of the same key. A longer interval writes less on"+
+			" predicates with many mutations, but leaves more deltas to be read until then.")
+	flag.Duration("rollup.throttle", 100*time.Millisecond,
+		"Least time between two batches of rollups.")
+
 	// Snapshot and Transactions.
+	flag.Float64("snapshot_bandwidth", 0,
+		"The most MB per second that this alpha sends snapshots at, to all the followers that "+
+			"are rebuilding their data from it. 0 means no limit.")
 	flag.Int("snapshot_after", 10000,
 		"Create a new Raft snapshot after this many number of Raft entries. The"+
 			" lower this number, the more frequent snapshot creation would be."+
@@ -129,10 +153,26 @@ they form a Raft group and provide synchronous replication.
 	flag.String("export", "export", "Folder in which to store exports.")
 	flag.Int("pending_proposals", 256,
 		"Number of pending mutation proposals. Useful for rate limiting.")
+	flag.Int("queued_proposals", 0,
+		"The most mutation proposals that can wait for one of the --pending_proposals slots. "+
+			"Mutations beyond this are rejected with a retryable error. 0 means no limit.")
+	flag.Int64("max_apply_backlog", 0,
+		"The most MB of committed Raft entries that can wait to be applied, beyond which new "+
+			"mutations are rejected with a retryable error. 0 means no limit.")
+	flag.Int("proposal_batch_size", 0,
+		"The most bytes of small mutations that are batched into one Raft proposal, like 65536. "+
+			"It raises the write throughput when there are many small transactions. All the "+
+			"alphas of a group must run a version that supports it. 0 disables batching.")
+	flag.Duration("proposal_batch_latency", time.Millisecond,
+		"The longest a mutation waits for others to be batched with, when "+
+			"--proposal_batch_size is set.")
 	flag.StringP("zero", "z", fmt.Sprintf("localhost:%d", x.PortZeroGrpc),
 		"Comma separated list of Dgraph zero addresses of the form IP_ADDRESS:PORT.")
 	flag.Uint64("idx", 0,
 		"Optional Raft ID that this Dgraph Alpha will use to join RAFT groups.")
+	flag.String("zone", "",
+		"Optional failure domain of this Dgraph Alpha, like a zone or a rack. Zero won't put "+
+			"a quorum of the replicas of a group in the same zone.")
 	flag.Int("max_retries", -1,
 		"Commits to disk will give up after these number of retries to prevent locking the worker"+
 			" in a failed state. Use -1 to retry infinitely.")
@@ -166,6 +206,13 @@ they form a Raft group and provide synchronous replication.
 			"normalize directive.")
 	flag.Uint64("mutations_nquad_limit", 1e6,
 		"Limit for the maximum number of nquads that can be inserted in a mutation request")
+	flag.Duration("query_timeout", 0,
+		"Maximum time a query can run for, after which it's cancelled. Queries can ask for "+
+			"less with the timeout parameter or the X-Dgraph-Query-Timeout header over HTTP. "+
+			"0 means no limit.")
+	flag.Int64("max_pending_queries", 0,
+		"Maximum number of queries that can be pending at once. Queries beyond this are "+
+			"rejected. 0 means no limit.")
 
 	//Custom plugins.
 	flag.String("custom_tokenizers", "",
@@ -175,6 +222,11 @@ they form a Raft group and provide synchronous replication.
 	grpc.EnableTracing = false
 
 	flag.Bool("graphql_introspection", true, "Set to false for no GraphQL schema introspection")
+	flag.Bool("graphql_playground", false,
+		"Serve a GraphiQL IDE for /graphql at --graphql_playground_path. The IDE loads its "+
+			"scripts from cdn.jsdelivr.net into the browser, so only set it where that's trusted.")
+	flag.String("graphql_playground_path", "/graphql/playground",
+		"Path the GraphiQL IDE for /graphql is served at, when --graphql_playground is set.")
 	flag.Bool("graphql_debug", false, "Enable debug mode in GraphQL. This returns auth errors to clients. We do not recommend turning it on for production.")
 
 	// Ludicrous mode
@@ -185,6 +237,40 @@ they form a Raft group and provide synchronous replication.
 	flag.Duration("graphql_poll_interval", time.Second, "polling interval for graphql subscription.")
 	flag.String("graphql_lambda_url", "",
 		"URL of lambda server that implements custom GraphQL JavaScript resolvers")
+	flag.String("graphql_schema_webhooks", "",
+		"Comma separated list of URLs that are sent the old and new GraphQL schemas, and the "+
+			"diff between them, whenever the GraphQL schema is updated.")
+	flag.Int("graphql_max_depth", 0,
+		"Maximum depth of fields allowed in a GraphQL query. 0 means no limit.")
+	flag.Int("graphql_max_root_fields", 0,
+		"Maximum number of root fields allowed in a GraphQL request. 0 means no limit.")
+	flag.Uint64("graphql_max_cost", 0,
+		"Maximum estimated cost of a GraphQL query, where a list field without first counts "+
+			"as 100 nodes. 0 means no limit.")
+	flag.Duration("graphql_timeout", 0,
+		"Maximum time a request to /graphql can take, after which its queries and mutations "+
+			"are cancelled. Requests can ask for less with the X-Dgraph-Timeout header. "+
+			"0 means no limit.")
+	flag.Duration("graphql_slow_query_threshold", 0,
+		"GraphQL requests that take longer than this are logged, with their variables "+
+			"redacted, the DQL they ran and how long each phase took. 0 means no logging.")
+	flag.Bool("graphql_slow_query_log_literals", false,
+		"Log the strings and numbers in the query and DQL of slow GraphQL requests, which can "+
+			"hold the values of their variables. They're redacted otherwise.")
+	flag.Float64("graphql_rate_limit", 0,
+		"Maximum number of requests per second each client can send to /graphql. Clients are "+
+			"told by their JWT, or their IP if they don't send one. 0 means no limit.")
+	flag.Int("graphql_rate_burst", 0,
+		"Number of requests a client can send to /graphql at once, before graphql_rate_limit "+
+			"applies. 0 means graphql_rate_limit rounded up.")
+	flag.String("admin_cors_origins", "",
+		"Comma separated list of origins allowed to make CORS requests to /admin. "+
+			"If empty, any origin is allowed.")
+	flag.String("cors_allowed_methods", "POST, OPTIONS",
+		"Methods that CORS requests to /graphql and /admin are allowed to use.")
+	flag.String("cors_allowed_headers", x.AccessControlAllowedHeaders,
+		"Headers that CORS requests to /graphql and /admin are allowed to send. Headers "+
+			"forwarded by @custom fields in the GraphQL schema are always allowed as well.")
 
 	// Cache flags
 	flag.String("cache_percentage", "0,65,35,0",
@@ -308,7 +394,12 @@ func healthCheck(w http.ResponseWriter, r *http.Request) {
 
 	_, ok := r.URL.Query()["live"]
 	if !ok {
-		if err := x.HealthCheck(); err != nil {
+		err := x.HealthCheck()
+		if err == nil {
+			// A skewed clock is only reported by /health?all, as a restart wouldn't fix it.
+			err = worker.UnsafePartition()
+		}
+		if err != nil {
 			w.WriteHeader(http.StatusServiceUnavailable)
 			_, err = w.Write([]byte(err.Error()))
 			if err != nil {
@@ -452,6 +543,10 @@ func setupServer(closer *z.Closer) {
 	// Do not use := notation here because adminServer is a global variable.
 	mainServer, adminServer, gqlHealthStore = admin.NewServers(introspection, &globalEpoch, closer)
 	http.Handle("/graphql", mainServer.HTTPHandler())
+	if Alpha.Conf.GetBool("graphql_playground") {
+		http.Handle(Alpha.Conf.GetString("graphql_playground_path"),
+			web.PlaygroundHandler("/graphql"))
+	}
 	http.HandleFunc("/probe/graphql", func(w http.ResponseWriter, r *http.Request) {
 		healthStatus := gqlHealthStore.GetHealth()
 		httpStatusCode := http.StatusOK
@@ -585,15 +680,22 @@ func run() {
 	walCache := (cachePercent[3] * (totalCache << 20)) / 100
 
 	ctype, clevel := x.ParseCompression(Alpha.Conf.GetString("badger.compression"))
+	blockSize := Alpha.Conf.GetInt("badger.block_size")
+	x.AssertTruef(blockSize > 0, "ERROR: badger.block_size must be greater than zero")
+	bloomFalsePositive := Alpha.Conf.GetFloat64("badger.bloom_false_positive")
+	x.AssertTruef(bloomFalsePositive >= 0 && bloomFalsePositive < 1,
+		"ERROR: badger.bloom_false_positive must be at least 0 and less than 1")
 	opts := worker.Options{
-		PostingDir:                 Alpha.Conf.GetString("postings"),
-		WALDir:                     Alpha.Conf.GetString("wal"),
-		PostingDirCompression:      ctype,
-		PostingDirCompressionLevel: clevel,
-		CachePercentage:            cachePercentage,
-		PBlockCacheSize:            pstoreBlockCacheSize,
-		PIndexCacheSize:            pstoreIndexCacheSize,
-		WalCache:                   walCache,
+		PostingDir:                   Alpha.Conf.GetString("postings"),
+		WALDir:                       Alpha.Conf.GetString("wal"),
+		PostingDirCompression:        ctype,
+		PostingDirCompressionLevel:   clevel,
+		PostingDirBlockSize:          blockSize,
+		PostingDirBloomFalsePositive: bloomFalsePositive,
+		CachePercentage:              cachePercentage,
+		PBlockCacheSize:              pstoreBlockCacheSize,
+		PIndexCacheSize:              pstoreIndexCacheSize,
+		WalCache:                     walCache,
 
 		MutationsMode: worker.AllowMutations,
 		AuthToken:     Alpha.Conf.GetString("auth_token"),
@@ -630,6 +732,14 @@ func run() {
 
 	worker.SetConfiguration(&opts)
 
+	posting.Config.RollupBatchSize = Alpha.Conf.GetInt("rollup.batch_size")
+	x.AssertTruef(posting.Config.RollupBatchSize > 0,
+		"ERROR: rollup.batch_size must be greater than zero")
+	posting.Config.RollupInterval = Alpha.Conf.GetDuration("rollup.interval")
+	posting.Config.RollupThrottle = Alpha.Conf.GetDuration("rollup.throttle")
+	x.AssertTruef(posting.Config.RollupThrottle > 0,
+		"ERROR: rollup.throttle must be greater than zero")
+
 	ips, err := getIPsFromString(Alpha.Conf.GetString("whitelist"))
 	x.Check(err)
 
@@ -643,21 +753,30 @@ func run() {
 
 	x.WorkerConfig = x.WorkerOptions{
 		TmpDir:               Alpha.Conf.GetString("tmp"),
+		InMemory:             x.ParseStorageMode(Alpha.Conf.GetString("badger.mode")),
 		ExportPath:           Alpha.Conf.GetString("export"),
 		NumPendingProposals:  Alpha.Conf.GetInt("pending_proposals"),
+		QueuedProposals:      Alpha.Conf.GetInt("queued_proposals"),
+		MaxApplyBacklog:      Alpha.Conf.GetInt64("max_apply_backlog") << 20,
+		ProposalBatchSize:    Alpha.Conf.GetInt("proposal_batch_size"),
+		ProposalBatchLatency: Alpha.Conf.GetDuration("proposal_batch_latency"),
 		ZeroAddr:             strings.Split(Alpha.Conf.GetString("zero"), ","),
 		RaftId:               cast.ToUint64(Alpha.Conf.GetString("idx")),
+		Zone:                 Alpha.Conf.GetString("zone"),
 		WhiteListedIPRanges:  ips,
 		MaxRetries:           Alpha.Conf.GetInt("max_retries"),
 		StrictMutations:      opts.MutationsMode == worker.StrictMutations,
 		AclEnabled:           secretFile != "",
 		SnapshotAfter:        Alpha.Conf.GetInt("snapshot_after"),
+		SnapshotBandwidth:    Alpha.Conf.GetFloat64("snapshot_bandwidth") * (1 << 20),
 		AbortOlderThan:       abortDur,
 		StartTime:            startTime,
 		LudicrousMode:        Alpha.Conf.GetBool("ludicrous_mode"),
 		LudicrousConcurrency: Alpha.Conf.GetInt("ludicrous_concurrency"),
 		TLSClientConfig:      tlsClientConf,
 		TLSServerConfig:      tlsServerConf,
+		QueryTimeout:         int64(Alpha.Conf.GetDuration("query_timeout")),
+		MaxPendingQueries:    Alpha.Conf.GetInt64("max_pending_queries"),
 	}
 	x.WorkerConfig.Parse(Alpha.Conf)
 
@@ -668,6 +787,9 @@ func run() {
 		glog.Infof("unable to read key %v", err)
 		return
 	}
+	if x.WorkerConfig.InMemory && len(x.WorkerConfig.EncryptionKey) > 0 {
+		glog.Fatalf("ERROR: encryption can't be used with badger.mode=memory")
+	}
 
 	setupCustomTokenizers()
 	x.Init()
@@ -679,6 +801,25 @@ func run() {
 	x.Config.GraphqlExtension = Alpha.Conf.GetBool("graphql_extensions")
 	x.Config.GraphqlDebug = Alpha.Conf.GetBool("graphql_debug")
 	x.Config.GraphqlLambdaUrl = Alpha.Conf.GetString("graphql_lambda_url")
+	x.Config.GraphqlMaxDepth = Alpha.Conf.GetInt("graphql_max_depth")
+	x.Config.GraphqlMaxRootFields = Alpha.Conf.GetInt("graphql_max_root_fields")
+	x.Config.GraphqlMaxCost = Alpha.Conf.GetUint64("graphql_max_cost")
+	x.Config.GraphqlTimeout = Alpha.Conf.GetDuration("graphql_timeout")
+	x.Config.GraphqlSlowQueryThreshold = Alpha.Conf.GetDuration("graphql_slow_query_threshold")
+	x.Config.GraphqlSlowQueryLogLiterals = Alpha.Conf.GetBool("graphql_slow_query_log_literals")
+	x.Config.GraphqlRateLimit = Alpha.Conf.GetFloat64("graphql_rate_limit")
+	x.Config.GraphqlRateBurst = Alpha.Conf.GetInt("graphql_rate_burst")
+	x.Config.CorsAllowedMethods = Alpha.Conf.GetString("cors_allowed_methods")
+	x.Config.CorsAllowedHeaders = Alpha.Conf.GetString("cors_allowed_headers")
+	for _, origin := range strings.Split(Alpha.Conf.GetString("admin_cors_origins"), ",") {
+		if origin = strings.TrimSpace(origin); origin == "" {
+			continue
+		}
+		if x.Config.AdminCorsOrigins == nil {
+			x.Config.AdminCorsOrigins = make(map[string]struct{})
+		}
+		x.Config.AdminCorsOrigins[origin] = struct{}{}
+	}
 	if x.Config.GraphqlLambdaUrl != "" {
 		graphqlLambdaUrl, err := url.Parse(x.Config.GraphqlLambdaUrl)
 		if err != nil {
@@ -691,6 +832,29 @@ func run() {
 			return
 		}
 	}
+	for _, webhook := range strings.Split(Alpha.Conf.GetString("graphql_schema_webhooks"), ",") {
+		if webhook = strings.TrimSpace(webhook); webhook == "" {
+			continue
+		}
+		webhookUrl, err := url.Parse(webhook)
+		if err != nil {
+			glog.Errorf("unable to parse graphql_schema_webhooks: %v", err)
+			return
+		}
+		if !webhookUrl.IsAbs() {
+			glog.Errorf("expecting graphql_schema_webhooks to be absolute URLs, got: %s",
+				webhookUrl.String())
+			return
+		}
+		x.Config.GraphqlSchemaWebhooks = append(x.Config.GraphqlSchemaWebhooks, webhook)
+	}
+	playground := Alpha.Conf.GetString("graphql_playground_path")
+	if Alpha.Conf.GetBool("graphql_playground") && (!strings.HasPrefix(playground, "/") ||
+		playground == "/graphql" || playground == "/admin") {
+		glog.Errorf("expecting graphql_playground_path to be a path starting with / other "+
+			"than /graphql and /admin, got: %s", playground)
+		return
+	}
 
 	x.PrintVersion()
 	glog.Infof("x.Config: %+v", x.Config)
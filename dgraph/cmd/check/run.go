@@ -0,0 +1,293 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Check is the sub-command invoked when calling "dgraph check".
+var Check x.SubCommand
+
+type options struct {
+	pdirs     []string
+	wdirs     []string
+	predicate string
+	readTs    uint64
+	key       x.SensitiveByteSlice
+}
+
+var opt options
+
+func init() {
+	Check.Cmd = &cobra.Command{
+		Use:   "check",
+		Short: "Check the consistency of the data of stopped Dgraph alphas",
+		Long: `
+Check reads the postings and WAL directories of alphas that have been stopped, and reports the
+problems it finds in them:
+
+* Data lists that can't be read, like split lists with a missing part.
+* Index and reverse keys that are missing uids that the data needs, or that have stale ones.
+* WAL entries that don't follow each other, or a hard state or checkpoint past the last entry.
+* Predicates whose data differs between the postings directories given, which should be the
+  ones of the replicas of a group.
+
+It exits with status 1 if there's a problem.
+`,
+		Example: `
+$ dgraph check -p alpha1/p,alpha2/p,alpha3/p -w alpha1/w,alpha2/w,alpha3/w
+`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := run(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	Check.EnvPrefix = "DGRAPH_CHECK"
+
+	flag := Check.Cmd.Flags()
+	flag.StringSliceVarP(&opt.pdirs, "postings", "p", nil,
+		"Comma separated list of the postings directories to check. If there are several, "+
+			"they must be the ones of the replicas of a group.")
+	flag.StringSliceVarP(&opt.wdirs, "wal", "w", nil,
+		"Comma separated list of the WAL directories to check.")
+	flag.StringVarP(&opt.predicate, "pred", "r", "", "Only check the given predicate.")
+	flag.Uint64Var(&opt.readTs, "at", 0, "The timestamp to read the data at. It defaults to "+
+		"the latest one that all the postings directories have.")
+	enc.RegisterFlags(flag)
+}
+
+func run() error {
+	var err error
+	if opt.key, err = enc.ReadKey(Check.Conf); err != nil {
+		return err
+	}
+	if len(opt.pdirs) == 0 && len(opt.wdirs) == 0 {
+		return errors.Errorf("at least one postings or WAL directory must be given")
+	}
+
+	var problems int
+	for _, dir := range opt.wdirs {
+		found, err := checkWal(dir)
+		if err != nil {
+			return err
+		}
+		problems += report(dir, found)
+	}
+
+	if len(opt.pdirs) > 0 {
+		found, err := checkPostings()
+		if err != nil {
+			return err
+		}
+		problems += found
+	}
+	if problems > 0 {
+		return errors.Errorf("Found %d problems", problems)
+	}
+	fmt.Println("No problems found")
+	return nil
+}
+
+func report(dir string, problems []string) int {
+	for _, p := range problems {
+		fmt.Printf("%s: %s\n", dir, p)
+	}
+	return len(problems)
+}
+
+func openPostings(dir string) (*badger.DB, error) {
+	db, err := badger.OpenManaged(badger.DefaultOptions(dir).
+		WithReadOnly(true).
+		WithEncryptionKey(opt.key).
+		WithLogger(nil))
+	return db, errors.Wrapf(err, "while opening %s", dir)
+}
+
+// checkPostings checks the postings directories, and returns how many problems there are.
+func checkPostings() (int, error) {
+	readTs := opt.readTs
+	if readTs == 0 {
+		for _, dir := range opt.pdirs {
+			db, err := openPostings(dir)
+			if err != nil {
+				return 0, err
+			}
+			if v := db.MaxVersion(); readTs == 0 || v < readTs {
+				readTs = v
+			}
+			x.Check(db.Close())
+		}
+	}
+	fmt.Printf("Checking the postings at timestamp %d\n", readTs)
+
+	ctx := context.Background()
+	var problems int
+	// sums holds the checksum of each predicate in each of the directories.
+	sums := make(map[string]map[string]uint64)
+	for _, dir := range opt.pdirs {
+		db, err := openPostings(dir)
+		if err != nil {
+			return 0, err
+		}
+		posting.Init(db, 0)
+		schema.Init(db)
+		if err := schema.LoadFromDb(); err != nil {
+			return 0, errors.Wrapf(err, "while loading the schema of %s", dir)
+		}
+
+		preds := schema.State().Predicates()
+		if opt.predicate != "" {
+			preds = []string{opt.predicate}
+		}
+		sort.Strings(preds)
+		for _, pred := range preds {
+			found, err := posting.CheckPredicate(ctx, pred, readTs)
+			if err != nil {
+				return 0, err
+			}
+			problems += report(dir, found)
+
+			sum, err := posting.ChecksumPredicate(ctx, pred, readTs)
+			if err != nil {
+				return 0, err
+			}
+			if sums[pred] == nil {
+				sums[pred] = make(map[string]uint64)
+			}
+			sums[pred][dir] = sum
+		}
+		x.Check(db.Close())
+	}
+
+	if len(opt.pdirs) < 2 {
+		return problems, nil
+	}
+	preds := make([]string, 0, len(sums))
+	for pred := range sums {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+	for _, pred := range preds {
+		var diff []string
+		for _, dir := range opt.pdirs {
+			if sum, ok := sums[pred][dir]; ok {
+				diff = append(diff, fmt.Sprintf("%s=%#x", dir, sum))
+			} else {
+				diff = append(diff, fmt.Sprintf("%s=missing", dir))
+			}
+		}
+		first := sums[pred][opt.pdirs[0]]
+		for _, dir := range opt.pdirs[1:] {
+			if sum, ok := sums[pred][dir]; !ok || sum != first {
+				fmt.Printf("%s: the data differs between the replicas: %s\n", pred,
+					strings.Join(diff, " "))
+				problems++
+				break
+			}
+		}
+	}
+	return problems, nil
+}
+
+// checkWal checks that the entries of the WAL in dir follow each other, and that its hard state
+// and checkpoint are within them.
+func checkWal(dir string) ([]string, error) {
+	fmt.Printf("Checking the WAL in %s\n", dir)
+	store, err := raftwal.OpenReadOnly(dir, opt.key)
+	if err != nil {
+		// The WAL isn't changed to open it, so its other checks can't be done until it's fixed.
+		return []string{fmt.Sprintf("the WAL can't be opened: %v", err)}, nil
+	}
+	defer store.Close()
+
+	var problems []string
+	snap, err := store.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	first, err := store.FirstIndex()
+	if err != nil {
+		return nil, err
+	}
+	last, err := store.LastIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	// Opening the WAL already checks that the first entry follows the snapshot.
+	prevIndex, prevTerm := snap.Metadata.Index, snap.Metadata.Term
+	for start := first; start <= last; {
+		entries, err := store.Entries(start, last+1, 64<<20)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("entries from %d can't be read: %v", start,
+				err))
+			break
+		}
+		if len(entries) == 0 {
+			problems = append(problems, fmt.Sprintf("entries from %d are missing", start))
+			break
+		}
+		for _, e := range entries {
+			if e.Index != prevIndex+1 {
+				problems = append(problems, fmt.Sprintf("entry %d follows entry %d", e.Index,
+					prevIndex))
+			}
+			if e.Term < prevTerm {
+				problems = append(problems, fmt.Sprintf("entry %d has term %d, lower than the "+
+					"term %d of entry %d", e.Index, e.Term, prevTerm, prevIndex))
+			}
+			prevIndex, prevTerm = e.Index, e.Term
+		}
+		start = prevIndex + 1
+	}
+
+	hs, err := store.HardState()
+	if err != nil {
+		return nil, err
+	}
+	if hs.Commit > last {
+		problems = append(problems, fmt.Sprintf("the commit index %d is past the last entry "+
+			"%d", hs.Commit, last))
+	}
+	checkpoint, err := store.Checkpoint()
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint > hs.Commit {
+		problems = append(problems, fmt.Sprintf("the checkpoint %d is past the commit index %d",
+			checkpoint, hs.Commit))
+	}
+	return problems, nil
+}
@@ -841,6 +841,16 @@ func printAlphaProposal(buf *bytes.Buffer, pr *pb.Proposal, pending map[uint64]b
 		fmt.Fprintf(buf, " Pending txns: %d .", len(pending))
 	case pr.Snapshot != nil:
 		fmt.Fprintf(buf, " Snapshot . %+v ", pr.Snapshot)
+	case len(pr.Batch) > 0:
+		fmt.Fprintf(buf, " Batch . Proposals: %d .", len(pr.Batch))
+		for _, data := range pr.Batch {
+			var p pb.Proposal
+			if err := p.Unmarshal(data[8:]); err != nil {
+				fmt.Fprintf(buf, " Unable to parse Proposal: %v .", err)
+				continue
+			}
+			printAlphaProposal(buf, &p, pending)
+		}
 	}
 }
 
@@ -27,7 +27,6 @@ import (
 	"runtime"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
@@ -262,6 +261,7 @@ func TestLiveLoadExportedSchema(t *testing.T) {
 				  code
 				  message
 				}
+				taskId
 			  }
 			}`,
 	}
@@ -269,8 +269,8 @@ func TestLiveLoadExportedSchema(t *testing.T) {
 	resp := testutil.MakeGQLRequestWithAccessJwt(t, params, token.AccessJwt)
 	require.Nilf(t, resp.Errors, resp.Errors.Error())
 
-	// wait a bit to be sure export is complete
-	time.Sleep(8 * time.Second)
+	// wait for the export to complete
+	testutil.WaitForTask(t, testutil.TaskId(t, resp, "export"), nil, token.AccessJwt)
 
 	// copy the export files from docker
 	exportId, groupId := copyExportToLocalFs(t)
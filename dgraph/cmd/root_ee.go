@@ -15,6 +15,7 @@ package cmd
 import (
 	acl "github.com/dgraph-io/dgraph/ee/acl"
 	"github.com/dgraph-io/dgraph/ee/backup"
+	"github.com/dgraph-io/dgraph/ee/enc"
 )
 
 func init() {
@@ -24,5 +25,6 @@ func init() {
 		&backup.LsBackup,
 		&backup.ExportBackup,
 		&acl.CmdAcl,
+		&enc.RotateKey,
 	)
 }
@@ -70,6 +70,11 @@ func (s *Server) lease(ctx context.Context, num *pb.Num, txn bool) (*pb.Assigned
 	if !node.AmLeader() {
 		return &emptyAssignedIds, errors.Errorf("Assigning IDs is only allowed on leader.")
 	}
+	// Until then, a leader that has been cut off from the rest of the Zeros for an election
+	// timeout stops leasing, as the others may have elected a new leader.
+	if err := node.UnsafePartition(); err != nil {
+		return &emptyAssignedIds, errors.Wrapf(err, "Refusing to assign IDs")
+	}
 
 	if num.Val == 0 && !num.ReadOnly {
 		return &emptyAssignedIds, errors.Errorf("Nothing to be leased")
@@ -0,0 +1,203 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// startDrain checks that the node of groupId can be drained, and starts draining it in the
+// background. Zero stops assigning new tablets to the group, moves the tablets of the group to
+// other groups if the node is the last one in it, and then removes the node from the group. If
+// the node is the leader of the group, it's asked to hand the leadership to another member first.
+//
+// Only one node of a group is drained at a time, and a node isn't drained if the group would be
+// left with no more than half of its replicas, as it would lose its quorum if one more failed.
+func (s *Server) startDrain(nodeId uint64, groupId uint32) error {
+	s.Lock()
+	defer s.Unlock()
+
+	group, ok := s.state.Groups[groupId]
+	if !ok {
+		return errors.Errorf("No group with groupId %d found", groupId)
+	}
+	if _, ok := group.Members[nodeId]; !ok {
+		return errors.Errorf("No node with nodeId %d found in group %d", nodeId, groupId)
+	}
+	if s.draining[groupId] {
+		return errors.Errorf("A node of group %d is already being drained", groupId)
+	}
+	last := len(group.Members) == 1
+	if left := len(group.Members) - 1; !last && left <= s.NumReplicas/2 {
+		return errors.Errorf("Unable to drain node %d, as group %d would be left with %d of "+
+			"its %d replicas", nodeId, groupId, left, s.NumReplicas)
+	}
+	if last {
+		for pred := range group.Tablets {
+			if x.IsReservedPredicate(pred) {
+				return errors.Errorf("Unable to drain the last node of group %d, as it serves "+
+					"the reserved predicate %s", groupId, pred)
+			}
+		}
+		if len(group.Tablets) > 0 && s.drainTarget(groupId) == 0 {
+			return errors.Errorf("No other group to move the tablets of group %d to", groupId)
+		}
+	}
+	s.draining[groupId] = true
+
+	go func() {
+		defer func() {
+			s.Lock()
+			defer s.Unlock()
+			delete(s.draining, groupId)
+		}()
+		if err := s.drainNode(nodeId, groupId, last); err != nil {
+			glog.Errorf("While draining node %d of group %d: %v", nodeId, groupId, err)
+			return
+		}
+		glog.Infof("Drained node %d of group %d", nodeId, groupId)
+	}()
+	return nil
+}
+
+// drainNode moves the tablets of groupId away if last is true, and removes the node.
+func (s *Server) drainNode(nodeId uint64, groupId uint32, last bool) error {
+	if last {
+		// Each tablet is only tried once, so that a move that keeps failing doesn't hold the
+		// drain forever. The node isn't removed if some tablets are left.
+		tried := make(map[string]bool)
+		for {
+			pred, dstGroup := s.nextDrainMove(groupId, tried)
+			if pred == "" {
+				break
+			}
+			tried[pred] = true
+			glog.Infof("Draining group %d: moving predicate %s to group %d", groupId, pred,
+				dstGroup)
+			if err := s.movePredicate(pred, groupId, dstGroup); err != nil {
+				glog.Errorf("Draining group %d: while moving predicate %s: %v", groupId, pred,
+					err)
+			}
+		}
+		if left := s.groupTablets(groupId); len(left) > 0 {
+			return errors.Errorf("The tablets %v couldn't be moved out of group %d", left,
+				groupId)
+		}
+	}
+
+	if err := s.transferLeadership(nodeId, groupId); err != nil {
+		return err
+	}
+	return s.removeNode(context.Background(), nodeId, groupId)
+}
+
+// transferLeadership asks the node of groupId to hand the leadership of the group to another
+// member, if it's the leader, so that the group doesn't wait for an election once it's removed.
+func (s *Server) transferLeadership(nodeId uint64, groupId uint32) error {
+	s.RLock()
+	group := s.state.Groups[groupId]
+	member := group.GetMembers()[nodeId]
+	others := len(group.GetMembers()) - 1
+	s.RUnlock()
+	if member == nil || !member.Leader || others == 0 {
+		return nil
+	}
+
+	pl, err := conn.GetPools().Get(member.Addr)
+	if err != nil {
+		return errors.Wrapf(err, "while connecting to node %d", nodeId)
+	}
+	if pl.ProtocolVersion() < x.ProtocolTransferLeadership {
+		// The group elects a new leader once the node is removed.
+		glog.Infof("Draining group %d: node %d is of an older version, which can't transfer "+
+			"its leadership", groupId, nodeId)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	glog.Infof("Draining group %d: transferring the leadership of node %d", groupId, nodeId)
+	_, err = pb.NewWorkerClient(pl.Get()).TransferLeadership(ctx, member)
+	return errors.Wrapf(err, "while transferring the leadership of node %d", nodeId)
+}
+
+// groupTablets returns the sorted tablets of groupId.
+func (s *Server) groupTablets(groupId uint32) []string {
+	s.RLock()
+	defer s.RUnlock()
+
+	var preds []string
+	for pred := range s.state.Groups[groupId].GetTablets() {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+	return preds
+}
+
+// nextDrainMove returns the next tablet of groupId to move, and the group to move it to.
+func (s *Server) nextDrainMove(groupId uint32, tried map[string]bool) (string, uint32) {
+	s.RLock()
+	defer s.RUnlock()
+
+	group, ok := s.state.Groups[groupId]
+	if !ok {
+		return "", 0
+	}
+	var preds []string
+	for pred := range group.Tablets {
+		if !tried[pred] {
+			preds = append(preds, pred)
+		}
+	}
+	if len(preds) == 0 {
+		return "", 0
+	}
+	sort.Strings(preds)
+	dstGroup := s.drainTarget(groupId)
+	if dstGroup == 0 {
+		return "", 0
+	}
+	return preds[0], dstGroup
+}
+
+// drainTarget returns the smallest group with a leader, other than groupId and the groups being
+// drained, or zero if there's none.
+func (s *Server) drainTarget(groupId uint32) uint32 {
+	s.AssertRLock()
+	var target uint32
+	var targetSize int64
+	for gid, group := range s.state.Groups {
+		if gid == groupId || s.draining[gid] || !s.hasLeader(gid) {
+			continue
+		}
+		var size int64
+		for _, tab := range group.Tablets {
+			size += tab.OnDiskBytes
+		}
+		if target == 0 || size < targetSize {
+			target, targetSize = gid, size
+		}
+	}
+	return target
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// snapshotHold is a read timestamp whose versions the alphas keep until the hold expires, so that
+// a series of queries can read the same snapshot of all the groups.
+type snapshotHold struct {
+	ReadTs    uint64 `json:"readTs"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// validateHoldTTL returns an error if a snapshot can't be held for ttl. Holds take up to
+// --max_snapshot_hold, as the alphas keep the old versions for them and tablets aren't moved
+// meanwhile.
+func validateHoldTTL(ttl time.Duration) error {
+	switch {
+	case ttl <= 0:
+		return errors.Errorf("The ttl of a snapshot hold must be positive, got %s", ttl)
+	case ttl > opts.maxSnapshotHold:
+		return errors.Errorf("The ttl of a snapshot hold can be at most %s, got %s",
+			opts.maxSnapshotHold, ttl)
+	}
+	return nil
+}
+
+// holdSnapshot leases a read-only timestamp and holds it for ttl. The holds that have expired are
+// released at the same time.
+func (s *Server) holdSnapshot(ctx context.Context, ttl time.Duration) (*snapshotHold, error) {
+	if err := validateHoldTTL(ttl); err != nil {
+		return nil, err
+	}
+	ids, err := s.Timestamps(ctx, &pb.Num{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	hold := &snapshotHold{ReadTs: ids.ReadOnly, ExpiresAt: now.Add(ttl).Unix()}
+
+	s.RLock()
+	holds := s.expiredHolds(now)
+	s.RUnlock()
+	holds[hold.ReadTs] = hold.ExpiresAt
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{SnapshotHolds: holds}); err != nil {
+		return nil, err
+	}
+	glog.Infof("Holding the snapshot at %d until %s", hold.ReadTs,
+		time.Unix(hold.ExpiresAt, 0).UTC())
+	return hold, nil
+}
+
+// releaseSnapshot releases the hold on the snapshot at readTs, along with the ones that have
+// expired.
+func (s *Server) releaseSnapshot(ctx context.Context, readTs uint64) error {
+	s.RLock()
+	_, ok := s.state.GetSnapshotHolds()[readTs]
+	holds := s.expiredHolds(time.Now())
+	s.RUnlock()
+	if !ok {
+		return errors.Errorf("There is no hold on the snapshot at %d", readTs)
+	}
+	holds[readTs] = 0
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{SnapshotHolds: holds}); err != nil {
+		return err
+	}
+	glog.Infof("Released the snapshot at %d", readTs)
+	return nil
+}
+
+// expiredHolds returns the holds that have expired at now, with an expiry of zero to release them.
+// The caller must hold the read lock.
+func (s *Server) expiredHolds(now time.Time) map[uint64]int64 {
+	s.AssertRLock()
+	holds := make(map[uint64]int64)
+	for ts, expiresAt := range s.state.GetSnapshotHolds() {
+		if expiresAt <= now.Unix() {
+			holds[ts] = 0
+		}
+	}
+	return holds
+}
+
+// SnapshotHeld returns whether a snapshot is held now.
+func (s *Server) SnapshotHeld() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.hasSnapshotHolds(time.Now())
+}
+
+// hasSnapshotHolds returns whether a snapshot is held at now. The caller must hold the read lock.
+func (s *Server) hasSnapshotHolds(now time.Time) bool {
+	s.AssertRLock()
+	for _, expiresAt := range s.state.GetSnapshotHolds() {
+		if expiresAt > now.Unix() {
+			return true
+		}
+	}
+	return false
+}
@@ -18,6 +18,7 @@ package zero
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -128,6 +129,54 @@ func (st *state) removeNode(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// drainNode can be used to decommission a Dgraph alpha node gracefully. It takes in the RAFT id
+// of the node and the group it belongs to. If the node is the last one of its group, its tablets
+// are moved to other groups first. The drain goes on in the background, and the node shows up in
+// the removed members of the state once it's done.
+func (st *state) drainNode(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	nodeId, ok := intFromQueryParam(w, r, "id")
+	if !ok {
+		return
+	}
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		return
+	}
+	if groupId == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"Only alpha nodes can be drained. Use /removeNode for Zero nodes.")
+		return
+	}
+
+	if err := st.zero.startDrain(nodeId, uint32(groupId)); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	_, err := fmt.Fprintf(w, "Draining node with group: %v, idx: %v", groupId, nodeId)
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
 // moveTablet can be used to move a tablet to a specific group. It takes in tablet and group as
 // argument.
 func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
@@ -185,6 +234,13 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if gid := st.zero.PinnedGroup(tablet); gid != 0 && gid != dstGroup {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Tablet: [%s] is pinned to group: "+
+			"[%d]. Pin it to group [%d] instead.", tablet, gid, dstGroup))
+		return
+	}
+
 	srcGroup := tab.GroupId
 	if srcGroup == dstGroup {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -193,7 +249,12 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := st.zero.movePredicate(tablet, srcGroup, dstGroup); err != nil {
+	if err := st.zero.movePredicate(tablet, srcGroup, dstGroup); err == errSnapshotHeld {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Tablet: [%s] can't be moved: %v",
+			tablet, err))
+		return
+	} else if err != nil {
 		glog.Errorf("While moving predicate %s from %d -> %d. Error: %v",
 			tablet, srcGroup, dstGroup, err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -207,6 +268,208 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// pinTablet can be used to pin a tablet to a specific group, so that the balancer doesn't move it
+// out of it. It takes in tablet and group as argument, and moves the tablet to the group if it's
+// served by another one. A group of 0 unpins the tablet.
+func (st *state) pinTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf(
+			"Query parameter 'group' should contain a valid integer."))
+		return
+	}
+	dstGroup := uint32(groupId)
+	if dstGroup != 0 {
+		var isKnown bool
+		for _, grp := range st.zero.KnownGroups() {
+			if grp == dstGroup {
+				isKnown = true
+				break
+			}
+		}
+		if !isKnown {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Group: [%d] is not a known group.",
+				dstGroup))
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := st.zero.PinTablet(ctx, tablet, dstGroup); err != nil {
+		glog.Errorf("While pinning predicate %s to %d. Error: %v", tablet, dstGroup, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	if dstGroup == 0 {
+		if _, err := fmt.Fprintf(w, "Predicate: [%s] unpinned", tablet); err != nil {
+			glog.Warningf("Error while writing response: %+v", err)
+		}
+		return
+	}
+
+	if err := st.zero.movePinnedTablet(tablet, dstGroup); err != nil {
+		glog.Errorf("While moving predicate %s to %d. Error: %v", tablet, dstGroup, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, fmt.Sprintf("Predicate: [%s] pinned to group [%d], but it "+
+			"couldn't be moved there yet: %v", tablet, dstGroup, err))
+		return
+	}
+	_, err := fmt.Fprintf(w, "Predicate: [%s] pinned to group [%d]", tablet, dstGroup)
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// balanceLeaders moves the group leaderships so that they're spread across the hosts of the
+// alphas, and returns the moves it made.
+func (st *state) balanceLeaders(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	moves := st.zero.leaderMoves()
+	for _, move := range moves {
+		if err := st.zero.moveLeader(move); err != nil {
+			glog.Errorf("While balancing leaders: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			x.SetStatus(w, x.Error, err.Error())
+			return
+		}
+	}
+	if err := json.NewEncoder(w).Encode(map[string][]leaderMove{"moves": moves}); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// holdSnapshot returns a read timestamp that is consistent across all the groups, and holds it for
+// the duration given by ttl, so that a series of read-only queries can all be run at it.
+func (st *state) holdSnapshot(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	ttl := time.Hour
+	if str := r.URL.Query().Get("ttl"); len(str) > 0 {
+		var err error
+		if ttl, err = time.ParseDuration(str); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest, "Error while parsing ttl")
+			return
+		}
+	}
+	if err := validateHoldTTL(ttl); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	hold, err := st.zero.holdSnapshot(ctx, ttl)
+	if err != nil {
+		glog.Errorf("While holding a snapshot. Error: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	if err := json.NewEncoder(w).Encode(hold); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// releaseSnapshot releases the hold on the snapshot at the timestamp given by ts, before it
+// expires.
+func (st *state) releaseSnapshot(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	readTs, ok := intFromQueryParam(w, r, "ts")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := st.zero.releaseSnapshot(ctx, readTs); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	if _, err := fmt.Fprintf(w, "Released the snapshot at: %d", readTs); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
 func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -231,9 +494,32 @@ func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// getUpgradeStatus returns the versions of the nodes of the cluster, which tells whether a rolling
+// upgrade is done.
+func (st *state) getUpgradeStatus(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(st.zero.upgradeStatus(st.node.Id)); err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+	}
+}
+
 func (st *state) pingResponse(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 
+	if err := st.node.UnsafePartition(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
+	if err := st.node.ClockSkew(); err != nil {
+		// A skewed clock is reported, but doesn't fail the check, as restarting Zero wouldn't
+		// fix it.
+		_, _ = w.Write([]byte("OK, but " + err.Error()))
+		return
+	}
 	_, _ = w.Write([]byte("OK"))
 }
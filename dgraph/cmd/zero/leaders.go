@@ -0,0 +1,167 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// leaderMove moves the leadership of a group to one of its other members.
+type leaderMove struct {
+	Group uint32 `json:"group"`
+	From  uint64 `json:"from"`
+	To    uint64 `json:"to"`
+}
+
+// balanceLeaders moves a group leadership every --rebalance_interval, if --balance_leaders is set,
+// so that the leaders are spread across the hosts of the alphas.
+func (s *Server) balanceLeaders() {
+	ticker := time.NewTicker(opts.rebalanceInterval)
+	for range ticker.C {
+		if !opts.balanceLeaders || !s.Node.AmLeader() {
+			continue
+		}
+		if !opts.rebalanceWindow.contains(time.Now()) {
+			glog.V(2).Infof("Not balancing leaders outside of the window %s", opts.rebalanceWindow)
+			continue
+		}
+		// Only do one move at a time, so that the next one is planned from the leaders that the
+		// alphas report after it.
+		moves := s.leaderMoves()
+		if len(moves) == 0 {
+			continue
+		}
+		if err := s.moveLeader(moves[0]); err != nil {
+			glog.Errorf("While balancing leaders: %v", err)
+		}
+	}
+}
+
+// leaderMoves returns the moves that spread the group leaders evenly across the hosts of the
+// alphas. Only the healthy members that can take the leadership are moved to.
+func (s *Server) leaderMoves() []leaderMove {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil {
+		return nil
+	}
+	return planLeaderMoves(s.state, s.draining, func(m *pb.Member) bool {
+		pl, err := conn.GetPools().Get(m.Addr)
+		return err == nil && pl.IsHealthy() && pl.ProtocolVersion() >= x.ProtocolTakeLeadership
+	})
+}
+
+// memberHost returns the host of the address of m.
+func memberHost(m *pb.Member) string {
+	host, _, err := net.SplitHostPort(m.Addr)
+	if err != nil {
+		return m.Addr
+	}
+	return host
+}
+
+// planLeaderMoves returns the moves that spread the leaders of the groups of state evenly across
+// the hosts of their members. A move goes to the member of the group whose host has the fewest
+// leaders, as long as it ends up with fewer leaders than the host the move leaves had. The
+// draining groups are left alone, and leaderships only move to the members that canLead.
+func planLeaderMoves(state *pb.MembershipState, draining map[uint32]bool,
+	canLead func(m *pb.Member) bool) []leaderMove {
+
+	leaders := make(map[uint32]*pb.Member)
+	count := make(map[string]int) // The number of leaders on each host.
+	var gids []uint32
+	for gid, group := range state.GetGroups() {
+		for _, m := range group.GetMembers() {
+			if m.Leader && !m.AmDead {
+				leaders[gid] = m
+				count[memberHost(m)]++
+				break
+			}
+		}
+		if leaders[gid] != nil && !draining[gid] {
+			gids = append(gids, gid)
+		}
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+
+	var moves []leaderMove
+	// Each move brings the numbers of leaders of two hosts closer, so this ends.
+	for {
+		var best *leaderMove
+		var bestGain int
+		for _, gid := range gids {
+			from := leaders[gid]
+			var ids []uint64
+			for id := range state.Groups[gid].GetMembers() {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			for _, id := range ids {
+				to := state.Groups[gid].Members[id]
+				if to.Id == from.Id || to.AmDead || !canLead(to) {
+					continue
+				}
+				// Moving only helps if the host of to ends up with fewer leaders than the host
+				// of from had.
+				gain := count[memberHost(from)] - count[memberHost(to)] - 1
+				if gain > bestGain {
+					best, bestGain = &leaderMove{Group: gid, From: from.Id, To: to.Id}, gain
+				}
+			}
+		}
+		if best == nil {
+			return moves
+		}
+		moves = append(moves, *best)
+		from, to := leaders[best.Group], state.Groups[best.Group].Members[best.To]
+		count[memberHost(from)]--
+		count[memberHost(to)]++
+		leaders[best.Group] = to
+	}
+}
+
+// moveLeader asks the member that move goes to, to take the leadership of its group.
+func (s *Server) moveLeader(move leaderMove) error {
+	s.RLock()
+	member := s.state.GetGroups()[move.Group].GetMembers()[move.To]
+	s.RUnlock()
+	if member == nil {
+		return errors.Errorf("Node %d isn't a member of group %d", move.To, move.Group)
+	}
+
+	pl, err := conn.GetPools().Get(member.Addr)
+	if err != nil {
+		return errors.Wrapf(err, "while connecting to node %d", move.To)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	glog.Infof("Moving the leadership of group %d from node %d to node %d", move.Group, move.From,
+		move.To)
+	_, err = pb.NewWorkerClient(pl.Get()).TakeLeadership(ctx, member)
+	return errors.Wrapf(err, "while moving the leadership of group %d to node %d", move.Group,
+		move.To)
+}
@@ -442,6 +442,9 @@ func (s *Server) CommitOrAbort(ctx context.Context, src *api.TxnContext) (*api.T
 	if !s.Node.AmLeader() {
 		return nil, errors.Errorf("Only leader can decide to commit or abort")
 	}
+	if err := s.Node.UnsafePartition(); err != nil {
+		return nil, errors.Wrapf(err, "Refusing to commit or abort")
+	}
 	err := s.commit(ctx, src)
 	if err != nil {
 		span.Annotate([]otrace.Attribute{otrace.BoolAttribute("error", true)}, err.Error())
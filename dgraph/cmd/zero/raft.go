@@ -299,6 +299,41 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 	return nil
 }
 
+// handlePinProposal pins the predicate of pin to its group, or unpins it if the group is zero.
+func (n *node) handlePinProposal(pin *pb.Tablet) {
+	n.server.AssertLock()
+	state := n.server.state
+	var pinned []*pb.Tablet
+	for _, p := range state.Pinned {
+		if p.Predicate != pin.Predicate {
+			pinned = append(pinned, p)
+		}
+	}
+	if pin.GroupId != 0 {
+		glog.Infof("Pinning predicate %s to group %d", pin.Predicate, pin.GroupId)
+		pinned = append(pinned, &pb.Tablet{Predicate: pin.Predicate, GroupId: pin.GroupId})
+	} else {
+		glog.Infof("Unpinning predicate %s", pin.Predicate)
+	}
+	state.Pinned = pinned
+}
+
+// handleSnapshotHoldsProposal adds the snapshot holds, and releases the ones with an expiry of zero.
+func (n *node) handleSnapshotHoldsProposal(holds map[uint64]int64) {
+	n.server.AssertLock()
+	state := n.server.state
+	for ts, expiresAt := range holds {
+		if expiresAt == 0 {
+			delete(state.SnapshotHolds, ts)
+			continue
+		}
+		if state.SnapshotHolds == nil {
+			state.SnapshotHolds = make(map[uint64]int64)
+		}
+		state.SnapshotHolds[ts] = expiresAt
+	}
+}
+
 func (n *node) applySnapshot(snap *pb.ZeroSnapshot) error {
 	existing, err := n.Store.Snapshot()
 	if err != nil {
@@ -376,6 +411,12 @@ func (n *node) applyProposal(e raftpb.Entry) (uint64, error) {
 			return key, err
 		}
 	}
+	if p.Pin != nil {
+		n.handlePinProposal(p.Pin)
+	}
+	if p.SnapshotHolds != nil {
+		n.handleSnapshotHoldsProposal(p.SnapshotHolds)
+	}
 	if p.License != nil {
 		// Check that the number of nodes in the cluster should be less than MaxNodes, otherwise
 		// reject the proposal.
@@ -788,18 +829,17 @@ func (n *node) calculateAndProposeSnapshot() error {
 	return nil
 }
 
-const tickDur = 100 * time.Millisecond
-
 func (n *node) Run() {
 	var leader bool
 	licenseApplied := false
+	tickDur := x.WorkerConfig.Raft.TickInterval
 	ticker := time.NewTicker(tickDur)
 	defer ticker.Stop()
 
 	// snapshot can cause select loop to block while deleting entries, so run
 	// it in goroutine
 	readStateCh := make(chan raft.ReadState, 100)
-	closer := z.NewCloser(5)
+	closer := z.NewCloser(6)
 	defer func() {
 		closer.SignalAndWait()
 		n.closer.Done()
@@ -811,7 +851,8 @@ func (n *node) Run() {
 	go n.updateZeroMembershipPeriodically(closer)
 	go n.checkQuorum(closer)
 	go n.RunReadIndexLoop(closer, readStateCh)
-	if !x.WorkerConfig.HardSync {
+	go n.MonitorSafety(closer)
+	if x.WorkerConfig.SyncInterval > 0 {
 		closer.AddRunning(1)
 		go x.StoreSync(n.Store, closer)
 	}
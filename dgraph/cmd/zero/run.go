@@ -52,7 +52,14 @@ type options struct {
 	numReplicas       int
 	peer              string
 	w                 string
+	inMemory          bool
 	rebalanceInterval time.Duration
+	rebalanceWindow   *moveWindow
+	maxMoves          int
+	moveBandwidth     float64
+	balanceLeaders    bool
+	webhooks          []string
+	maxSnapshotHold   time.Duration
 	tlsClientConfig   *tls.Config
 }
 
@@ -87,7 +94,28 @@ instances to achieve high-availability.
 		" The count includes the original shard.")
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
+	flag.String("badger.mode", "disk",
+		"[disk, memory] Where to keep the WAL. memory keeps it in memory only, without writing"+
+			" anything to the WAL directory, so that it's lost when zero exits. It's meant for"+
+			" tests.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
+	flag.String("rebalance_window", "",
+		"Daily window of time in UTC, of the form HH:MM-HH:MM, in which Zero moves predicates to "+
+			"rebalance the groups. Leave it empty to rebalance at any time. It doesn't apply to "+
+			"the moves asked for through the /moveTablet and /pinTablet endpoints.")
+	flag.Int("max_moves", 1, "Maximum number of predicate moves that can run at the same time.")
+	flag.Float64("move_bandwidth", 0,
+		"Maximum rate in MB/s at which each predicate move streams its data. 0 means no limit.")
+	flag.Bool("balance_leaders", false,
+		"Move a group leadership every --rebalance_interval, within the --rebalance_window, so "+
+			"that the leaders of the groups are spread across the hosts of the alphas.")
+	flag.Duration("max_snapshot_hold", 24*time.Hour,
+		"Longest ttl that /holdSnapshot accepts. The alphas keep the versions that a held "+
+			"snapshot needs, and rebalancing stops, until the hold expires.")
+	flag.StringSlice("webhook", nil,
+		"Comma separated list of URLs that the leader Zero POSTs a JSON event to when a node "+
+			"joins or leaves, a group is created or removed, a tablet moves or a group gets a "+
+			"new leader.")
 	flag.String("enterprise_license", "", "Path to the enterprise license file.")
 	// TLS configurations
 	x.RegisterServerTLSFlags(flag)
@@ -179,9 +207,19 @@ func run() {
 		numReplicas:       Zero.Conf.GetInt("replicas"),
 		peer:              Zero.Conf.GetString("peer"),
 		w:                 Zero.Conf.GetString("wal"),
+		inMemory:          x.ParseStorageMode(Zero.Conf.GetString("badger.mode")),
 		rebalanceInterval: Zero.Conf.GetDuration("rebalance_interval"),
+		maxMoves:          Zero.Conf.GetInt("max_moves"),
+		moveBandwidth:     Zero.Conf.GetFloat64("move_bandwidth") * (1 << 20),
+		balanceLeaders:    Zero.Conf.GetBool("balance_leaders"),
+		webhooks:          Zero.Conf.GetStringSlice("webhook"),
+		maxSnapshotHold:   Zero.Conf.GetDuration("max_snapshot_hold"),
 		tlsClientConfig:   tlsConf,
 	}
+	opts.rebalanceWindow, err = parseMoveWindow(Zero.Conf.GetString("rebalance_window"))
+	if err != nil {
+		log.Fatalf("ERROR: Invalid rebalance window: %v", err)
+	}
 	glog.Infof("Setting Config to: %+v", opts)
 
 	if opts.nodeId == 0 {
@@ -210,6 +248,16 @@ func run() {
 			opts.rebalanceInterval)
 	}
 
+	if opts.maxMoves <= 0 {
+		log.Fatalf("ERROR: Maximum number of moves must be greater than zero. Found: %d",
+			opts.maxMoves)
+	}
+
+	if opts.maxSnapshotHold <= 0 {
+		log.Fatalf("ERROR: Maximum snapshot hold must be greater than zero. Found: %s",
+			opts.maxSnapshotHold)
+	}
+
 	grpc.EnableTracing = false
 	otrace.ApplyConfig(otrace.Config{
 		DefaultSampler: otrace.ProbabilitySampler(Zero.Conf.GetFloat64("trace"))})
@@ -228,8 +276,13 @@ func run() {
 	x.Check(err)
 
 	// Create and initialize write-ahead log.
-	x.Checkf(os.MkdirAll(opts.w, 0700), "Error while creating WAL dir.")
-	store := raftwal.Init(opts.w)
+	var store *raftwal.DiskStorage
+	if opts.inMemory {
+		store = raftwal.InitMemory()
+	} else {
+		x.Checkf(os.MkdirAll(opts.w, 0700), "Error while creating WAL dir.")
+		store = raftwal.Init(opts.w)
+	}
 	store.SetUint(raftwal.RaftId, opts.nodeId)
 	store.SetUint(raftwal.GroupId, 0) // All zeros have group zero.
 
@@ -243,8 +296,14 @@ func run() {
 
 	http.HandleFunc("/health", st.pingResponse)
 	http.HandleFunc("/state", st.getState)
+	http.HandleFunc("/upgradeStatus", st.getUpgradeStatus)
 	http.HandleFunc("/removeNode", st.removeNode)
+	http.HandleFunc("/drainNode", st.drainNode)
 	http.HandleFunc("/moveTablet", st.moveTablet)
+	http.HandleFunc("/pinTablet", st.pinTablet)
+	http.HandleFunc("/balanceLeaders", st.balanceLeaders)
+	http.HandleFunc("/holdSnapshot", st.holdSnapshot)
+	http.HandleFunc("/releaseSnapshot", st.releaseSnapshot)
 	http.HandleFunc("/assign", st.assign)
 	http.HandleFunc("/enterpriseLicense", st.applyEnterpriseLicense)
 	http.HandleFunc("/jemalloc", x.JemallocHandler)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -62,25 +63,108 @@ This would trigger G1 to get latest state. Wait for it.
 func (s *Server) rebalanceTablets() {
 	ticker := time.NewTicker(opts.rebalanceInterval)
 	for range ticker.C {
+		if !opts.rebalanceWindow.contains(time.Now()) {
+			glog.V(2).Infof("Not rebalancing tablets outside of the window %s", opts.rebalanceWindow)
+			continue
+		}
+		// Only start a move if there's room for it, instead of waiting for the ongoing ones.
+		if len(s.moveOngoing) >= cap(s.moveOngoing) {
+			continue
+		}
 		predicate, srcGroup, dstGroup := s.chooseTablet()
 		if len(predicate) == 0 {
 			continue
 		}
-		if err := s.movePredicate(predicate, srcGroup, dstGroup); err != nil {
-			glog.Errorln(err)
+		go func() {
+			if err := s.movePredicate(predicate, srcGroup, dstGroup); err != nil {
+				glog.Errorln(err)
+			}
+		}()
+	}
+}
+
+// moveWindow is a daily window of time in UTC.
+type moveWindow struct {
+	// start and end are the times of the day the window starts and ends at. The window goes past
+	// midnight if end is before start.
+	start, end time.Duration
+}
+
+// parseMoveWindow parses a window of the form HH:MM-HH:MM. It returns nil if s is empty, which
+// is a window that contains all times.
+func parseMoveWindow(s string) (*moveWindow, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return nil, errors.Errorf("%q isn't of the form HH:MM-HH:MM", s)
+	}
+	var times [2]time.Duration
+	for i, part := range parts {
+		t, err := time.Parse("15:04", strings.TrimSpace(part))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing %q", s)
 		}
+		times[i] = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
 	}
+	if times[0] == times[1] {
+		return nil, errors.Errorf("the window %q is empty", s)
+	}
+	return &moveWindow{start: times[0], end: times[1]}, nil
 }
 
+func (w *moveWindow) contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+	t = t.UTC()
+	d := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	if w.start < w.end {
+		return d >= w.start && d < w.end
+	}
+	return d >= w.start || d < w.end
+}
+
+func (w *moveWindow) String() string {
+	if w == nil {
+		return "always"
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d UTC", int(w.start.Hours()), int(w.start.Minutes())%60,
+		int(w.end.Hours()), int(w.end.Minutes())%60)
+}
+
+// errSnapshotHeld is returned by movePredicate while snapshots are held. The group that a tablet
+// moves to only gets its latest version, so the reads at the held snapshots would fail.
+var errSnapshotHeld = errors.New("Tablets can't be moved while snapshots are held. Release " +
+	"them with /releaseSnapshot or wait for them to expire.")
+
 // movePredicate is the main entry point for move predicate logic. This Zero must remain the leader
 // for the entire duration of predicate move. If this Zero stops being the leader, the final
 // proposal of reassigning the tablet to the destination would fail automatically.
 func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) error {
+	if s.SnapshotHeld() {
+		return errSnapshotHeld
+	}
 	s.moveOngoing <- struct{}{}
 	defer func() {
 		<-s.moveOngoing
 	}()
 
+	// Keep the balancer from choosing other tablets of these groups until the move is done, as
+	// their sizes don't account for it yet.
+	s.Lock()
+	s.moving[srcGroup]++
+	s.moving[dstGroup]++
+	s.Unlock()
+	defer func() {
+		s.Lock()
+		defer s.Unlock()
+		s.moving[srcGroup]--
+		s.moving[dstGroup]--
+	}()
+
 	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
 	defer cancel()
 
@@ -131,6 +215,7 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 		SourceGid: srcGroup,
 		DestGid:   dstGroup,
 		TxnTs:     ids.StartId,
+		Bandwidth: uint64(opts.moveBandwidth),
 	}
 	span.Annotatef(nil, "Starting move: %+v", in)
 	glog.Infof("Starting move: %+v", in)
@@ -144,6 +229,8 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 		Predicate:         predicate,
 		OnDiskBytes:       tab.OnDiskBytes,
 		UncompressedBytes: tab.UncompressedBytes,
+		KeyCount:          tab.KeyCount,
+		SplitCount:        tab.SplitCount,
 		Force:             true,
 		MoveTs:            in.TxnTs,
 	}
@@ -189,6 +276,22 @@ func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uin
 	if !s.Node.AmLeader() || numGroups <= 1 {
 		return
 	}
+	// Moving a predicate drops its old versions, which the reads at a held snapshot need.
+	if s.hasSnapshotHolds(time.Now()) {
+		return
+	}
+
+	// Move the pinned predicates that aren't in their group first, like the ones that were pinned
+	// to a group before it was up.
+	for _, pin := range s.state.Pinned {
+		tab := s.servingTablet(pin.Predicate)
+		if tab == nil || tab.GroupId == pin.GroupId || !s.hasLeader(pin.GroupId) ||
+			s.moving[tab.GroupId] > 0 || s.moving[pin.GroupId] > 0 ||
+			s.draining[tab.GroupId] || s.draining[pin.GroupId] {
+			continue
+		}
+		return pin.Predicate, tab.GroupId, pin.GroupId
+	}
 
 	// Sort all groups by their sizes.
 	type kv struct {
@@ -197,6 +300,10 @@ func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uin
 	}
 	var groups []kv
 	for k, v := range s.state.Groups {
+		// Leave out the groups with an ongoing move, and the ones being drained.
+		if s.moving[k] > 0 || s.draining[k] {
+			continue
+		}
 		space := int64(0)
 		for _, tab := range v.Tablets {
 			space += tab.OnDiskBytes
@@ -208,7 +315,7 @@ func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uin
 	})
 
 	glog.Infof("\n\nGroups sorted by size: %+v\n\n", groups)
-	for lastGroup := numGroups - 1; lastGroup > 0; lastGroup-- {
+	for lastGroup := len(groups) - 1; lastGroup > 0; lastGroup-- {
 		srcGroup = groups[lastGroup].gid
 		dstGroup = groups[0].gid
 		sizeDiff := groups[lastGroup].size - groups[0].size
@@ -232,6 +339,10 @@ func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uin
 			if x.IsReservedPredicate(tab.Predicate) {
 				continue
 			}
+			// Pinned predicates stay in the group they're pinned to.
+			if s.pinnedGroup(tab.Predicate) != 0 {
+				continue
+			}
 
 			// Finds a tablet as big a possible such that on moving it dstGroup's size is
 			// less than or equal to srcGroup.
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// nodeVersion is the version of a node of the cluster, as told by its last heartbeat.
+type nodeVersion struct {
+	Id              uint64 `json:"id"`
+	Group           uint32 `json:"group"`
+	Addr            string `json:"addr"`
+	Healthy         bool   `json:"healthy"`
+	Version         string `json:"version"`
+	ProtocolVersion uint32 `json:"protocolVersion"`
+}
+
+// upgradeStatus tells how far a rolling upgrade of the cluster is.
+type upgradeStatus struct {
+	Nodes []nodeVersion `json:"nodes"`
+	// Versions are the builds that the nodes run.
+	Versions []string `json:"versions"`
+	// ProtocolVersion is the lowest protocol version of the nodes, which is what the cluster as
+	// a whole speaks. The nodes don't use the features of later versions with each other.
+	ProtocolVersion uint32 `json:"protocolVersion"`
+	// Upgraded is true if all the nodes are healthy, run the same build, and speak the same
+	// protocol version.
+	Upgraded bool `json:"upgraded"`
+}
+
+// upgradeStatus returns the versions of the nodes of the cluster. selfId is the id of this Zero,
+// which doesn't have a heartbeat of its own.
+func (s *Server) upgradeStatus(selfId uint64) *upgradeStatus {
+	s.RLock()
+	var nodes []nodeVersion
+	for _, m := range s.state.GetZeros() {
+		nodes = append(nodes, nodeVersion{Id: m.Id, Addr: m.Addr})
+	}
+	for gid, group := range s.state.GetGroups() {
+		for _, m := range group.Members {
+			nodes = append(nodes, nodeVersion{Id: m.Id, Group: gid, Addr: m.Addr})
+		}
+	}
+	s.RUnlock()
+
+	status := &upgradeStatus{Upgraded: true}
+	versions := make(map[string]bool)
+	var maxProtocol uint32
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Group == 0 && node.Id == selfId {
+			node.Healthy = true
+			node.Version, node.ProtocolVersion = x.Version(), x.ProtocolVersion
+		} else if pl, err := conn.GetPools().Get(node.Addr); err == nil {
+			info := pl.HealthInfo()
+			node.Healthy = pl.IsHealthy()
+			node.Version, node.ProtocolVersion = info.Version, info.ProtocolVersion
+		}
+		if !node.Healthy {
+			status.Upgraded = false
+		} else {
+			versions[node.Version] = true
+		}
+
+		if i == 0 || node.ProtocolVersion < status.ProtocolVersion {
+			status.ProtocolVersion = node.ProtocolVersion
+		}
+		if node.ProtocolVersion > maxProtocol {
+			maxProtocol = node.ProtocolVersion
+		}
+	}
+	for v := range versions {
+		status.Versions = append(status.Versions, v)
+	}
+	sort.Strings(status.Versions)
+	if len(status.Versions) > 1 || status.ProtocolVersion != maxProtocol {
+		status.Upgraded = false
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Group != nodes[j].Group {
+			return nodes[i].Group < nodes[j].Group
+		}
+		return nodes[i].Id < nodes[j].Id
+	})
+	status.Nodes = nodes
+	return status
+}
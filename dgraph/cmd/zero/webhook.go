@@ -0,0 +1,261 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// The events that Zero sends to the --webhook URLs.
+const (
+	eventNodeJoined    = "node_joined"
+	eventNodeLeft      = "node_left"
+	eventGroupCreated  = "group_created"
+	eventGroupRemoved  = "group_removed"
+	eventTabletMoved   = "tablet_moved"
+	eventLeaderChanged = "leader_changed"
+)
+
+// webhookEvent is a change of the membership state. The group of the Zero nodes is 0.
+type webhookEvent struct {
+	Event     string    `json:"event"`
+	Time      time.Time `json:"time"`
+	Group     uint32    `json:"group"`
+	Node      uint64    `json:"node,omitempty"`
+	Addr      string    `json:"addr,omitempty"`
+	Predicate string    `json:"predicate,omitempty"`
+	// FromGroup is the group that a tablet moved from.
+	FromGroup uint32 `json:"fromGroup,omitempty"`
+}
+
+var (
+	// webhookQueueSize is how many events can wait to be sent to each --webhook URL. Once its
+	// queue is full, the new events of a URL are dropped.
+	webhookQueueSize = 1000
+	// webhookAttempts is how many times an event is sent to a URL before it's dropped.
+	webhookAttempts = 8
+	// webhookBackoff is how long the first retry of an event waits. Every other one waits twice as
+	// long as the one before, up to webhookMaxBackoff.
+	webhookBackoff    = time.Second
+	webhookMaxBackoff = time.Minute
+)
+
+// watchMembership sends the changes of the membership state to the --webhook URLs, while this
+// Zero is the leader. Every Zero keeps track of the state, so that a new leader reports the
+// changes that led to it.
+func (s *Server) watchMembership() {
+	if len(opts.webhooks) == 0 {
+		return
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	queues := make([]*webhookQueue, 0, len(opts.webhooks))
+	for _, url := range opts.webhooks {
+		queues = append(queues, newWebhookQueue(client, url))
+	}
+
+	var last *pb.MembershipState
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		state := s.membershipState()
+		if last != nil && s.Node.AmLeader() {
+			for _, event := range membershipEvents(last, state, time.Now()) {
+				for _, q := range queues {
+					q.push(event)
+				}
+			}
+		}
+		last = state
+	}
+}
+
+// webhookQueue sends events to a --webhook URL in order, in the background. An event that fails
+// is retried with backoff, and the ones after it wait meanwhile, so that a URL that's down
+// doesn't hold up the others.
+type webhookQueue struct {
+	client *http.Client
+	url    string
+	events chan webhookEvent
+}
+
+func newWebhookQueue(client *http.Client, url string) *webhookQueue {
+	q := &webhookQueue{client: client, url: url, events: make(chan webhookEvent, webhookQueueSize)}
+	go q.run()
+	return q
+}
+
+// push queues event to be sent. It doesn't wait, so the event is dropped if the queue is full.
+func (q *webhookQueue) push(event webhookEvent) {
+	select {
+	case q.events <- event:
+	default:
+		glog.Warningf("Dropping %s event for webhook %s, as %d events are already waiting for it",
+			event.Event, q.url, webhookQueueSize)
+	}
+}
+
+func (q *webhookQueue) run() {
+	for event := range q.events {
+		q.deliver(event)
+	}
+}
+
+// deliver sends event until the URL accepts it, it answers that it never will, or it has failed
+// webhookAttempts times.
+func (q *webhookQueue) deliver(event webhookEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		glog.Errorf("While marshaling webhook event %+v: %v", event, err)
+		return
+	}
+
+	backoff := webhookBackoff
+	for attempt := 1; ; attempt++ {
+		retry, err := q.post(data)
+		if err == nil {
+			return
+		}
+		if !retry || attempt == webhookAttempts {
+			glog.Errorf("Dropping %s event for webhook %s after %d attempts: %v", event.Event,
+				q.url, attempt, err)
+			return
+		}
+		glog.Warningf("While sending %s event to webhook %s, retrying in %s: %v", event.Event,
+			q.url, backoff, err)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > webhookMaxBackoff {
+			backoff = webhookMaxBackoff
+		}
+	}
+}
+
+// post sends data to the URL once. If that fails, it also returns whether it's worth retrying:
+// requests that the URL rejects as they are aren't.
+func (q *webhookQueue) post(data []byte) (bool, error) {
+	resp, err := q.client.Post(q.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return true, err
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	retry := resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusRequestTimeout
+	return retry, errors.Errorf("status %s", resp.Status)
+}
+
+// membershipEvents returns the events that tell how the membership state went from old to cur.
+func membershipEvents(old, cur *pb.MembershipState, now time.Time) []webhookEvent {
+	var events []webhookEvent
+	diffMembers := func(gid uint32, oldMembers, newMembers map[uint64]*pb.Member) {
+		for _, id := range sortedMemberIds(newMembers) {
+			if _, ok := oldMembers[id]; !ok {
+				m := newMembers[id]
+				events = append(events, webhookEvent{Event: eventNodeJoined, Time: now,
+					Group: gid, Node: id, Addr: m.Addr})
+			}
+		}
+		for _, id := range sortedMemberIds(oldMembers) {
+			if _, ok := newMembers[id]; !ok {
+				m := oldMembers[id]
+				events = append(events, webhookEvent{Event: eventNodeLeft, Time: now,
+					Group: gid, Node: id, Addr: m.Addr})
+			}
+		}
+		oldLeader, newLeader := groupLeader(oldMembers), groupLeader(newMembers)
+		if newLeader != nil && (oldLeader == nil || oldLeader.Id != newLeader.Id) {
+			events = append(events, webhookEvent{Event: eventLeaderChanged, Time: now,
+				Group: gid, Node: newLeader.Id, Addr: newLeader.Addr})
+		}
+	}
+
+	diffMembers(0, old.GetZeros(), cur.GetZeros())
+	var gids []uint32
+	for gid := range cur.GetGroups() {
+		gids = append(gids, gid)
+	}
+	for gid := range old.GetGroups() {
+		if _, ok := cur.GetGroups()[gid]; !ok {
+			gids = append(gids, gid)
+		}
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	for _, gid := range gids {
+		oldGroup, ok := old.GetGroups()[gid]
+		newGroup, exists := cur.GetGroups()[gid]
+		if !ok {
+			events = append(events, webhookEvent{Event: eventGroupCreated, Time: now,
+				Group: gid})
+		}
+		diffMembers(gid, oldGroup.GetMembers(), newGroup.GetMembers())
+		if !exists {
+			events = append(events, webhookEvent{Event: eventGroupRemoved, Time: now,
+				Group: gid})
+		}
+	}
+
+	// A tablet moved if it's served by another group than it was.
+	oldTablets := make(map[string]uint32)
+	for gid, group := range old.GetGroups() {
+		for pred := range group.GetTablets() {
+			oldTablets[pred] = gid
+		}
+	}
+	var moved []webhookEvent
+	for gid, group := range cur.GetGroups() {
+		for pred := range group.GetTablets() {
+			if from, ok := oldTablets[pred]; ok && from != gid {
+				moved = append(moved, webhookEvent{Event: eventTabletMoved, Time: now,
+					Group: gid, Predicate: pred, FromGroup: from})
+			}
+		}
+	}
+	sort.Slice(moved, func(i, j int) bool { return moved[i].Predicate < moved[j].Predicate })
+	return append(events, moved...)
+}
+
+func sortedMemberIds(members map[uint64]*pb.Member) []uint64 {
+	ids := make([]uint64, 0, len(members))
+	for id := range members {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// groupLeader returns the member that leads the group, or nil if none does.
+func groupLeader(members map[uint64]*pb.Member) *pb.Member {
+	for _, id := range sortedMemberIds(members) {
+		if members[id].Leader {
+			return members[id]
+		}
+	}
+	return nil
+}
@@ -74,6 +74,8 @@ type Server struct {
 	tlsClientConfig *tls.Config
 
 	moveOngoing    chan struct{}
+	moving         map[uint32]int  // Number of ongoing moves from or to each group.
+	draining       map[uint32]bool // Groups that a node is being drained from.
 	blockCommitsOn *sync.Map
 
 	checkpointPerGroup map[uint32]uint64
@@ -97,10 +99,14 @@ func (s *Server) Init() {
 	s.leaderChangeCh = make(chan struct{}, 1)
 	s.closer = z.NewCloser(2) // grpc and http
 	s.blockCommitsOn = new(sync.Map)
-	s.moveOngoing = make(chan struct{}, 1)
+	s.moveOngoing = make(chan struct{}, opts.maxMoves)
+	s.moving = make(map[uint32]int)
+	s.draining = make(map[uint32]bool)
 	s.checkpointPerGroup = make(map[uint32]uint64)
 
 	go s.rebalanceTablets()
+	go s.balanceLeaders()
+	go s.watchMembership()
 }
 
 func (s *Server) periodicallyPostTelemetry() {
@@ -316,6 +322,60 @@ func (s *Server) ServingTablet(tablet string) *pb.Tablet {
 	return nil
 }
 
+// pinnedGroup returns the group that pred is pinned to, or zero if it isn't pinned.
+func (s *Server) pinnedGroup(pred string) uint32 {
+	s.AssertRLock()
+	for _, p := range s.state.GetPinned() {
+		if p.Predicate == pred {
+			return p.GroupId
+		}
+	}
+	return 0
+}
+
+// PinnedGroup returns the group that pred is pinned to, or zero if it isn't pinned.
+func (s *Server) PinnedGroup(pred string) uint32 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.pinnedGroup(pred)
+}
+
+// PinTablet pins the predicate to the group, so that the balancer doesn't move it and it's
+// created in that group. A group of zero unpins it.
+func (s *Server) PinTablet(ctx context.Context, pred string, gid uint32) error {
+	if x.IsReservedPredicate(pred) {
+		return errors.Errorf("Unable to pin reserved predicate %s", pred)
+	}
+	return s.Node.proposeAndWait(ctx, &pb.ZeroProposal{
+		Pin: &pb.Tablet{Predicate: pred, GroupId: gid},
+	})
+}
+
+// movePinnedTablet moves pred to gid, the group it was pinned to, if it's served by another group,
+// instead of waiting for the balancer to do it.
+func (s *Server) movePinnedTablet(pred string, gid uint32) error {
+	tab := s.ServingTablet(pred)
+	if tab == nil || tab.GroupId == gid {
+		return nil
+	}
+	return s.movePredicate(pred, tab.GroupId, gid)
+}
+
+// zoneHasQuorum returns whether adding m to group would put a quorum of the replicas of the group
+// in the zone of m, so that losing that zone would make the group unavailable.
+func (s *Server) zoneHasQuorum(group *pb.Group, m *pb.Member) bool {
+	if m.Zone == "" || s.NumReplicas <= 1 {
+		return false
+	}
+	count := 1
+	for _, member := range group.GetMembers() {
+		if member.Id != m.Id && member.Zone == m.Zone {
+			count++
+		}
+	}
+	return count > s.NumReplicas/2
+}
+
 func (s *Server) blockTablet(pred string) func() {
 	s.blockCommitsOn.Store(pred, struct{}{})
 	return func() {
@@ -388,9 +448,8 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 			continue
 		}
 
-		s := float64(srcTablet.OnDiskBytes)
-		d := float64(dstTablet.OnDiskBytes)
-		if dstTablet.Remove || (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1) {
+		if dstTablet.Remove || tabletStatsChanged(srcTablet, dstTablet) ||
+			tabletRatesChanged(srcTablet, dstTablet) {
 			dstTablet.Force = false
 			proposal := &pb.ZeroProposal{
 				Tablet: dstTablet,
@@ -401,6 +460,32 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 	return res, nil
 }
 
+// tabletStatsChanged tells whether the size, key count or split count of the tablet dst moved
+// by more than 10% away from the ones of src, so that they're worth proposing.
+func tabletStatsChanged(src, dst *pb.Tablet) bool {
+	changed := func(s, d float64) bool {
+		return (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1)
+	}
+	return changed(float64(src.OnDiskBytes), float64(dst.OnDiskBytes)) ||
+		changed(float64(src.KeyCount), float64(dst.KeyCount)) ||
+		changed(float64(src.SplitCount), float64(dst.SplitCount))
+}
+
+// minRateChange is the change of a read or write rate, per second, below which it isn't worth
+// proposing, however large it is relative to the rate.
+const minRateChange = 1.0
+
+// tabletRatesChanged tells whether the read or write rate of the tablet dst moved by more than
+// a quarter and more than minRateChange away from the one of src. The rates change with every
+// report, even smoothed, so proposing every small change would propose every tablet each time.
+func tabletRatesChanged(src, dst *pb.Tablet) bool {
+	changed := func(s, d float64) bool {
+		diff := math.Abs(d - s)
+		return diff > minRateChange && diff > 0.25*s
+	}
+	return changed(src.ReadRate, dst.ReadRate) || changed(src.WriteRate, dst.WriteRate)
+}
+
 // removeNode removes the given node from the given group.
 // It's the user's responsibility to ensure that node doesn't come back again
 // before calling the api.
@@ -494,74 +579,10 @@ func (s *Server) Connect(ctx context.Context,
 	// Create a connection and check validity of the address by doing an Echo.
 	conn.GetPools().Connect(m.Addr, s.tlsClientConfig)
 
-	createProposal := func() *pb.ZeroProposal {
-		s.Lock()
-		defer s.Unlock()
-
-		proposal := new(pb.ZeroProposal)
-		// Check if we already have this member.
-		for _, group := range s.state.Groups {
-			if _, has := group.Members[m.Id]; has {
-				return nil
-			}
-		}
-		if m.Id == 0 {
-			// In certain situations, the proposal can be sent and return with an error.
-			// However,  Dgraph will keep retrying the proposal. To avoid assigning duplicating
-			// IDs, the couter is incremented every time a proposal is created.
-			m.Id = s.nextRaftId
-			s.nextRaftId += 1
-			proposal.MaxRaftId = m.Id
-		} else if m.Id >= s.nextRaftId {
-			s.nextRaftId = m.Id + 1
-			proposal.MaxRaftId = m.Id
-		}
-
-		// We don't have this member. So, let's see if it has preference for a group.
-		if m.GroupId > 0 {
-			group, has := s.state.Groups[m.GroupId]
-			if !has {
-				// We don't have this group. Add the server to this group.
-				proposal.Member = m
-				return proposal
-			}
-
-			if _, has := group.Members[m.Id]; has {
-				proposal.Member = m // Update in case some fields have changed, like address.
-				return proposal
-			}
-
-			// We don't have this server in the list.
-			if len(group.Members) < s.NumReplicas {
-				// We need more servers here, so let's add it.
-				proposal.Member = m
-				return proposal
-			} else if m.ForceGroupId {
-				// If the group ID was taken from the group_id file, force the member
-				// to be in this group even if the group is at capacity. This should
-				// not happen if users properly initialize a cluster after a bulk load.
-				proposal.Member = m
-				return proposal
-			}
-			// Already have plenty of servers serving this group.
-		}
-		// Let's assign this server to a new group.
-		for gid, group := range s.state.Groups {
-			if len(group.Members) < s.NumReplicas {
-				m.GroupId = gid
-				proposal.Member = m
-				return proposal
-			}
-		}
-		// We either don't have any groups, or don't have any groups which need another member.
-		m.GroupId = s.nextGroup
-		// We shouldn't increase nextGroup here as we don't know whether we have enough
-		// replicas until proposal is committed and can cause issues due to race.
-		proposal.Member = m
-		return proposal
+	proposal, err := s.createMemberProposal(m)
+	if err != nil {
+		return &emptyConnectionState, err
 	}
-
-	proposal := createProposal()
 	if proposal == nil {
 		return &pb.ConnectionState{
 			State: ms, Member: m,
@@ -584,6 +605,100 @@ func (s *Server) Connect(ctx context.Context,
 	return resp, nil
 }
 
+// createMemberProposal returns the proposal that adds m to a group, or nil if m is already in
+// one. It refuses to start a new group while the groups that need more replicas can't take m
+// because of its zone.
+func (s *Server) createMemberProposal(m *pb.Member) (*pb.ZeroProposal, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	proposal := new(pb.ZeroProposal)
+	// Check if we already have this member.
+	for _, group := range s.state.Groups {
+		if _, has := group.Members[m.Id]; has {
+			return nil, nil
+		}
+	}
+	if m.Id == 0 {
+		// In certain situations, the proposal can be sent and return with an error.
+		// However,  Dgraph will keep retrying the proposal. To avoid assigning duplicating
+		// IDs, the couter is incremented every time a proposal is created.
+		m.Id = s.nextRaftId
+		s.nextRaftId += 1
+		proposal.MaxRaftId = m.Id
+	} else if m.Id >= s.nextRaftId {
+		s.nextRaftId = m.Id + 1
+		proposal.MaxRaftId = m.Id
+	}
+
+	// We don't have this member. So, let's see if it has preference for a group.
+	if m.GroupId > 0 {
+		group, has := s.state.Groups[m.GroupId]
+		if !has {
+			// We don't have this group. Add the server to this group.
+			proposal.Member = m
+			return proposal, nil
+		}
+
+		if _, has := group.Members[m.Id]; has {
+			proposal.Member = m // Update in case some fields have changed, like address.
+			return proposal, nil
+		}
+
+		// We don't have this server in the list.
+		if m.ForceGroupId {
+			// If the group ID was taken from the group_id file, force the member
+			// to be in this group even if the group is at capacity. This should
+			// not happen if users properly initialize a cluster after a bulk load.
+			if s.zoneHasQuorum(group, m) {
+				glog.Warningf("Forcing member %d into group %d puts a quorum of it in zone %q",
+					m.Id, m.GroupId, m.Zone)
+			}
+			proposal.Member = m
+			return proposal, nil
+		} else if len(group.Members) < s.NumReplicas && !s.zoneHasQuorum(group, m) {
+			// We need more servers here, so let's add it.
+			proposal.Member = m
+			return proposal, nil
+		}
+		// Already have plenty of servers serving this group, or in the zone of this server.
+	}
+	// Let's assign this server to a new group.
+	var zoneFull []uint32
+	for gid, group := range s.state.Groups {
+		if len(group.Members) < s.NumReplicas {
+			if s.zoneHasQuorum(group, m) {
+				zoneFull = append(zoneFull, gid)
+				continue
+			}
+			m.GroupId = gid
+			proposal.Member = m
+			return proposal, nil
+		}
+	}
+	if len(zoneFull) > 0 {
+		// Starting a new group would leave these ones under-replicated, so the server waits
+		// until an alpha of another zone has joined them instead. Nothing was proposed, so
+		// the Raft ID given to it above can be given again.
+		if proposal.MaxRaftId != 0 && proposal.MaxRaftId == s.nextRaftId-1 {
+			s.nextRaftId--
+		}
+		return nil, errors.Errorf("ZONE_QUORUM: Groups %v need more replicas, but adding "+
+			"%s would put a quorum of them in zone %q. Start an Alpha in another zone first.",
+			zoneFull, m.Addr, m.Zone)
+	}
+	// We either don't have any groups, or all of them have all their replicas, so this
+	// server starts a new one.
+	m.GroupId = s.nextGroup
+	for s.state.Groups[m.GroupId] != nil {
+		m.GroupId++
+	}
+	// We shouldn't increase nextGroup here as we don't know whether we have enough
+	// replicas until proposal is committed and can cause issues due to race.
+	proposal.Member = m
+	return proposal, nil
+}
+
 // ShouldServe returns the tablet serving the predicate passed in the request.
 func (s *Server) ShouldServe(
 	ctx context.Context, tablet *pb.Tablet) (resp *pb.Tablet, err error) {
@@ -613,9 +728,24 @@ func (s *Server) ShouldServe(
 		return &pb.Tablet{}, nil
 	}
 
-	// Set the tablet to be served by this server's group.
+	// Set the tablet to be served by this server's group, unless it's pinned to another one.
 	var proposal pb.ZeroProposal
 
+	s.RLock()
+	if gid := s.pinnedGroup(tablet.Predicate); gid != 0 {
+		if _, ok := s.state.Groups[gid]; ok {
+			tablet.GroupId = gid
+		}
+	}
+	if s.draining[tablet.GroupId] {
+		// A node of the group is being drained, and it may be the last one, so serve the
+		// tablet from another group.
+		if gid := s.drainTarget(tablet.GroupId); gid != 0 {
+			tablet.GroupId = gid
+		}
+	}
+	s.RUnlock()
+
 	if x.IsReservedPredicate(tablet.Predicate) {
 		// Force all the reserved predicates to be allocated to group 1.
 		// This is to make it easier to stream ACL updates to all alpha servers
@@ -677,15 +807,18 @@ func (s *Server) UpdateMembership(ctx context.Context, group *pb.Group) (*api.Pa
 	if len(group.Members) == 0 {
 		return &api.Payload{Data: []byte("OK")}, nil
 	}
-	select {
-	case s.moveOngoing <- struct{}{}:
-	default:
-		// If a move is going on, don't do the next steps of deleting predicates.
-		return &api.Payload{Data: []byte("OK")}, nil
+	// If a move is going on, don't do the next steps of deleting predicates. Taking all the move
+	// slots keeps new moves from starting until we're done.
+	for i := 0; i < cap(s.moveOngoing); i++ {
+		select {
+		case s.moveOngoing <- struct{}{}:
+			defer func() {
+				<-s.moveOngoing
+			}()
+		default:
+			return &api.Payload{Data: []byte("OK")}, nil
+		}
 	}
-	defer func() {
-		<-s.moveOngoing
-	}()
 
 	if err := s.deletePredicates(ctx, group); err != nil {
 		glog.Warningf("While deleting predicates: %v", err)
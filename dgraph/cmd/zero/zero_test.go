@@ -18,9 +18,16 @@ package zero
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +42,406 @@ func TestRemoveNode(t *testing.T) {
 	err = server.removeNode(context.TODO(), 1, 2)
 	require.Error(t, err)
 }
+
+func TestTabletStatsChanged(t *testing.T) {
+	src := &pb.Tablet{OnDiskBytes: 1000, KeyCount: 100, SplitCount: 2, ReadRate: 10}
+	require.False(t, tabletStatsChanged(src, &pb.Tablet{OnDiskBytes: 1050, KeyCount: 105,
+		SplitCount: 2, ReadRate: 10.5}))
+	require.True(t, tabletStatsChanged(src, &pb.Tablet{OnDiskBytes: 1200, KeyCount: 100,
+		SplitCount: 2, ReadRate: 10}))
+	require.True(t, tabletStatsChanged(src, &pb.Tablet{OnDiskBytes: 1000, KeyCount: 100,
+		SplitCount: 3, ReadRate: 10}))
+	// The rates are compared on their own.
+	require.False(t, tabletStatsChanged(src, &pb.Tablet{OnDiskBytes: 1000, KeyCount: 100,
+		SplitCount: 2}))
+}
+
+func TestTabletRatesChanged(t *testing.T) {
+	src := &pb.Tablet{ReadRate: 10, WriteRate: 0.01}
+	require.False(t, tabletRatesChanged(src, &pb.Tablet{ReadRate: 12, WriteRate: 0.01}))
+	require.True(t, tabletRatesChanged(src, &pb.Tablet{ReadRate: 13, WriteRate: 0.01}))
+	require.True(t, tabletRatesChanged(src, &pb.Tablet{ReadRate: 5, WriteRate: 0.01}))
+	// Changes below a read or write per second aren't proposed, however large relatively.
+	require.False(t, tabletRatesChanged(src, &pb.Tablet{ReadRate: 10, WriteRate: 0.9}))
+	require.False(t, tabletRatesChanged(&pb.Tablet{}, &pb.Tablet{ReadRate: 0.5}))
+	require.True(t, tabletRatesChanged(&pb.Tablet{}, &pb.Tablet{ReadRate: 2}))
+}
+
+func TestHandlePinProposal(t *testing.T) {
+	server := &Server{state: &pb.MembershipState{}}
+	n := &node{server: server}
+	server.Lock()
+	defer server.Unlock()
+
+	n.handlePinProposal(&pb.Tablet{Predicate: "name", GroupId: 2})
+	n.handlePinProposal(&pb.Tablet{Predicate: "age", GroupId: 1})
+	require.Equal(t, uint32(2), server.pinnedGroup("name"))
+	require.Equal(t, uint32(1), server.pinnedGroup("age"))
+	require.Equal(t, uint32(0), server.pinnedGroup("friend"))
+
+	n.handlePinProposal(&pb.Tablet{Predicate: "name", GroupId: 3})
+	require.Equal(t, uint32(3), server.pinnedGroup("name"))
+	require.Len(t, server.state.Pinned, 2)
+
+	n.handlePinProposal(&pb.Tablet{Predicate: "name"})
+	require.Equal(t, uint32(0), server.pinnedGroup("name"))
+	require.Len(t, server.state.Pinned, 1)
+}
+
+func TestHandleSnapshotHoldsProposal(t *testing.T) {
+	server := &Server{state: &pb.MembershipState{}}
+	n := &node{server: server}
+	server.Lock()
+	defer server.Unlock()
+
+	now := time.Now()
+	require.False(t, server.hasSnapshotHolds(now))
+	n.handleSnapshotHoldsProposal(map[uint64]int64{
+		10: now.Add(time.Hour).Unix(),
+		20: now.Add(-time.Minute).Unix(),
+	})
+	require.Len(t, server.state.SnapshotHolds, 2)
+	require.True(t, server.hasSnapshotHolds(now))
+	server.Unlock()
+	require.True(t, server.SnapshotHeld())
+	server.Lock()
+	require.Equal(t, map[uint64]int64{20: 0}, server.expiredHolds(now))
+
+	n.handleSnapshotHoldsProposal(map[uint64]int64{10: 0, 20: 0})
+	require.Empty(t, server.state.SnapshotHolds)
+	require.False(t, server.hasSnapshotHolds(now))
+}
+
+func TestNoMovesWhileSnapshotHeld(t *testing.T) {
+	server := &Server{
+		state: &pb.MembershipState{
+			Groups: map[uint32]*pb.Group{
+				1: {
+					Members: map[uint64]*pb.Member{1: {Id: 1, Leader: true}},
+					Tablets: map[string]*pb.Tablet{"name": {GroupId: 1, Predicate: "name"}},
+				},
+				2: {Members: map[uint64]*pb.Member{2: {Id: 2, Leader: true}}},
+			},
+			SnapshotHolds: map[uint64]int64{10: time.Now().Add(time.Hour).Unix()},
+		},
+		draining: make(map[uint32]bool),
+	}
+
+	// Pinning doesn't move the tablet.
+	require.Equal(t, errSnapshotHeld, server.movePinnedTablet("name", 2))
+
+	// Neither does draining, so the node isn't removed either.
+	require.Error(t, server.drainNode(1, 1, true))
+	require.Equal(t, uint32(1), server.ServingTablet("name").GroupId)
+	require.Contains(t, server.state.Groups[1].Members, uint64(1))
+}
+
+func TestPlanLeaderMoves(t *testing.T) {
+	// Three groups with a replica on each of three hosts, all led from host a.
+	state := &pb.MembershipState{Groups: map[uint32]*pb.Group{}}
+	for gid := uint32(1); gid <= 3; gid++ {
+		members := make(map[uint64]*pb.Member)
+		for i, host := range []string{"a", "b", "c"} {
+			id := uint64(gid)*10 + uint64(i)
+			members[id] = &pb.Member{Id: id, GroupId: gid, Addr: host + ":7080", Leader: i == 0}
+		}
+		state.Groups[gid] = &pb.Group{Members: members}
+	}
+	all := func(*pb.Member) bool { return true }
+
+	moves := planLeaderMoves(state, nil, all)
+	require.Equal(t, []leaderMove{{Group: 1, From: 10, To: 11}, {Group: 2, From: 20, To: 22}},
+		moves)
+
+	// Once the leaders are spread, there's nothing to move.
+	for _, move := range moves {
+		state.Groups[move.Group].Members[move.From].Leader = false
+		state.Groups[move.Group].Members[move.To].Leader = true
+	}
+	require.Empty(t, planLeaderMoves(state, nil, all))
+
+	// The draining groups and the members that can't lead are left alone.
+	state.Groups[2].Members[20].Leader, state.Groups[2].Members[22].Leader = true, false
+	require.Equal(t, []leaderMove{{Group: 2, From: 20, To: 22}}, planLeaderMoves(state, nil, all))
+	require.Equal(t, []leaderMove{{Group: 3, From: 30, To: 32}},
+		planLeaderMoves(state, map[uint32]bool{2: true}, all))
+	require.Equal(t, []leaderMove{{Group: 3, From: 30, To: 32}},
+		planLeaderMoves(state, nil, func(m *pb.Member) bool { return m.Id != 22 }))
+}
+
+func TestMembershipEvents(t *testing.T) {
+	old := &pb.MembershipState{
+		Zeros: map[uint64]*pb.Member{1: {Id: 1, Addr: "zero1:5080", Leader: true}},
+		Groups: map[uint32]*pb.Group{
+			1: {
+				Members: map[uint64]*pb.Member{
+					1: {Id: 1, Addr: "alpha1:7080", Leader: true},
+					2: {Id: 2, Addr: "alpha2:7080"},
+				},
+				Tablets: map[string]*pb.Tablet{"name": {}, "age": {}},
+			},
+		},
+	}
+	cur := proto.Clone(old).(*pb.MembershipState)
+	require.Empty(t, membershipEvents(old, cur, time.Time{}))
+
+	delete(cur.Groups[1].Members, 1)
+	cur.Groups[1].Members[2].Leader = true
+	delete(cur.Groups[1].Tablets, "age")
+	cur.Groups[2] = &pb.Group{
+		Members: map[uint64]*pb.Member{3: {Id: 3, Addr: "alpha3:7080"}},
+		Tablets: map[string]*pb.Tablet{"age": {}},
+	}
+	require.Equal(t, []webhookEvent{
+		{Event: eventNodeLeft, Group: 1, Node: 1, Addr: "alpha1:7080"},
+		{Event: eventLeaderChanged, Group: 1, Node: 2, Addr: "alpha2:7080"},
+		{Event: eventGroupCreated, Group: 2},
+		{Event: eventNodeJoined, Group: 2, Node: 3, Addr: "alpha3:7080"},
+		{Event: eventTabletMoved, Group: 2, Predicate: "age", FromGroup: 1},
+	}, membershipEvents(old, cur, time.Time{}))
+}
+
+func TestWebhookQueueRetries(t *testing.T) {
+	defer func(d time.Duration) { webhookBackoff = d }(webhookBackoff)
+	webhookBackoff = time.Millisecond
+
+	var calls int32
+	received := make(chan webhookEvent, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first event fails twice before it's accepted.
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var event webhookEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err == nil {
+			received <- event
+		}
+	}))
+	defer server.Close()
+
+	q := newWebhookQueue(server.Client(), server.URL)
+	q.push(webhookEvent{Event: eventNodeJoined, Node: 1})
+	q.push(webhookEvent{Event: eventNodeLeft, Node: 1})
+	for _, want := range []string{eventNodeJoined, eventNodeLeft} {
+		select {
+		case event := <-received:
+			require.Equal(t, want, event.Event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s event wasn't delivered", want)
+		}
+	}
+	require.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestWebhookQueueGivesUp(t *testing.T) {
+	defer func(d time.Duration) { webhookBackoff = d }(webhookBackoff)
+	webhookBackoff = time.Millisecond
+
+	tests := []struct {
+		status int
+		calls  int32
+	}{
+		// A URL that keeps failing gets webhookAttempts of them.
+		{status: http.StatusInternalServerError, calls: int32(webhookAttempts)},
+		// One that rejects the event isn't asked again.
+		{status: http.StatusBadRequest, calls: 1},
+	}
+	for _, tcase := range tests {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(tcase.status)
+		}))
+		q := &webhookQueue{client: server.Client(), url: server.URL}
+		q.deliver(webhookEvent{Event: eventNodeJoined})
+		require.Equal(t, tcase.calls, atomic.LoadInt32(&calls), "status %d", tcase.status)
+		server.Close()
+	}
+}
+
+func TestWebhookQueueFull(t *testing.T) {
+	defer func(n int) { webhookQueueSize = n }(webhookQueueSize)
+	webhookQueueSize = 1
+
+	// The queue isn't run, so nothing takes the events out of it.
+	q := &webhookQueue{url: "http://webhook", events: make(chan webhookEvent, webhookQueueSize)}
+	q.push(webhookEvent{Event: eventNodeJoined})
+	q.push(webhookEvent{Event: eventNodeLeft})
+	require.Len(t, q.events, 1)
+	require.Equal(t, eventNodeJoined, (<-q.events).Event)
+}
+
+func TestValidateHoldTTL(t *testing.T) {
+	defer func(d time.Duration) { opts.maxSnapshotHold = d }(opts.maxSnapshotHold)
+	opts.maxSnapshotHold = 24 * time.Hour
+
+	require.NoError(t, validateHoldTTL(time.Hour))
+	require.NoError(t, validateHoldTTL(24*time.Hour))
+	require.Error(t, validateHoldTTL(0))
+	require.Error(t, validateHoldTTL(-time.Minute))
+	err := validateHoldTTL(25 * time.Hour)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at most 24h0m0s")
+}
+
+func TestZoneHasQuorum(t *testing.T) {
+	server := &Server{NumReplicas: 3}
+	group := &pb.Group{Members: map[uint64]*pb.Member{
+		1: {Id: 1, Zone: "a"},
+		2: {Id: 2, Zone: "b"},
+	}}
+	require.True(t, server.zoneHasQuorum(group, &pb.Member{Id: 3, Zone: "a"}))
+	require.False(t, server.zoneHasQuorum(group, &pb.Member{Id: 3, Zone: "c"}))
+	require.False(t, server.zoneHasQuorum(group, &pb.Member{Id: 3}))
+	// A member already in the group isn't counted twice.
+	require.False(t, server.zoneHasQuorum(group, &pb.Member{Id: 1, Zone: "a"}))
+	require.False(t, server.zoneHasQuorum(newGroup(), &pb.Member{Id: 3, Zone: "a"}))
+
+	server.NumReplicas = 1
+	require.False(t, server.zoneHasQuorum(group, &pb.Member{Id: 3, Zone: "a"}))
+}
+
+func TestCreateMemberProposalZones(t *testing.T) {
+	server := &Server{NumReplicas: 3, nextRaftId: 2, nextGroup: 2}
+	server.state = &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		1: {Members: map[uint64]*pb.Member{1: {Id: 1, GroupId: 1, Zone: "a"}}},
+	}}
+
+	// Group 1 needs replicas, but can't take another one from zone a, and a new group isn't
+	// started instead.
+	_, err := server.createMemberProposal(&pb.Member{Addr: "alpha2", Zone: "a"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ZONE_QUORUM")
+	require.Equal(t, uint64(2), server.nextRaftId)
+
+	proposal, err := server.createMemberProposal(&pb.Member{Addr: "alpha3", Zone: "b"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), proposal.Member.GroupId)
+	require.Equal(t, uint64(2), proposal.Member.Id)
+
+	// Once all the groups have all their replicas, a new group is started.
+	server.state.Groups[1].Members[2] = &pb.Member{Id: 2, GroupId: 1, Zone: "b"}
+	server.state.Groups[1].Members[3] = &pb.Member{Id: 3, GroupId: 1, Zone: "c"}
+	server.nextRaftId = 4
+	proposal, err = server.createMemberProposal(&pb.Member{Addr: "alpha4", Zone: "a"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), proposal.Member.GroupId)
+}
+
+func TestMoveWindow(t *testing.T) {
+	at := func(hour, min int) time.Time {
+		return time.Date(2021, 3, 1, hour, min, 0, 0, time.UTC)
+	}
+
+	w, err := parseMoveWindow("")
+	require.NoError(t, err)
+	require.True(t, w.contains(at(12, 0)))
+
+	w, err = parseMoveWindow("01:30-05:00")
+	require.NoError(t, err)
+	require.Equal(t, "01:30-05:00 UTC", w.String())
+	require.False(t, w.contains(at(1, 29)))
+	require.True(t, w.contains(at(1, 30)))
+	require.True(t, w.contains(at(4, 59)))
+	require.False(t, w.contains(at(5, 0)))
+	require.True(t, w.contains(time.Date(2021, 3, 1, 4, 0, 0, 0, time.FixedZone("", 3600))))
+
+	// The window can go past midnight.
+	w, err = parseMoveWindow("22:00-06:00")
+	require.NoError(t, err)
+	require.True(t, w.contains(at(23, 0)))
+	require.True(t, w.contains(at(0, 0)))
+	require.False(t, w.contains(at(6, 0)))
+	require.False(t, w.contains(at(12, 0)))
+
+	for _, s := range []string{"22:00", "22:00-25:00", "10:00-10:00"} {
+		_, err = parseMoveWindow(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestStartDrain(t *testing.T) {
+	server := &Server{
+		state: &pb.MembershipState{Groups: map[uint32]*pb.Group{
+			1: {
+				Members: map[uint64]*pb.Member{1: {Id: 1, Leader: true}},
+				Tablets: map[string]*pb.Tablet{"dgraph.type": {OnDiskBytes: 10}},
+			},
+			2: {
+				Members: map[uint64]*pb.Member{2: {Id: 2, Leader: true}},
+				Tablets: map[string]*pb.Tablet{"name": {OnDiskBytes: 100}},
+			},
+			3: {
+				Members: map[uint64]*pb.Member{3: {Id: 3}},
+			},
+		}},
+		draining: make(map[uint32]bool),
+	}
+	require.Error(t, server.startDrain(2, 1))
+	require.Error(t, server.startDrain(4, 2))
+	// The last node of group 1 serves the reserved predicates.
+	require.Error(t, server.startDrain(1, 1))
+
+	server.RLock()
+	require.Equal(t, uint32(1), server.drainTarget(2))
+	server.RUnlock()
+
+	// Groups being drained, and groups without a leader, don't get tablets.
+	server.draining[1] = true
+	server.RLock()
+	require.Equal(t, uint32(0), server.drainTarget(2))
+	server.RUnlock()
+	require.Error(t, server.startDrain(2, 2))
+}
+
+func TestStartDrainKeepsQuorum(t *testing.T) {
+	members := make(map[uint64]*pb.Member)
+	for id := uint64(1); id <= 3; id++ {
+		members[id] = &pb.Member{Id: id, Leader: id == 1}
+	}
+	server := &Server{
+		NumReplicas: 3,
+		state: &pb.MembershipState{Groups: map[uint32]*pb.Group{
+			1: {Members: members},
+		}},
+		draining: make(map[uint32]bool),
+	}
+
+	// Only one node of a group is drained at a time, even if it isn't the last one.
+	server.Lock()
+	server.draining[1] = true
+	server.Unlock()
+	require.Error(t, server.startDrain(2, 1))
+
+	// Two of the three replicas are a majority, but one isn't.
+	server.Lock()
+	delete(server.draining, 1)
+	delete(members, 3)
+	server.Unlock()
+	err := server.startDrain(2, 1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "would be left with 1 of its 3 replicas")
+}
+
+func TestUpgradeStatus(t *testing.T) {
+	server := &Server{state: &pb.MembershipState{
+		Zeros:  map[uint64]*pb.Member{1: {Id: 1, Addr: "zero1:5080"}},
+		Groups: map[uint32]*pb.Group{},
+	}}
+	status := server.upgradeStatus(1)
+	require.True(t, status.Upgraded)
+	require.Equal(t, x.ProtocolVersion, status.ProtocolVersion)
+	require.Equal(t, []string{x.Version()}, status.Versions)
+
+	// An alpha that hasn't sent a heartbeat could be of any version.
+	server.state.Groups[1] = &pb.Group{Members: map[uint64]*pb.Member{
+		1: {Id: 1, GroupId: 1, Addr: "alpha1:7080"},
+	}}
+	status = server.upgradeStatus(1)
+	require.False(t, status.Upgraded)
+	require.Equal(t, uint32(0), status.ProtocolVersion)
+	require.Len(t, status.Nodes, 2)
+	require.Equal(t, uint32(1), status.Nodes[1].Group)
+	require.False(t, status.Nodes[1].Healthy)
+}
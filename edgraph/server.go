@@ -89,6 +89,10 @@ const (
 var (
 	numGraphQLPM uint64
 	numGraphQL   uint64
+
+	// numPendingQueries is the number of queries being run, checked against the
+	// max_pending_queries limit.
+	numPendingQueries int64
 )
 
 var (
@@ -201,6 +205,57 @@ func UpdateGQLSchema(ctx context.Context, gqlSchema,
 	})
 }
 
+// ValidateDgraphSchema checks that dgraphSchema, the Dgraph schema generated for a GraphQL
+// schema, could be applied to the current Dgraph schema, without applying it. oldDgraphSchema
+// is the one generated for the GraphQL schema being replaced. It returns warnings about what
+// applying it would do, like predicates that would be reindexed.
+//
+// Changes to predicates are only checked against the predicates this alpha serves.
+func ValidateDgraphSchema(ctx context.Context, dgraphSchema, oldDgraphSchema string) ([]string,
+	error) {
+	result := &schema.ParsedSchema{}
+	if dgraphSchema != "" {
+		var err error
+		if result, err = parseAlterSchema(dgraphSchema); err != nil {
+			return nil, err
+		}
+	}
+
+	var warnings []string
+	if oldResult, err := schema.Parse(oldDgraphSchema); err == nil {
+		preds := make(map[string]bool)
+		for _, update := range result.Preds {
+			preds[update.Predicate] = true
+		}
+		for _, update := range oldResult.Preds {
+			if !preds[update.Predicate] {
+				warnings = append(warnings, fmt.Sprintf("Predicate %s is no longer in the "+
+					"GraphQL schema. Its data is kept in Dgraph, but can't be reached "+
+					"through GraphQL.", update.Predicate))
+			}
+		}
+	}
+	for _, update := range result.Preds {
+		if err := worker.CheckSchema(update); err != nil {
+			return nil, err
+		}
+		old, ok := schema.State().Get(ctx, update.Predicate)
+		if !ok {
+			continue
+		}
+		oldTokenizers := append([]string(nil), old.Tokenizer...)
+		newTokenizers := append([]string(nil), update.Tokenizer...)
+		sort.Strings(oldTokenizers)
+		sort.Strings(newTokenizers)
+		if strings.Join(oldTokenizers, ",") != strings.Join(newTokenizers, ",") ||
+			old.Count != update.Count || old.Directive != update.Directive {
+			warnings = append(warnings, fmt.Sprintf("Predicate %s will be reindexed, which "+
+				"can take a while if it has a lot of data.", update.Predicate))
+		}
+	}
+	return warnings, nil
+}
+
 // validateAlterOperation validates the given operation for alter.
 func validateAlterOperation(ctx context.Context, op *api.Operation) error {
 	// The following code block checks if the operation should run or not.
@@ -240,8 +295,13 @@ func parseSchemaFromAlterOperation(op *api.Operation) (*schema.ParsedSchema, err
 	if schema.State().IndexingInProgress() {
 		return nil, errIndexingInProgress
 	}
+	return parseAlterSchema(op.Schema)
+}
 
-	result, err := schema.Parse(op.Schema)
+// parseAlterSchema parses the schema of an alter operation, and checks that it doesn't change
+// pre-defined or reserved predicates and types.
+func parseAlterSchema(s string) (*schema.ParsedSchema, error) {
+	result, err := schema.Parse(s)
 	if err != nil {
 		return nil, err
 	}
@@ -939,18 +999,24 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 	}
 
 	// Append self.
+	status := "healthy"
+	if worker.SafetyCheck() != nil {
+		status = "unhealthy"
+	}
 	healthAll = append(healthAll, pb.HealthInfo{
-		Instance:    "alpha",
-		Address:     x.WorkerConfig.MyAddr,
-		Status:      "healthy",
-		Group:       strconv.Itoa(int(worker.GroupId())),
-		Version:     x.Version(),
-		Uptime:      int64(time.Since(x.WorkerConfig.StartTime) / time.Second),
-		LastEcho:    time.Now().Unix(),
-		Ongoing:     worker.GetOngoingTasks(),
-		Indexing:    schema.GetIndexingPredicates(),
-		EeFeatures:  ee.GetEEFeaturesList(),
-		MaxAssigned: posting.Oracle().MaxAssigned(),
+		Instance:         "alpha",
+		Address:          x.WorkerConfig.MyAddr,
+		Status:           status,
+		Group:            strconv.Itoa(int(worker.GroupId())),
+		Version:          x.Version(),
+		Uptime:           int64(time.Since(x.WorkerConfig.StartTime) / time.Second),
+		LastEcho:         time.Now().Unix(),
+		Ongoing:          worker.GetOngoingTasks(),
+		Indexing:         schema.GetIndexingPredicates(),
+		EeFeatures:       ee.GetEEFeaturesList(),
+		MaxAssigned:      posting.Oracle().MaxAssigned(),
+		LastAppliedIndex: worker.LastAppliedIndex(),
+		ProtocolVersion:  x.ProtocolVersion,
 	})
 
 	var err error
@@ -1047,6 +1113,18 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 
 	span.Annotatef(nil, "Request received: %v", req)
 	if isQuery {
+		pending := atomic.AddInt64(&numPendingQueries, 1)
+		defer atomic.AddInt64(&numPendingQueries, -1)
+		if limit := worker.MaxPendingQueries(); limit > 0 && pending > limit {
+			return nil, errors.Errorf("Too many pending queries: the limit of %d pending "+
+				"queries has been reached. Please retry later.", limit)
+		}
+		if timeout := worker.QueryTimeout(); timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
 		ostats.Record(ctx, x.PendingQueries.M(1), x.NumQueries.M(1))
 		defer func() {
 			measurements = append(measurements, x.PendingQueries.M(-1))
@@ -1102,6 +1180,21 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 	return resp, nil
 }
 
+// validateBestEffort returns an error if the best effort query req can't be run as it's asked
+// for.
+func validateBestEffort(req *api.Request, quorum bool) error {
+	// Sanity: check that request is read-only too.
+	if !req.ReadOnly {
+		return errors.Errorf("A best effort query must be read-only.")
+	}
+	// A best effort query reads at the latest timestamp this alpha knows of, which may be
+	// behind the latest commit however the groups are read.
+	if quorum {
+		return errors.Errorf("A best effort query can't be a quorum read.")
+	}
+	return nil
+}
+
 func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error) {
 	resp := &api.Response{}
 	if len(qc.req.Query) == 0 {
@@ -1120,6 +1213,7 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	qr := query.Request{
 		Latency:  qc.latency,
 		GqlQuery: &qc.gqlRes,
+		Quorum:   query.IsQuorum(ctx),
 	}
 
 	// Here we try our best effort to not contact Zero for a timestamp. If we succeed,
@@ -1135,9 +1229,8 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	}
 
 	if qc.req.BestEffort {
-		// Sanity: check that request is read-only too.
-		if !qc.req.ReadOnly {
-			return resp, errors.Errorf("A best effort query must be read-only.")
+		if err := validateBestEffort(qc.req, qr.Quorum); err != nil {
+			return resp, err
 		}
 		if qc.req.StartTs == 0 {
 			qc.req.StartTs = posting.Oracle().MaxAssigned()
@@ -1179,6 +1272,8 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 			respMap["types"] = formatTypes(er.Types)
 		}
 		resp.Json, err = json.Marshal(respMap)
+	} else if query.IsExplain(ctx) {
+		resp.Json, err = query.ToExplainJson(er.Subgraphs)
 	} else if qc.req.RespFormat == api.Request_RDF {
 		resp.Rdf, err = query.ToRDF(qc.latency, er.Subgraphs)
 	} else {
@@ -1602,3 +1697,22 @@ func isDropAll(op *api.Operation) bool {
 	}
 	return false
 }
+
+// DeleteEdges deletes the given edges, in transactions of up to 1000 edges each.
+func DeleteEdges(ctx context.Context, edges []*api.NQuad) error {
+	for len(edges) > 0 {
+		n := len(edges)
+		if n > 1000 {
+			n = 1000
+		}
+		req := &api.Request{
+			Mutations: []*api.Mutation{{Del: edges[:n]}},
+			CommitNow: true,
+		}
+		if _, err := (&Server{}).doQuery(ctx, req, NoAuthorize); err != nil {
+			return errors.Wrapf(err, "while deleting edges")
+		}
+		edges = edges[n:]
+	}
+	return nil
+}
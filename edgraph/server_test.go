@@ -17,10 +17,12 @@
 package edgraph
 
 import (
+	"context"
 	"testing"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/chunker"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
@@ -119,3 +121,43 @@ func TestValidateKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDgraphSchema(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		Person.name: string @index(exact) .
+		Person.age: int .
+		Person.friends: [uid] .`), 1))
+
+	oldSchema := `
+		Person.name: string @index(exact) .
+		Person.age: int .
+		Person.friends: [uid] .`
+
+	warnings, err := ValidateDgraphSchema(context.Background(), `
+		Person.name: string @index(exact, term) .
+		Person.age: int .`, oldSchema)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"Predicate Person.friends is no longer in the GraphQL schema. Its data is kept in " +
+			"Dgraph, but can't be reached through GraphQL.",
+		"Predicate Person.name will be reindexed, which can take a while if it has a lot " +
+			"of data.",
+	}, warnings)
+
+	warnings, err = ValidateDgraphSchema(context.Background(), oldSchema, oldSchema)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+
+	_, err = ValidateDgraphSchema(context.Background(), `dgraph.secret: string .`, oldSchema)
+	require.EqualError(t, err, "Can't alter predicate `dgraph.secret` as it is prefixed with "+
+		"`dgraph.` which is reserved as the namespace for dgraph's internal types/predicates.")
+}
+
+func TestValidateBestEffort(t *testing.T) {
+	require.NoError(t, validateBestEffort(&api.Request{BestEffort: true, ReadOnly: true}, false))
+	require.EqualError(t, validateBestEffort(&api.Request{BestEffort: true}, false),
+		"A best effort query must be read-only.")
+	require.EqualError(t,
+		validateBestEffort(&api.Request{BestEffort: true, ReadOnly: true}, true),
+		"A best effort query can't be a quorum read.")
+}
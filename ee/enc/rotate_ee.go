@@ -0,0 +1,110 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// RotateKey is the sub-command used to change the encryption key of the data of an alpha.
+var RotateKey x.SubCommand
+
+const newEncKeyFile = "new_encryption_key_file"
+
+func init() {
+	RotateKey.Cmd = &cobra.Command{
+		Use:   "rotate_key",
+		Short: "Change the encryption key of the postings and WAL directories (EE)",
+		Long: `
+Rotate_key changes the encryption key of the postings and WAL directories of an alpha that's
+been stopped. The data is encrypted with data keys, which are kept in a key registry that's
+encrypted with the encryption key given to the alpha. Only the key registry is encrypted again
+with the new key, so rotating the key doesn't depend on the size of the data. The data stays
+encrypted with the same data keys, and it's only encrypted with new ones as it's rewritten, like
+by compactions, once Badger makes a new data key.
+
+The alpha must be restarted with the new key. In a group with replicas, the alphas can be
+rotated one at a time, so that the group keeps serving queries.
+`,
+		Example: `
+$ dgraph rotate_key -p p -w w --encryption_key_file old.key --new_encryption_key_file new.key
+`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runRotateKey(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	RotateKey.EnvPrefix = "DGRAPH_ROTATE_KEY"
+
+	flag := RotateKey.Cmd.Flags()
+	flag.StringP("postings", "p", "", "Directory where posting lists are stored.")
+	flag.StringP("wal", "w", "", "Directory where the write-ahead log is stored.")
+	flag.String(newEncKeyFile, "", "The file that stores the new symmetric key of length 16, "+
+		"24, or 32 bytes.")
+	RegisterFlags(flag)
+	_ = RotateKey.Cmd.MarkFlagRequired(newEncKeyFile)
+}
+
+func runRotateKey() error {
+	oldKey, err := ReadKey(RotateKey.Conf)
+	if err != nil {
+		return err
+	}
+	if len(oldKey) == 0 {
+		return errors.Errorf("the current encryption key must be given")
+	}
+	lkr := &localKeyReader{keyFile: RotateKey.Conf.GetString(newEncKeyFile)}
+	newKey, err := lkr.readKey()
+	if err != nil {
+		return errors.Wrapf(err, "while reading the new encryption key")
+	}
+
+	dirs := []string{RotateKey.Conf.GetString("postings"), RotateKey.Conf.GetString("wal")}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		if err := rotateKeyRegistry(dir, oldKey, newKey); err != nil {
+			return err
+		}
+		fmt.Printf("Rotated the encryption key of %s\n", dir)
+	}
+	return nil
+}
+
+// rotateKeyRegistry encrypts the key registry in dir with newKey instead of oldKey. The registry
+// is written to a new file that's then renamed, so it's never left half written.
+func rotateKeyRegistry(dir string, oldKey, newKey x.SensitiveByteSlice) error {
+	if _, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName)); err != nil {
+		return errors.Wrapf(err, "while looking for the key registry in %s", dir)
+	}
+	opt := badger.KeyRegistryOptions{Dir: dir, ReadOnly: true, EncryptionKey: oldKey}
+	kr, err := badger.OpenKeyRegistry(opt)
+	if err != nil {
+		return errors.Wrapf(err, "while opening the key registry in %s", dir)
+	}
+	opt.ReadOnly = false
+	opt.EncryptionKey = newKey
+	return errors.Wrapf(badger.WriteKeyRegistry(kr, opt),
+		"while writing the key registry in %s", dir)
+}
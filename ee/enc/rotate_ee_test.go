@@ -0,0 +1,56 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKeyRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate_key")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	oldKey := []byte("1234567890123456")
+	newKey := []byte("abcdefghijklmnopqrstuvwx")
+	opt := badger.KeyRegistryOptions{Dir: dir, EncryptionKey: oldKey}
+	kr, err := badger.OpenKeyRegistry(opt)
+	require.NoError(t, err)
+	dk, err := kr.LatestDataKey()
+	require.NoError(t, err)
+	require.NoError(t, kr.Close())
+
+	require.Error(t, rotateKeyRegistry(dir, newKey, oldKey))
+	require.NoError(t, rotateKeyRegistry(dir, oldKey, newKey))
+
+	opt.ReadOnly = true
+	_, err = badger.OpenKeyRegistry(opt)
+	require.Equal(t, badger.ErrEncryptionKeyMismatch, err)
+
+	opt.EncryptionKey = newKey
+	kr, err = badger.OpenKeyRegistry(opt)
+	require.NoError(t, err)
+	got, err := kr.DataKey(dk.KeyId)
+	require.NoError(t, err)
+	require.Equal(t, dk.Data, got.Data)
+
+	empty, err := ioutil.TempDir("", "rotate_key")
+	require.NoError(t, err)
+	defer os.RemoveAll(empty)
+	require.Error(t, rotateKeyRegistry(empty, oldKey, newKey))
+}
@@ -65,7 +65,9 @@ type MathTree struct {
 
 func isUnary(f string) bool {
 	return f == "exp" || f == "ln" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" || f == "abs" ||
+		f == "sin" || f == "cos" || f == "tan" || f == "asin" || f == "acos" || f == "atan" ||
+		f == "lower" || f == "upper" || f == "trim"
 }
 
 func isBinaryMath(f string) bool {
@@ -73,7 +75,7 @@ func isBinaryMath(f string) bool {
 }
 
 func isTernary(f string) bool {
-	return f == "cond"
+	return f == "cond" || f == "substring" || f == "dateadd"
 }
 
 func isZero(f string, rval types.Val) bool {
@@ -163,7 +165,11 @@ func isMathFunc(f string) bool {
 		f == "==" || f == "!=" ||
 		f == "min" || f == "max" || f == "sqrt" ||
 		f == "pow" || f == "logbase" || f == "floor" || f == "ceil" ||
-		f == "since"
+		f == "since" || f == "abs" || f == "sin" || f == "cos" || f == "tan" ||
+		f == "asin" || f == "acos" || f == "atan" || f == "atan2" ||
+		f == "concat" || f == "substring" || f == "lower" || f == "upper" || f == "trim" ||
+		f == "datetrunc" || f == "datepart" || f == "dateadd" || f == "distance" ||
+		f == "jsonpath"
 }
 
 func parseMathFunc(it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
@@ -250,6 +256,14 @@ loop:
 				}
 				continue
 			}
+			if len(item.Val) > 0 && item.Val[0] == quote {
+				str, err := unquoteIfQuoted(item.Val)
+				if err != nil {
+					return nil, false, err
+				}
+				valueStack.push(&MathTree{Const: types.Val{Tid: types.StringID, Value: str}})
+				continue
+			}
 			// We will try to parse the constant as an Int first, if that fails we move to float
 			child := &MathTree{}
 			i, err := strconv.ParseInt(item.Val, 10, 64)
@@ -373,6 +387,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 				t.Const.Value.(float64), 'E', -1, 64))
 		case types.IntID:
 			leafStr, err = buf.WriteString(strconv.FormatInt(t.Const.Value.(int64), 10))
+		case types.StringID:
+			leafStr, err = buf.WriteString(strconv.Quote(t.Const.Value.(string)))
 		}
 		x.Check2(leafStr, err)
 		return
@@ -382,7 +398,9 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 	switch t.Fn {
 	case "+", "-", "/", "*", "%", "exp", "ln", "cond", "min",
 		"sqrt", "max", "<", ">", "<=", ">=", "==", "!=", "u-",
-		"logbase", "pow":
+		"logbase", "pow", "abs", "sin", "cos", "tan", "asin", "acos", "atan", "atan2",
+		"concat", "substring", "lower", "upper", "trim", "datetrunc", "datepart", "dateadd",
+		"distance", "jsonpath":
 		x.Check2(buf.WriteString(t.Fn))
 	default:
 		x.Fatalf("Unknown operator: %q", t.Fn)
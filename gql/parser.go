@@ -57,6 +57,8 @@ type GraphQuery struct {
 	NeedsVar   []VarContext
 	Func       *Function
 	Expand     string // Which variable to expand with.
+	// ExpandExcept holds the predicates that expand() leaves out.
+	ExpandExcept []string
 
 	Args map[string]string
 	// Query can have multiple sort parameters.
@@ -67,12 +69,15 @@ type GraphQuery struct {
 	Normalize        bool
 	Recurse          bool
 	RecurseArgs      RecurseArgs
+	Levels           *RecurseLevels
 	ShortestPathArgs ShortestPathArgs
 	Cascade          []string
 	IgnoreReflex     bool
+	Total            bool
 	Facets           *pb.FacetParams
 	FacetsFilter     *FilterTree
 	GroupbyAttrs     []GroupByAttr
+	GroupbyArgs      GroupbyArgs
 	FacetVar         map[string]string
 	FacetsOrder      []*FacetOrder
 
@@ -96,6 +101,15 @@ type RecurseArgs struct {
 	// argument in the substitution part.
 }
 
+// RecurseLevels stores the arguments of the @levels directive, which limits a predicate in a
+// @recurse block to the nodes at some levels of the recursion. The nodes at the root are at
+// level 1, the nodes they lead to at level 2, and so on.
+type RecurseLevels struct {
+	From uint64
+	// To is 0 if there's no upper bound.
+	To uint64
+}
+
 // ShortestPathArgs stores the arguments needed to process the shortest path query.
 type ShortestPathArgs struct {
 	// From, To can have a uid or a uid function as the argument.
@@ -113,6 +127,15 @@ type GroupByAttr struct {
 	Langs []string
 }
 
+// GroupbyArgs stores the ordering and pagination of the groups of a @groupby directive.
+type GroupbyArgs struct {
+	// Order holds the names of the aggregates, or of the grouping attributes, to order the
+	// groups by.
+	Order  []*pb.Order
+	First  int
+	Offset int
+}
+
 // FacetOrder stores ordering for single facet key.
 type FacetOrder struct {
 	Key  string
@@ -201,12 +224,31 @@ var mathOpPrecedence = map[string]int{
 	"exp":     100,
 	"ln":      99,
 	"sqrt":    98,
+	"abs":     97,
+	"sin":     96,
+	"cos":     95,
+	"tan":     94,
+	"asin":    93,
+	"acos":    92,
+	"atan":    91,
 	"cond":    90,
 	"pow":     89,
 	"logbase": 88,
+	"atan2":   87,
 	"max":     85,
 	"min":     84,
 
+	"lower":     83,
+	"upper":     82,
+	"trim":      81,
+	"concat":    80,
+	"substring": 79,
+	"datetrunc": 78,
+	"datepart":  77,
+	"dateadd":   76,
+	"distance":  75,
+	"jsonpath":  74,
+
 	"/": 50,
 	"*": 49,
 	"%": 48,
@@ -918,6 +960,58 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+func parseLevels(it *lex.ItemIterator, gq *GraphQuery) error {
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return it.Errorf("Expected ( after levels")
+	}
+
+	levels := &RecurseLevels{From: 1}
+	for it.Next() {
+		item := it.Item()
+		if item.Typ != itemName {
+			return item.Errorf("Expected key inside @levels()")
+		}
+		key := strings.ToLower(item.Val)
+
+		if ok := trySkipItemTyp(it, itemColon); !ok {
+			return it.Errorf("Expected colon(:) after %s", key)
+		}
+		if !it.Next() {
+			return it.Errorf("Expected argument")
+		}
+
+		item = it.Item()
+		if item.Typ != itemName {
+			return item.Errorf("Expected value inside @levels() for key: %s", key)
+		}
+		level, err := strconv.ParseUint(item.Val, 0, 64)
+		if err != nil || level == 0 {
+			return item.Errorf("Value inside %s should be a positive integer", key)
+		}
+		switch key {
+		case "from":
+			levels.From = level
+		case "to":
+			levels.To = level
+		default:
+			return item.Errorf("Unexpected key: [%s] inside @levels", key)
+		}
+
+		if _, ok := tryParseItemType(it, itemRightRound); ok {
+			if levels.To != 0 && levels.To < levels.From {
+				return item.Errorf("Level to: %d is lower than level from: %d inside @levels",
+					levels.To, levels.From)
+			}
+			gq.Levels = levels
+			return nil
+		}
+		if _, ok := tryParseItemType(it, itemComma); !ok {
+			return it.Errorf("Expected comma after value: %s inside @levels", item.Val)
+		}
+	}
+	return it.Errorf("Expected ) after @levels")
+}
+
 // getQuery creates a GraphQuery object tree by calling getRoot
 // and goDeep functions by looking at '{'.
 func getQuery(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
@@ -969,6 +1063,8 @@ L:
 				}
 			case "ignorereflex":
 				gq.IgnoreReflex = true
+			case "total":
+				gq.Total = true
 			case "recurse":
 				gq.Recurse = true
 				if err := parseRecurseArgs(it, gq); err != nil {
@@ -1619,7 +1715,7 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "jsonpath":
 		return true
 	}
 	return false
@@ -2206,6 +2302,20 @@ loop:
 			if err != nil {
 				return err
 			}
+			if peekIt[0].Typ == itemColon && isGroupbyArg(val) {
+				if alias != "" {
+					return item.Errorf("Expected predicate after %s:", alias)
+				}
+				it.Next() // Consume the itemColon
+				if !it.Next() || it.Item().Typ != itemName {
+					return item.Errorf("Expected a value after %s: in groupby", val)
+				}
+				if err := parseGroupbyArg(&gq.GroupbyArgs, val, it.Item().Val); err != nil {
+					return it.Item().Errorf("%v", err)
+				}
+				expectArg = false
+				continue
+			}
 			if peekIt[0].Typ == itemColon {
 				if alias != "" {
 					return item.Errorf("Expected predicate after %s:", alias)
@@ -2249,6 +2359,34 @@ loop:
 	return nil
 }
 
+func isGroupbyArg(key string) bool {
+	switch key {
+	case "orderasc", "orderdesc", "first", "offset":
+		return true
+	}
+	return false
+}
+
+func parseGroupbyArg(args *GroupbyArgs, key, val string) error {
+	switch key {
+	case "orderasc", "orderdesc":
+		args.Order = append(args.Order, &pb.Order{Attr: val, Desc: key == "orderdesc"})
+	case "first":
+		first, err := strconv.Atoi(val)
+		if err != nil || first <= 0 {
+			return errors.Errorf("Value inside first should be a positive integer in groupby")
+		}
+		args.First = first
+	case "offset":
+		offset, err := strconv.Atoi(val)
+		if err != nil || offset < 0 {
+			return errors.Errorf("Value inside offset should be a non-negative integer in groupby")
+		}
+		args.Offset = offset
+	}
+	return nil
+}
+
 // parseFilter parses the filter directive to produce a QueryFilter / parse tree.
 func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 	it.Next()
@@ -2442,6 +2580,13 @@ loop:
 			if !expectArg {
 				return item.Errorf("Expected a variable but got comma")
 			}
+			if next, ok := it.PeekOne(); ok && next.Typ == itemColon && item.Val == "except" {
+				// The type list ends with the comma before the except argument.
+				it.Prev()
+				it.Prev()
+				expectArg = false
+				break loop
+			}
 			typeList = fmt.Sprintf("%s,%s", typeList, item.Val)
 			expectArg = false
 		default:
@@ -2455,6 +2600,37 @@ loop:
 	return nil
 }
 
+// parseExpandExcept parses the except argument of expand(), like `, except: [name, age]`,
+// starting at the comma before it.
+func parseExpandExcept(it *lex.ItemIterator, gq *GraphQuery) error {
+	expected := []lex.ItemType{itemName, itemColon, itemLeftSquare}
+	for _, typ := range expected {
+		it.Next()
+		item := it.Item()
+		if item.Typ != typ || (typ == itemName && item.Val != "except") {
+			return item.Errorf("Expected except: [predicates] in expand(), but got %s", item.Val)
+		}
+	}
+
+	expectArg := true
+	for it.Next() {
+		item := it.Item()
+		switch {
+		case item.Typ == itemRightSquare && !expectArg:
+			return nil
+		case item.Typ == itemComma && !expectArg:
+			expectArg = true
+		case item.Typ == itemName && expectArg:
+			pred := collectName(it, item.Val)
+			gq.ExpandExcept = append(gq.ExpandExcept, pred)
+			expectArg = false
+		default:
+			return item.Errorf("Unexpected token %s in the except list of expand()", item.Val)
+		}
+	}
+	return it.Errorf("Expected ] to end the except list of expand()")
+}
+
 func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 	valid := true
 	it.Prev()
@@ -2542,6 +2718,13 @@ func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 			if err := parseGroupby(it, curp); err != nil {
 				return err
 			}
+		case "levels":
+			if curp.Levels != nil {
+				return item.Errorf("Only one levels directive allowed.")
+			}
+			if err := parseLevels(it, curp); err != nil {
+				return err
+			}
 		default:
 			return item.Errorf("Unknown directive [%s]", item.Val)
 		}
@@ -3110,7 +3293,16 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 						return err
 					}
 				}
-				it.Next() // Consume ')'
+				it.Next()
+				if it.Item().Typ == itemComma {
+					if err := parseExpandExcept(it, child); err != nil {
+						return err
+					}
+					it.Next()
+				}
+				if it.Item().Typ != itemRightRound {
+					return it.Item().Errorf("Invalid use of expand()")
+				}
 				gq.Children = append(gq.Children, child)
 				// Note: curp is not set to nil. So it can have children, filters, etc.
 				curp = child
@@ -3310,7 +3502,8 @@ func isMathBlock(name string) bool {
 }
 
 func isGeoFunc(name string) bool {
-	return name == "near" || name == "contains" || name == "within" || name == "intersects"
+	return name == "near" || name == "nearest" || name == "contains" || name == "within" ||
+		name == "intersects"
 }
 
 func IsInequalityFn(name string) bool {
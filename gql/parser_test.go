@@ -25,6 +25,7 @@ import (
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/chunker"
 	"github.com/dgraph-io/dgraph/lex"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -557,6 +558,67 @@ func TestParseQueryWithVarValAggLogSqrt(t *testing.T) {
 		res.Query[1].Children[0].Children[2].MathExp.debugString())
 }
 
+func TestParseQueryWithVarValAggString(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x0a)) {
+			n as name
+			d: math(concat(upper(trim(n)), concat(" \\\"", substring(lower(n), 0, 3))))
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.EqualValues(t,
+		`(concat (upper (trim n)) (concat " \\\"" (substring (lower n) 0 3)))`,
+		res.Query[0].Children[1].MathExp.debugString())
+}
+
+func TestParseQueryWithVarValAggDate(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x0a)) {
+			d as dob
+			month: math(datetrunc(d, "month"))
+			first: math(dateadd(d, 1 - datepart(d, "day"), "day"))
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.EqualValues(t, `(datetrunc d "month")`,
+		res.Query[0].Children[1].MathExp.debugString())
+	require.EqualValues(t, `(dateadd d (- 1 (datepart d "day")) "day")`,
+		res.Query[0].Children[2].MathExp.debugString())
+}
+
+func TestParseQueryWithVarValAggTrigAbs(t *testing.T) {
+	query := `
+	{
+		me(func: uid(L), orderasc: val(d) ) {
+			name
+			val(e)
+		}
+
+		var(func: uid(0x0a)) {
+			L as friends {
+				a as lat
+				b as long
+				d as math(2 * asin(sqrt(pow(sin(a / 2), 2) + cos(a) * pow(sin(b / 2), 2))))
+				e as math(abs(atan2(a, b) - atan(tan(a))) + acos(cos(b)))
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.EqualValues(t,
+		"(* 2 (asin (sqrt (+ (pow (sin (/ a 2)) 2) (* (cos a) (pow (sin (/ b 2)) 2))))))",
+		res.Query[1].Children[0].Children[2].MathExp.debugString())
+	require.EqualValues(t, "(+ (abs (- (atan2 a b) (atan (tan a)))) (acos (cos b)))",
+		res.Query[1].Children[0].Children[3].MathExp.debugString())
+}
+
 func TestParseQueryWithVarValAggNestedConditional(t *testing.T) {
 	query := `
 	{
@@ -2464,6 +2526,42 @@ func TestParseFilter_Geo1(t *testing.T) {
 	require.Equal(t, false, resp.Query[0].Children[0].Filter.Func.Args[1].IsValueVar)
 }
 
+func TestParseNearest(t *testing.T) {
+	query := `
+	query {
+		me(func: nearest(loc, [-1.12 , 2.0123 ], 3)) {
+			l as loc
+			d: math(distance(l, "[-1.12, 2.0123]"))
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "nearest", resp.Query[0].Func.Name)
+	require.Equal(t, "[-1.12,2.0123]", resp.Query[0].Func.Args[0].Value)
+	require.Equal(t, "3", resp.Query[0].Func.Args[1].Value)
+	require.Equal(t, `(distance l "[-1.12, 2.0123]")`,
+		resp.Query[0].Children[1].MathExp.debugString())
+}
+
+func TestParseJSONPath(t *testing.T) {
+	query := `
+	query {
+		me(func: has(payload)) @filter(jsonpath(payload, "$.status", "shipped")) {
+			p as payload
+			t: math(jsonpath(p, "$.total"))
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "jsonpath", resp.Query[0].Filter.Func.Name)
+	require.Equal(t, "payload", resp.Query[0].Filter.Func.Attr)
+	require.Equal(t, "$.status", resp.Query[0].Filter.Func.Args[0].Value)
+	require.Equal(t, "shipped", resp.Query[0].Filter.Func.Args[1].Value)
+	require.Equal(t, `(jsonpath p "$.total")`, resp.Query[0].Children[1].MathExp.debugString())
+}
+
 func TestParseFilter_Geo2(t *testing.T) {
 	query := `
 	query {
@@ -3123,6 +3221,21 @@ func TestParseNormalize(t *testing.T) {
 	require.True(t, res.Query[0].Normalize)
 }
 
+func TestParseTotal(t *testing.T) {
+	query := `
+	query {
+		me(func: has(name), first: 10, offset: 20) @filter(eq(gender, "female")) @total {
+			name
+		}
+}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.NotNil(t, res.Query[0])
+	require.True(t, res.Query[0].Total)
+	require.NotNil(t, res.Query[0].Filter)
+}
+
 func TestParseGroupbyRoot(t *testing.T) {
 	query := `
 	query {
@@ -3254,7 +3367,7 @@ func TestParseGroupbyWithAliasForError(t *testing.T) {
 	query := `
 	query {
 		me(func: uid(0x1)) {
-			friends @groupby(first: 10, SchooL: school) {
+			friends @groupby(after: 10, SchooL: school) {
 				count(uid)
 			}
 			hometown
@@ -3263,7 +3376,55 @@ func TestParseGroupbyWithAliasForError(t *testing.T) {
 	}
 `
 	_, err := Parse(Request{Str: query})
-	require.Contains(t, err.Error(), "Can't use keyword first as alias in groupby")
+	require.Contains(t, err.Error(), "Can't use keyword after as alias in groupby")
+}
+
+func TestParseGroupbyWithOrderAndPagination(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(name, School: school, orderdesc: Total, orderasc: name, first: 5,
+				offset: 10) {
+				Total: count(uid)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	gq := res.Query[0].Children[0]
+	require.Equal(t, 2, len(gq.GroupbyAttrs))
+	require.Equal(t, "School", gq.GroupbyAttrs[1].Alias)
+	require.Equal(t, []*pb.Order{{Attr: "Total", Desc: true}, {Attr: "name"}},
+		gq.GroupbyArgs.Order)
+	require.Equal(t, 5, gq.GroupbyArgs.First)
+	require.Equal(t, 10, gq.GroupbyArgs.Offset)
+}
+
+func TestParseGroupbyWithPaginationError(t *testing.T) {
+	tests := []struct {
+		groupby    string
+		errMessage string
+	}{
+		{"@groupby(name, first: 0)", "Value inside first should be a positive integer in groupby"},
+		{"@groupby(name, offset: ten)",
+			"Value inside offset should be a non-negative integer in groupby"},
+		{"@groupby(Name: first: 10)", "Expected predicate after Name:"},
+		{"@groupby(first: 10)", "Expected atleast one attribute in groupby"},
+	}
+	for _, tcase := range tests {
+		query := `
+		query {
+			me(func: uid(0x1)) {
+				friends ` + tcase.groupby + ` {
+					count(uid)
+				}
+			}
+		}`
+		_, err := Parse(Request{Str: query})
+		require.Error(t, err, tcase.groupby)
+		require.Contains(t, err.Error(), tcase.errMessage)
+	}
 }
 
 func TestParseGroupbyError(t *testing.T) {
@@ -5136,6 +5297,52 @@ func TestParseExpandType(t *testing.T) {
 	require.Equal(t, "uid", gq.Query[0].Children[0].Children[0].Attr)
 }
 
+func TestParseExpandExcept(t *testing.T) {
+	query := `
+	{
+		var(func: has(name)) {
+			expand(Person, Animal, except: [name, dgraph.type]) {
+				uid
+			}
+		}
+		me(func: has(name)) {
+			expand(_all_, except: [age])
+		}
+	}
+`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "Person,Animal", gq.Query[0].Children[0].Expand)
+	require.Equal(t, []string{"name", "dgraph.type"}, gq.Query[0].Children[0].ExpandExcept)
+	require.Equal(t, 1, len(gq.Query[0].Children[0].Children))
+	require.Equal(t, "_all_", gq.Query[1].Children[0].Expand)
+	require.Equal(t, []string{"age"}, gq.Query[1].Children[0].ExpandExcept)
+}
+
+func TestParseExpandExceptError(t *testing.T) {
+	query := `
+	{
+		me(func: has(name)) {
+			expand(_all_, except: [])
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Unexpected token ] in the except list of expand()")
+
+	query = `
+	{
+		me(func: has(name)) {
+			expand(_all_, only: [age])
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected except: [predicates] in expand(), but got only")
+}
+
 func TestParseVarAfterCountQry(t *testing.T) {
 	query := `
 		{
@@ -5200,6 +5407,49 @@ func TestRecurseWithArgs(t *testing.T) {
 	require.Equal(t, gq.Query[0].RecurseArgs.Depth, uint64(1))
 }
 
+func TestRecurseWithLevels(t *testing.T) {
+	query := `
+	{
+		me(func: eq(name, "sad")) @recurse(depth: 4) {
+			name
+			friend @levels(to: 1) @filter(eq(name, "happy"))
+			friend @levels(from: 2, to: 3)
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := gq.Query[0].Children
+	require.Len(t, children, 3)
+	require.Nil(t, children[0].Levels)
+	require.Equal(t, &RecurseLevels{From: 1, To: 1}, children[1].Levels)
+	require.NotNil(t, children[1].Filter)
+	require.Equal(t, &RecurseLevels{From: 2, To: 3}, children[2].Levels)
+}
+
+func TestRecurseWithLevelsError(t *testing.T) {
+	tests := []struct {
+		levels     string
+		errMessage string
+	}{
+		{"@levels(from: 0)", "Value inside from should be a positive integer"},
+		{"@levels(to: two)", "Value inside to should be a positive integer"},
+		{"@levels(from: 3, to: 2)", "Level to: 2 is lower than level from: 3 inside @levels"},
+		{"@levels(depth: 1)", "Unexpected key: [depth] inside @levels"},
+		{"@levels(from: 1) @levels(to: 2)", "Only one levels directive allowed."},
+	}
+	for _, tcase := range tests {
+		query := `
+		{
+			me(func: eq(name, "sad")) @recurse {
+				friend ` + tcase.levels + `
+			}
+		}`
+		_, err := Parse(Request{Str: query})
+		require.Error(t, err, tcase.levels)
+		require.Contains(t, err.Error(), tcase.errMessage)
+	}
+}
+
 func TestRecurseWithArgsWithError(t *testing.T) {
 	query := `
 	{
@@ -65,6 +65,11 @@ const (
 		This is the schema that is being served by Dgraph at /graphql.
 		"""
 		generatedSchema: String!
+
+		"""
+		The Dgraph schema (predicates and types) that was generated from the 'schema' field.
+		"""
+		dgraphSchema: String!
 	}
 
 	type Cors @dgraph(type: "dgraph.cors"){
@@ -142,6 +147,21 @@ const (
 		List of Enterprise Features that are enabled.
 		"""
 		ee_features: [String]
+
+		"""
+		The highest timestamp assigned to a transaction that this node knows of.
+		"""
+		max_assigned: Int
+
+		"""
+		Index of the last Raft log entry that this node has applied.
+		"""
+		last_applied_index: Int
+
+		"""
+		Version of the protocol between the alphas and zeros that the node speaks.
+		"""
+		protocol_version: Int
 	}
 
 	type MembershipState {
@@ -183,6 +203,12 @@ const (
 		remove: Boolean
 		readOnly: Boolean
 		moveTs: Int
+		onDiskBytes: Int
+		uncompressedBytes: Int
+		keyCount: Int
+		splitCount: Int
+		readRate: Float
+		writeRate: Float
 	}
 
 	type License {
@@ -248,13 +274,128 @@ const (
 
 	type ExportPayload {
 		response: Response
+
+		"""
+		The export now runs in a task, so the files are only known once it's done. This is empty,
+		query the task with taskId for the exported files instead.
+		"""
+		exportedFiles: [String] @deprecated(reason: "Query the task with taskId for the files.")
+
+		"""
+		ID of the task running the export. Query the task to know when the export is done, and
+		to get the exported files.
+		"""
+		taskId: String
+	}
+
+	type TaskPayload {
+		id: String
+
+		"""
+		The kind of the task: "backup", "export", "restore" or "compact".
+		"""
+		kind: String
+
+		"""
+		Status of the task: "Running", "Success" or "Failed".
+		"""
+		status: String
+
+		"""
+		The error, if the task failed.
+		"""
+		message: String
+
+		"""
+		The files written by an export task that has finished.
+		"""
 		exportedFiles: [String]
+
+		startedAt: DateTime
+		lastUpdated: DateTime
+	}
+
+	input TaskInput {
+		id: String!
 	}
 
 	type DrainingPayload {
 		response: Response
 	}
 
+	input CompactInput {
+		"""
+		The number of compactions to run at a time (default: 1). Fewer leave more of the disk to
+		queries and mutations while compacting.
+		"""
+		workers: Int
+
+		"""
+		Compact even if edges were written to this alpha in the last 30 seconds (default: false).
+		Writes stall while the postings are compacted, which can last until it's done.
+		"""
+		force: Boolean
+	}
+
+	type CompactPayload {
+		response: Response
+
+		"""
+		ID of the task running the compaction. Query the task to know when it's done.
+		"""
+		taskId: String
+	}
+
+	input CollectGarbageInput {
+		"""
+		Delete the garbage that's found, instead of only reporting it (default: false).
+		"""
+		purge: Boolean
+	}
+
+	type CollectGarbagePayload {
+		response: Response
+
+		"""
+		Predicates that have data in the group of this alpha, but aren't in the schema.
+		"""
+		orphanedPredicates: [String]
+
+		"""
+		The number of edges to nodes that have been deleted. It's null if they weren't looked
+		for, which is when the predicates are served by more than one group.
+		"""
+		danglingEdges: Int
+
+		"""
+		Whether there are more dangling edges than the ones counted. Run it again once they're
+		purged to find the others.
+		"""
+		truncated: Boolean
+
+		"""
+		ID of the task purging the garbage, if purge was set. Query the task to know when it's
+		done.
+		"""
+		taskId: String
+	}
+
+	input CheckConsistencyInput {
+		"""
+		Only check the given predicate, instead of all the ones of the group of this alpha.
+		"""
+		predicate: String
+	}
+
+	type CheckConsistencyPayload {
+		response: Response
+
+		"""
+		The problems found in the data, like index keys that are missing uids.
+		"""
+		problems: [String]
+	}
+
 	type ShutdownPayload {
 		response: Response
 	}
@@ -272,6 +413,22 @@ const (
 		False value of logRequest disables above.
 		"""
 		logRequest: Boolean
+
+		"""
+		Verbosity of the logs, like the -v flag.
+		"""
+		logVerbosity: Int
+
+		"""
+		Longest a query can run for, as a duration like "30s" or "2m". "0s" means no limit.
+		"""
+		queryTimeout: String
+
+		"""
+		Most queries that can be pending at once. Queries beyond it are rejected. 0 means no
+		limit.
+		"""
+		maxPendingQueries: Int
 	}
 
 	type ConfigPayload {
@@ -280,6 +437,72 @@ const (
 
 	type Config {
 		cacheMb: Float
+		logRequest: Boolean
+		logVerbosity: Int
+		queryTimeout: String
+		maxPendingQueries: Int
+	}
+
+	input ValidateGQLSchemaInput {
+		schema: String!
+	}
+
+	type ValidateGQLSchemaPayload {
+		"""
+		True if the schema could be applied with updateGQLSchema.
+		"""
+		valid: Boolean!
+
+		"""
+		Why the schema can't be applied, if it's not valid.
+		"""
+		errors: [String]
+
+		"""
+		What applying the schema would do that might need attention, like predicates that
+		would be reindexed, or that would no longer be in the GraphQL schema.
+		"""
+		warnings: [String]
+
+		"""
+		The GraphQL schema that would be served, if the schema is valid.
+		"""
+		generatedSchema: String
+
+		"""
+		The Dgraph schema that would be applied, if the schema is valid.
+		"""
+		dgraphSchema: String
+	}
+
+	type LambdaStatus {
+		"""
+		URL of the lambda server that @lambda fields are resolved by, if one is registered.
+		"""
+		url: String
+
+		"""
+		True if the lambda server answered a request from this alpha without a server error.
+		"""
+		healthy: Boolean!
+
+		"""
+		How the lambda server answered, or why it couldn't be reached.
+		"""
+		message: String
+	}
+
+	input RegisterLambdaInput {
+		"""
+		URL of the lambda server, like "http://localhost:8686/graphql-worker". An empty URL
+		unregisters the lambda server, which fails if the GraphQL schema has @lambda fields.
+		"""
+		url: String!
+	}
+
+	type RegisterLambdaPayload {
+		response: Response
+		lambda: LambdaStatus
 	}
 
 	` + adminTypes + `
@@ -291,6 +514,22 @@ const (
 		config: Config
 		getAllowedCORSOrigins: Cors
 		querySchemaHistory(first: Int, offset: Int): [SchemaHistory]
+
+		"""
+		Run all the checks that updateGQLSchema runs on the input schema, against the current
+		schema, without applying anything.
+		"""
+		validateGQLSchema(input: ValidateGQLSchemaInput!): ValidateGQLSchemaPayload
+
+		"""
+		Get the status of a backup, export, restore or compact task started on this alpha.
+		"""
+		task(input: TaskInput!): TaskPayload
+
+		"""
+		Get the URL of the lambda server, and check that it's healthy.
+		"""
+		lambda: LambdaStatus
 		` + adminQueries + `
 	}
 
@@ -319,6 +558,24 @@ const (
 		"""
 		shutdown: ShutdownPayload
 
+		"""
+		Compact the postings of this alpha into one level, to reclaim the disk space taken by
+		deleted data without restarting it.
+		"""
+		compact(input: CompactInput): CompactPayload
+
+		"""
+		Find the data that drops and deletes have left behind in the group of this alpha, and
+		delete it if purge is set.
+		"""
+		collectGarbage(input: CollectGarbageInput): CollectGarbagePayload
+
+		"""
+		Check that the index and reverse keys of the predicates in the group of this alpha match
+		their data.
+		"""
+		checkConsistency(input: CheckConsistencyInput): CheckConsistencyPayload
+
 		"""
 		Alter the node's config.
 		"""
@@ -326,6 +583,12 @@ const (
 
 		replaceAllowedCORSOrigins(origins: [String]): Cors
 
+		"""
+		Register the URL of the lambda server on this alpha, instead of the
+		--graphql_lambda_url flag, until the alpha restarts.
+		"""
+		registerLambda(input: RegisterLambdaInput!): RegisterLambdaPayload
+
 		` + adminMutations + `
 	}
  `
@@ -347,11 +610,16 @@ var (
 		resolve.LoggingMWMutation,
 	}
 	adminQueryMWConfig = map[string]resolve.QueryMiddlewares{
-		"health":        {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery}, // dgraph checks Guardian auth for health
-		"state":         {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery}, // dgraph checks Guardian auth for state
-		"config":        commonAdminQueryMWs,
-		"listBackups":   commonAdminQueryMWs,
-		"getGQLSchema":  commonAdminQueryMWs,
+		// dgraph checks Guardian auth for health
+		"health": {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
+		// dgraph checks Guardian auth for state
+		"state":             {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
+		"config":            commonAdminQueryMWs,
+		"listBackups":       commonAdminQueryMWs,
+		"getGQLSchema":      commonAdminQueryMWs,
+		"validateGQLSchema": commonAdminQueryMWs,
+		"task":              commonAdminQueryMWs,
+		"lambda":            commonAdminQueryMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"queryGroup":            {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
@@ -363,14 +631,18 @@ var (
 		"getAllowedCORSOrigins": {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":          commonAdminMutationMWs,
-		"config":          commonAdminMutationMWs,
-		"draining":        commonAdminMutationMWs,
-		"export":          commonAdminMutationMWs,
-		"login":           {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
-		"restore":         commonAdminMutationMWs,
-		"shutdown":        commonAdminMutationMWs,
-		"updateGQLSchema": commonAdminMutationMWs,
+		"backup":           commonAdminMutationMWs,
+		"checkConsistency": commonAdminMutationMWs,
+		"collectGarbage":   commonAdminMutationMWs,
+		"compact":          commonAdminMutationMWs,
+		"config":           commonAdminMutationMWs,
+		"draining":         commonAdminMutationMWs,
+		"export":           commonAdminMutationMWs,
+		"login":            {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
+		"registerLambda":   commonAdminMutationMWs,
+		"restore":          commonAdminMutationMWs,
+		"shutdown":         commonAdminMutationMWs,
+		"updateGQLSchema":  commonAdminMutationMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":                   {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
@@ -427,6 +699,7 @@ type gqlSchema struct {
 	ID              string `json:"id,omitempty"`
 	Schema          string `json:"schema,omitempty"`
 	GeneratedSchema string
+	DgraphSchema    string
 }
 
 type adminServer struct {
@@ -490,7 +763,7 @@ func newAdminResolver(
 
 	server := &adminServer{
 		rf:                rf,
-		resolver:          resolve.New(adminSchema, rf),
+		resolver:          resolve.New(schema.WithoutQueryLimits(adminSchema), rf),
 		gqlServer:         gqlServer,
 		fns:               fns,
 		withIntrospection: withIntrospection,
@@ -567,13 +840,16 @@ func newAdminResolver(
 func newAdminResolverFactory() resolve.ResolverFactory {
 
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"backup":   resolveBackup,
-		"config":   resolveUpdateConfig,
-		"draining": resolveDraining,
-		"export":   resolveExport,
-		"login":    resolveLogin,
-		"restore":  resolveRestore,
-		"shutdown": resolveShutdown,
+		"backup":           resolveBackup,
+		"checkConsistency": resolveCheckConsistency,
+		"collectGarbage":   resolveCollectGarbage,
+		"compact":          resolveCompact,
+		"config":           resolveUpdateConfig,
+		"draining":         resolveDraining,
+		"export":           resolveExport,
+		"login":            resolveLogin,
+		"restore":          resolveRestore,
+		"shutdown":         resolveShutdown,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
@@ -582,6 +858,12 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("health", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveHealth)
 		}).
+		WithQueryResolver("task", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveTask)
+		}).
+		WithQueryResolver("lambda", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveGetLambda)
+		}).
 		WithQueryResolver("state", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveState)
 		}).
@@ -605,12 +887,25 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 						false
 				})
 		}).
+		WithMutationResolver("registerLambda", func(m schema.Mutation) resolve.MutationResolver {
+			return resolve.MutationResolverFunc(
+				func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+					return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady), Field: m},
+						false
+				})
+		}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
 					return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady), Field: q}
 				})
 		}).
+		WithQueryResolver("validateGQLSchema", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(
+				func(ctx context.Context, query schema.Query) *resolve.Resolved {
+					return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady), Field: q}
+				})
+		}).
 		WithQueryResolver("getAllowedCORSOrigins", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
@@ -651,6 +946,7 @@ func generateGQLSchema(sch *gqlSchema) (schema.Schema, error) {
 		return nil, err
 	}
 	sch.GeneratedSchema = schHandler.GQLSchema()
+	sch.DgraphSchema = schHandler.DGSchema()
 	generatedSchema, err := schema.FromString(sch.GeneratedSchema)
 	if err != nil {
 		return nil, err
@@ -720,6 +1016,12 @@ func (as *adminServer) addConnectedAdminResolvers() {
 				admin: as,
 			}
 		}).
+		WithQueryResolver("validateGQLSchema",
+			func(q schema.Query) resolve.QueryResolver {
+				return &validateSchemaResolver{
+					admin: as,
+				}
+			}).
 		WithQueryResolver("getGQLSchema",
 			func(q schema.Query) resolve.QueryResolver {
 				getResolver := &getSchemaResolver{
@@ -826,6 +1128,9 @@ func (as *adminServer) addConnectedAdminResolvers() {
 			}).
 		WithMutationResolver("replaceAllowedCORSOrigins", func(m schema.Mutation) resolve.MutationResolver {
 			return resolve.MutationResolverFunc(resolveReplaceAllowedCORSOrigins)
+		}).
+		WithMutationResolver("registerLambda", func(m schema.Mutation) resolve.MutationResolver {
+			return &registerLambdaResolver{admin: as}
 		})
 }
 
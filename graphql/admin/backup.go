@@ -25,6 +25,7 @@ import (
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 )
 
 type backupInput struct {
@@ -39,21 +40,26 @@ func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
+	// Check the input that can be checked up front, so that the mutation fails at once instead
+	// of starting a task that's bound to fail.
+	if input.Destination == "" {
+		return resolve.EmptyResult(m, errors.Errorf("you must specify a 'destination' value")),
+			false
+	}
 
-	err = worker.ProcessBackupRequest(context.Background(), &pb.BackupRequest{
+	req := &pb.BackupRequest{
 		Destination:  input.Destination,
 		AccessKey:    input.AccessKey,
 		SecretKey:    input.SecretKey,
 		SessionToken: input.SessionToken,
 		Anonymous:    input.Anonymous,
-	}, input.ForceFull)
-
-	if err != nil {
-		return resolve.EmptyResult(m, err), false
 	}
+	taskId := tasks.start("backup", func() ([]string, error) {
+		return nil, worker.ProcessBackupRequest(context.Background(), req, input.ForceFull)
+	})
 
 	return &resolve.Resolved{
-		Data:  map[string]interface{}{m.Name(): response("Success", "Backup completed.")},
+		Data:  map[string]interface{}{m.Name(): taskResponse("Backup", taskId)},
 		Field: m,
 	}, true
 }
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/golang/glog"
+)
+
+type checkConsistencyInput struct {
+	Predicate string
+}
+
+func resolveCheckConsistency(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got checkConsistency request through GraphQL admin API")
+
+	input, err := getCheckConsistencyInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	problems, err := worker.CheckConsistency(ctx, input.Predicate)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	msg := "No problems found."
+	if len(problems) > 0 {
+		msg = "Found problems in the data."
+	}
+	resp := response("Success", msg)
+	resp["problems"] = toGraphQLArray(problems)
+	return &resolve.Resolved{
+		Data:  map[string]interface{}{m.Name(): resp},
+		Field: m,
+	}, true
+}
+
+func getCheckConsistencyInput(m schema.Mutation) (*checkConsistencyInput, error) {
+	var input checkConsistencyInput
+	inputArg := m.ArgValue(schema.InputArgName)
+	if inputArg == nil {
+		return &input, nil
+	}
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func adminMutation(t *testing.T, query string) schema.Mutation {
+	gqlSchema, err := schema.FromString(graphqlAdminSchema)
+	require.NoError(t, err)
+	op, err := gqlSchema.Operation(&schema.Request{Query: query})
+	require.NoError(t, err)
+	require.Len(t, op.Mutations(), 1)
+	return op.Mutations()[0]
+}
+
+func TestGetCompactInput(t *testing.T) {
+	input, err := getCompactInput(adminMutation(t, `mutation { compact { taskId } }`))
+	require.NoError(t, err)
+	require.Nil(t, input.Workers)
+	require.False(t, input.Force)
+
+	input, err = getCompactInput(adminMutation(t,
+		`mutation { compact(input: {workers: 4, force: true}) { taskId } }`))
+	require.NoError(t, err)
+	require.Equal(t, 4, *input.Workers)
+	require.True(t, input.Force)
+}
+
+func TestResolveCompactRejectsWorkers(t *testing.T) {
+	for _, workers := range []string{"0", "-1"} {
+		m := adminMutation(t, `mutation { compact(input: {workers: `+workers+`}) { taskId } }`)
+		res, ok := resolveCompact(context.Background(), m)
+		require.False(t, ok)
+		require.Error(t, res.Err)
+		require.Contains(t, res.Err.Error(), "workers must be greater than zero")
+		require.Equal(t, map[string]interface{}{"compact": nil}, res.Data)
+	}
+}
@@ -19,11 +19,13 @@ package admin
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 )
 
 type configInput struct {
@@ -32,6 +34,10 @@ type configInput struct {
 	// logging of all requests coming to alphas. LogRequest type has been kept as *bool instead of
 	// bool to avoid updating WorkerOptions.LogRequest when it has default value of false.
 	LogRequest *bool
+	// The other settings are pointers for the same reason: to tell when they aren't given.
+	LogVerbosity      *int
+	QueryTimeout      *string
+	MaxPendingQueries *int64
 }
 
 func resolveUpdateConfig(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -42,6 +48,16 @@ func resolveUpdateConfig(ctx context.Context, m schema.Mutation) (*resolve.Resol
 		return resolve.EmptyResult(m, err), false
 	}
 
+	// Parse the timeout before changing anything, so that a bad timeout doesn't leave the
+	// config half updated.
+	var queryTimeout time.Duration
+	if input.QueryTimeout != nil {
+		if queryTimeout, err = time.ParseDuration(*input.QueryTimeout); err != nil {
+			return resolve.EmptyResult(m, errors.Errorf("invalid queryTimeout %q: it must be "+
+				"a duration, like \"30s\" or \"2m\"", *input.QueryTimeout)), false
+		}
+	}
+
 	// update cacheMB only when it is specified by user
 	if input.CacheMb != nil {
 		if err = worker.UpdateCacheMb(int64(*input.CacheMb)); err != nil {
@@ -54,6 +70,24 @@ func resolveUpdateConfig(ctx context.Context, m schema.Mutation) (*resolve.Resol
 		worker.UpdateLogRequest(*input.LogRequest)
 	}
 
+	if input.LogVerbosity != nil {
+		if err = worker.UpdateLogVerbosity(*input.LogVerbosity); err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+	}
+
+	if input.QueryTimeout != nil {
+		if err = worker.UpdateQueryTimeout(queryTimeout); err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+	}
+
+	if input.MaxPendingQueries != nil {
+		if err = worker.UpdateMaxPendingQueries(*input.MaxPendingQueries); err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+	}
+
 	return &resolve.Resolved{
 		Data:  map[string]interface{}{m.Name(): response("Success", "Config updated successfully")},
 		Field: m,
@@ -65,6 +99,10 @@ func resolveGetConfig(ctx context.Context, q schema.Query) *resolve.Resolved {
 
 	conf := make(map[string]interface{})
 	conf["cacheMb"] = float64(worker.Config.CacheMb)
+	conf["logRequest"] = worker.LogRequestEnabled()
+	conf["logVerbosity"] = worker.LogVerbosity()
+	conf["queryTimeout"] = worker.QueryTimeout().String()
+	conf["maxPendingQueries"] = worker.MaxPendingQueries()
 
 	return &resolve.Resolved{
 		Data:  map[string]interface{}{q.Name(): conf},
@@ -54,6 +54,11 @@ const adminTypes = `
 
 	type BackupPayload {
 		response: Response
+
+		"""
+		ID of the task running the backup. Query the task to know when the backup is done.
+		"""
+		taskId: String
 	}
 
 	input RestoreInput {
@@ -144,6 +149,11 @@ const adminTypes = `
 		Includes the error message if the operation failed.
 		"""
 		message: String
+
+		"""
+		ID of the task running the restore. Query the task to know when the restore is done.
+		"""
+		taskId: String
 	}
 
 	input ListBackupsInput {
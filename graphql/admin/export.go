@@ -50,23 +50,23 @@ func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		}
 	}
 
-	files, err := worker.ExportOverNetwork(context.Background(), &pb.ExportRequest{
+	req := &pb.ExportRequest{
 		Format:       format,
 		Destination:  input.Destination,
 		AccessKey:    input.AccessKey,
 		SecretKey:    input.SecretKey,
 		SessionToken: input.SessionToken,
 		Anonymous:    input.Anonymous,
-	})
-	if err != nil {
-		return resolve.EmptyResult(m, err), false
 	}
+	taskId := tasks.start("export", func() ([]string, error) {
+		return worker.ExportOverNetwork(context.Background(), req)
+	})
 
-	responseData := response("Success", "Export completed.")
-	responseData["exportedFiles"] = toGraphQLArray(files)
-
+	resp := taskResponse("Export", taskId)
+	// Clients that still select the files get none, as they're not known until the task is done.
+	resp["exportedFiles"] = toGraphQLArray(nil)
 	return &resolve.Resolved{
-		Data:  map[string]interface{}{m.Name(): responseData},
+		Data:  map[string]interface{}{m.Name(): resp},
 		Field: m,
 	}, true
 }
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/golang/glog"
+)
+
+type collectGarbageInput struct {
+	Purge bool
+}
+
+func resolveCollectGarbage(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got collectGarbage request through GraphQL admin API")
+
+	input, err := getCollectGarbageInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	garbage, err := worker.FindGarbage(ctx)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	glog.Infof("Found %d orphaned predicates and %d dangling edges",
+		len(garbage.OrphanedPredicates), len(garbage.DanglingEdges))
+
+	resp := response("Success", "Found the garbage.")
+	if input.Purge {
+		// The purge can take a while, so it's run as a task, like backups.
+		taskId := tasks.start("collectGarbage", func() ([]string, error) {
+			return nil, purgeGarbage(context.Background(), garbage)
+		})
+		resp = taskResponse("Purging the garbage", taskId)
+	}
+	resp["orphanedPredicates"] = toGraphQLArray(garbage.OrphanedPredicates)
+	if garbage.CheckedEdges {
+		resp["danglingEdges"] = len(garbage.DanglingEdges)
+		resp["truncated"] = garbage.Truncated
+	}
+	return &resolve.Resolved{
+		Data:  map[string]interface{}{m.Name(): resp},
+		Field: m,
+	}, true
+}
+
+// purgeGarbage deletes the data of the orphaned predicates and the dangling edges of garbage.
+func purgeGarbage(ctx context.Context, garbage *worker.Garbage) error {
+	for _, pred := range garbage.OrphanedPredicates {
+		if err := worker.CleanPredicate(ctx, pred); err != nil {
+			return err
+		}
+	}
+	return edgraph.DeleteEdges(ctx, garbage.DanglingEdges)
+}
+
+func getCollectGarbageInput(m schema.Mutation) (*collectGarbageInput, error) {
+	var input collectGarbageInput
+	inputArg := m.ArgValue(schema.InputArgName)
+	if inputArg == nil {
+		return &input, nil
+	}
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
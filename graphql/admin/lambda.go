@@ -0,0 +1,140 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// lambdaHealthTimeout is how long the health check of the lambda server waits for it to answer.
+const lambdaHealthTimeout = 5 * time.Second
+
+type registerLambdaInput struct {
+	Url string
+}
+
+type registerLambdaResolver struct {
+	admin *adminServer
+}
+
+// Resolve registers the URL of the lambda server, and rebuilds the GraphQL API so that its
+// @lambda fields are sent there. The URL is only changed on this alpha, until it restarts.
+func (rlr *registerLambdaResolver) Resolve(ctx context.Context,
+	m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got registerLambda request")
+
+	inputByts, err := json.Marshal(m.ArgValue(schema.InputArgName))
+	if err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
+	var input registerLambdaInput
+	if err := json.Unmarshal(inputByts, &input); err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
+	if input.Url != "" {
+		lambdaUrl, err := url.Parse(input.Url)
+		if err != nil || !lambdaUrl.IsAbs() {
+			return resolve.EmptyResult(m, errors.Errorf("invalid lambda URL %q: it must be "+
+				"an absolute URL, like \"http://localhost:8686/graphql-worker\"", input.Url)), false
+		}
+	}
+
+	if err := rlr.admin.setLambdaUrl(input.Url); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{m.Name(): map[string]interface{}{
+			"response": map[string]interface{}{
+				"code":    "Success",
+				"message": "Lambda URL updated successfully",
+			},
+			"lambda": lambdaStatus(ctx, input.Url),
+		}},
+		Field: m,
+	}, true
+}
+
+// setLambdaUrl changes the URL of the lambda server, and rebuilds the GraphQL API with it.
+func (as *adminServer) setLambdaUrl(lambdaUrl string) error {
+	as.mux.Lock()
+	defer as.mux.Unlock()
+
+	oldUrl := x.LambdaUrl()
+	x.SetLambdaUrl(lambdaUrl)
+	if as.schema != nil && as.schema.Schema != "" {
+		generatedSchema, err := generateGQLSchema(as.schema)
+		if err != nil {
+			// The current schema must keep working, so the URL isn't changed.
+			x.SetLambdaUrl(oldUrl)
+			return schema.GQLWrapf(err, "couldn't change the lambda URL, as the current "+
+				"GraphQL schema needs it")
+		}
+		as.resetSchema(generatedSchema)
+	}
+	glog.Infof("Lambda URL changed from %q to %q", oldUrl, lambdaUrl)
+	return nil
+}
+
+func resolveGetLambda(ctx context.Context, q schema.Query) *resolve.Resolved {
+	return &resolve.Resolved{
+		Data:  map[string]interface{}{q.Name(): lambdaStatus(ctx, x.LambdaUrl())},
+		Field: q,
+	}
+}
+
+// lambdaStatus returns the URL of the lambda server and whether it's healthy. It's healthy if
+// it answers a request, with any status other than a server error.
+func lambdaStatus(ctx context.Context, lambdaUrl string) map[string]interface{} {
+	status := map[string]interface{}{"url": lambdaUrl, "healthy": false}
+	if lambdaUrl == "" {
+		status["url"] = nil
+		status["message"] = "No lambda URL is registered"
+		return status
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, lambdaHealthTimeout)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, lambdaUrl, nil)
+	if err != nil {
+		status["message"] = err.Error()
+		return status
+	}
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		status["message"] = fmt.Sprintf("lambda server couldn't be reached: %v", err)
+		return status
+	}
+	resp.Body.Close()
+
+	status["healthy"] = resp.StatusCode < http.StatusInternalServerError
+	status["message"] = fmt.Sprintf("lambda server answered with %s in %s", resp.Status,
+		time.Since(start).Round(time.Millisecond))
+	return status
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/worker"
+	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
 
@@ -45,6 +46,8 @@ type restoreInput struct {
 }
 
 func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got restore request")
+
 	input, err := getRestoreInput(m)
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
@@ -66,7 +69,9 @@ func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
 		VaultField:        input.VaultField,
 		VaultFormat:       input.VaultFormat,
 	}
-	err = worker.ProcessRestoreRequest(context.Background(), &req)
+	// The backup is verified before the task is started, so that a restore that can't be done
+	// fails the mutation at once.
+	done, err := worker.ProcessRestoreRequest(context.Background(), &req)
 	if err != nil {
 		return &resolve.Resolved{
 			Data: map[string]interface{}{m.Name(): map[string]interface{}{
@@ -76,12 +81,12 @@ func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
 			Err:   schema.GQLWrapLocationf(err, m.Location(), "resolving %s failed", m.Name()),
 		}, false
 	}
+	taskId := tasks.start("restore", func() ([]string, error) {
+		return nil, <-done
+	})
 
 	return &resolve.Resolved{
-		Data: map[string]interface{}{m.Name(): map[string]interface{}{
-			"code":    "Success",
-			"message": "Restore operation started.",
-		}},
+		Data:  map[string]interface{}{m.Name(): taskResponse("Restore", taskId)},
 		Field: m,
 	}, true
 }
@@ -67,8 +67,9 @@ func (usr *updateSchemaResolver) Resolve(ctx context.Context, m schema.Mutation)
 	}
 
 	usr.admin.mux.RLock()
-	oldSchemaHash := farm.Fingerprint64([]byte(usr.admin.schema.Schema))
+	oldSchema := usr.admin.schema.Schema
 	usr.admin.mux.RUnlock()
+	oldSchemaHash := farm.Fingerprint64([]byte(oldSchema))
 
 	newSchemaHash := farm.Fingerprint64([]byte(input.Set.Schema))
 	updateHistory := oldSchemaHash != newSchemaHash
@@ -83,6 +84,7 @@ func (usr *updateSchemaResolver) Resolve(ctx context.Context, m schema.Mutation)
 			glog.Errorf("error while updating schema history %s", err.Error())
 		}
 	}
+	notifySchemaWebhooks(oldSchema, input.Set.Schema)
 
 	return &resolve.Resolved{
 		Data: map[string]interface{}{
@@ -91,12 +93,72 @@ func (usr *updateSchemaResolver) Resolve(ctx context.Context, m schema.Mutation)
 					"id":              query.UidToHex(resp.Uid),
 					"schema":          input.Set.Schema,
 					"generatedSchema": schHandler.GQLSchema(),
+					"dgraphSchema":    schHandler.DGSchema(),
 				}}},
 		Field: m,
 		Err:   nil,
 	}, true
 }
 
+type validateGQLSchemaInput struct {
+	Schema string
+}
+
+type validateSchemaResolver struct {
+	admin *adminServer
+}
+
+// Resolve runs all the checks updateGQLSchema would run on the input schema, without applying
+// it, and returns the errors it has and warnings about what applying it would do.
+func (vsr *validateSchemaResolver) Resolve(ctx context.Context, q schema.Query) *resolve.Resolved {
+	glog.Info("Got validateGQLSchema request")
+
+	inputByts, err := json.Marshal(q.ArgValue(schema.InputArgName))
+	if err != nil {
+		return resolve.EmptyResult(q, schema.GQLWrapf(err, "couldn't get input argument"))
+	}
+	var input validateGQLSchemaInput
+	if err := json.Unmarshal(inputByts, &input); err != nil {
+		return resolve.EmptyResult(q, schema.GQLWrapf(err, "couldn't get input argument"))
+	}
+
+	payload := map[string]interface{}{"valid": false}
+	result := &resolve.Resolved{
+		Data:  map[string]interface{}{q.Name(): payload},
+		Field: q,
+	}
+	invalid := func(err error) *resolve.Resolved {
+		var errs []interface{}
+		for _, gqlErr := range schema.AsGQLErrors(err) {
+			errs = append(errs, gqlErr.Error())
+		}
+		payload["errors"] = errs
+		return result
+	}
+
+	schHandler, err := schema.NewHandler(input.Schema, true)
+	if err != nil {
+		return invalid(err)
+	}
+	if _, err = schema.FromString(schHandler.GQLSchema()); err != nil {
+		return invalid(err)
+	}
+	payload["generatedSchema"] = schHandler.GQLSchema()
+	payload["dgraphSchema"] = schHandler.DGSchema()
+
+	vsr.admin.mux.RLock()
+	oldDgraphSchema := vsr.admin.schema.DgraphSchema
+	vsr.admin.mux.RUnlock()
+	warnings, err := edgraph.ValidateDgraphSchema(ctx, schHandler.DGSchema(), oldDgraphSchema)
+	if err != nil {
+		return invalid(err)
+	}
+
+	payload["valid"] = true
+	payload["warnings"] = toGraphQLArray(warnings)
+	return result
+}
+
 func (gsr *getSchemaResolver) Rewrite(ctx context.Context,
 	gqlQuery schema.Query) ([]*gql.GraphQuery, error) {
 	gsr.gqlQuery = gqlQuery
@@ -139,6 +201,8 @@ func doQuery(gql *gqlSchema, field schema.Field) ([]byte, error) {
 			val, err = json.Marshal(gql.Schema)
 		case "generatedSchema":
 			val, err = json.Marshal(gql.GeneratedSchema)
+		case "dgraphSchema":
+			val, err = json.Marshal(gql.DgraphSchema)
 		}
 		x.Check2(val, err)
 
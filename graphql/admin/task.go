@@ -0,0 +1,194 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	taskRunning = "Running"
+	taskSuccess = "Success"
+	taskFailed  = "Failed"
+
+	// maxFinishedTasks is how many finished tasks are remembered. Once there are more, the
+	// oldest finished ones are forgotten, and polling them reports that they don't exist.
+	maxFinishedTasks = 100
+)
+
+// A task is a backup, export or restore started by an admin mutation. The mutation returns as
+// soon as the task is started, with the id of the task, and the task query reports how it's
+// going.
+//
+// Tasks only live in the memory of the alpha that started them, so they must be polled on the
+// same alpha, and are lost when it restarts.
+type task struct {
+	id            string
+	kind          string
+	status        string
+	message       string
+	exportedFiles []string
+	startedAt     time.Time
+	lastUpdated   time.Time
+}
+
+type taskList struct {
+	sync.Mutex
+	tasks    map[string]*task
+	finished []string
+	rand     *rand.Rand
+}
+
+var tasks = &taskList{
+	tasks: make(map[string]*task),
+	rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+}
+
+// start runs fn in the background as a new task of the given kind, and returns the id of the
+// task. fn returns the files it wrote, if any, or the error it failed with.
+func (tl *taskList) start(kind string, fn func() ([]string, error)) string {
+	tl.Lock()
+	id := fmt.Sprintf("%#x", tl.rand.Uint64())
+	for tl.tasks[id] != nil {
+		id = fmt.Sprintf("%#x", tl.rand.Uint64())
+	}
+	now := time.Now()
+	tl.tasks[id] = &task{id: id, kind: kind, status: taskRunning, startedAt: now,
+		lastUpdated: now}
+	tl.Unlock()
+
+	go func() {
+		files, err := fn()
+
+		tl.Lock()
+		defer tl.Unlock()
+		t := tl.tasks[id]
+		t.lastUpdated = time.Now()
+		if err != nil {
+			glog.Errorf("%s task %s failed: %v", kind, id, err)
+			t.status = taskFailed
+			t.message = err.Error()
+		} else {
+			glog.Infof("%s task %s completed.", kind, id)
+			t.status = taskSuccess
+			t.exportedFiles = files
+		}
+
+		tl.finished = append(tl.finished, id)
+		if len(tl.finished) > maxFinishedTasks {
+			delete(tl.tasks, tl.finished[0])
+			tl.finished = tl.finished[1:]
+		}
+	}()
+	return id
+}
+
+// get returns a copy of the task with the given id, or nil if there's no such task.
+func (tl *taskList) get(id string) *task {
+	tl.Lock()
+	defer tl.Unlock()
+	t, ok := tl.tasks[id]
+	if !ok {
+		return nil
+	}
+	cp := *t
+	return &cp
+}
+
+// wait blocks until the task with the given id has finished, and returns it.
+func (tl *taskList) wait(ctx context.Context, id string) (*task, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		t := tl.get(id)
+		if t == nil {
+			return nil, errors.Errorf("task %s not found", id)
+		}
+		if t.status != taskRunning {
+			return t, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForTask blocks until the task with the given id has finished, and returns the error it
+// failed with, if any.
+func WaitForTask(ctx context.Context, id string) error {
+	t, err := tasks.wait(ctx, id)
+	if err != nil {
+		return err
+	}
+	if t.status == taskFailed {
+		return errors.New(t.message)
+	}
+	return nil
+}
+
+type taskInput struct {
+	Id string
+}
+
+func resolveTask(ctx context.Context, q schema.Query) *resolve.Resolved {
+	inputByts, err := json.Marshal(q.ArgValue(schema.InputArgName))
+	if err != nil {
+		return resolve.EmptyResult(q, schema.GQLWrapf(err, "couldn't get input argument"))
+	}
+	var input taskInput
+	if err := json.Unmarshal(inputByts, &input); err != nil {
+		return resolve.EmptyResult(q, schema.GQLWrapf(err, "couldn't get input argument"))
+	}
+
+	t := tasks.get(input.Id)
+	if t == nil {
+		return resolve.EmptyResult(q, errors.Errorf("task %s not found. Tasks are only known "+
+			"to the alpha that started them, until it restarts.", input.Id))
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{q.Name(): map[string]interface{}{
+			"id":            t.id,
+			"kind":          t.kind,
+			"status":        t.status,
+			"message":       t.message,
+			"exportedFiles": toGraphQLArray(t.exportedFiles),
+			"startedAt":     t.startedAt.Format(time.RFC3339),
+			"lastUpdated":   t.lastUpdated.Format(time.RFC3339),
+		}},
+		Field: q,
+	}
+}
+
+// taskResponse is the payload of a mutation that started the task with the given id.
+func taskResponse(kind, id string) map[string]interface{} {
+	resp := response("Success", fmt.Sprintf("%s started with task ID %s.", kind, id))
+	resp["taskId"] = id
+	return resp
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+)
+
+// maxDiffSize is the most lines of the old schema times lines of the new one that a diff is
+// computed for, which bounds the memory it takes to about 16MB. Past that, the webhooks are sent
+// the schemas without a diff.
+const maxDiffSize = 4 << 20
+
+// schemaUpdate is the body of the requests sent to the schema webhooks.
+type schemaUpdate struct {
+	OldSchema string `json:"oldSchema"`
+	NewSchema string `json:"newSchema"`
+	// Diff is a line by line diff from the old schema to the new one. Lines only in the old
+	// schema start with "- ", lines only in the new one with "+ ", and lines in both with "  ".
+	Diff string `json:"diff"`
+}
+
+// notifySchemaWebhooks sends the old and new schemas, and the diff between them, to the URLs
+// given by the --graphql_schema_webhooks flag. The requests are sent in the background, and
+// failures are only logged, as they can't undo the schema update.
+func notifySchemaWebhooks(oldSchema, newSchema string) {
+	if len(x.Config.GraphqlSchemaWebhooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(&schemaUpdate{
+		OldSchema: oldSchema,
+		NewSchema: newSchema,
+		Diff:      schemaDiff(oldSchema, newSchema),
+	})
+	if err != nil {
+		glog.Errorf("couldn't marshal the schema update for the webhooks: %v", err)
+		return
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	for _, url := range x.Config.GraphqlSchemaWebhooks {
+		go func(url string) {
+			resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+			if err != nil {
+				glog.Errorf("couldn't send the schema update to webhook %s: %v", url, err)
+				return
+			}
+			defer resp.Body.Close()
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				glog.Errorf("webhook %s responded to the schema update with status %s", url,
+					resp.Status)
+			}
+		}(url)
+	}
+}
+
+// schemaDiff returns a line by line diff from oldSchema to newSchema, in the format described
+// for schemaUpdate.Diff. It's "" if the schemas are the same, or too large to be diffed.
+func schemaDiff(oldSchema, newSchema string) string {
+	if oldSchema == newSchema {
+		return ""
+	}
+	a, b := splitLines(oldSchema), splitLines(newSchema)
+	if len(a)*len(b) > maxDiffSize {
+		return ""
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			x.Check2(diff.WriteString("  " + a[i] + "\n"))
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			x.Check2(diff.WriteString("- " + a[i] + "\n"))
+			i++
+		default:
+			x.Check2(diff.WriteString("+ " + b[j] + "\n"))
+			j++
+		}
+	}
+	return diff.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
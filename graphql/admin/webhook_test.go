@@ -0,0 +1,121 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		diff     string
+	}{
+		{
+			name: "same",
+			old:  "type A {\n  a: String\n}\n",
+			new:  "type A {\n  a: String\n}\n",
+			diff: "",
+		},
+		{
+			name: "first schema",
+			old:  "",
+			new:  "type A {\n  a: String\n}",
+			diff: "+ type A {\n+   a: String\n+ }\n",
+		},
+		{
+			name: "field added",
+			old:  "type A {\n  a: String\n}\n",
+			new:  "type A {\n  a: String\n  b: Int\n}\n",
+			diff: "  type A {\n    a: String\n+   b: Int\n  }\n",
+		},
+		{
+			name: "field changed",
+			old:  "type A {\n  a: String\n  b: Int\n}",
+			new:  "type A {\n  a: String!\n  b: Int\n}",
+			diff: "  type A {\n-   a: String\n+   a: String!\n    b: Int\n  }\n",
+		},
+		{
+			name: "type removed",
+			old:  "type A {\n  a: String\n}\ntype B {\n  b: Int\n}",
+			new:  "type B {\n  b: Int\n}",
+			diff: "- type A {\n-   a: String\n- }\n  type B {\n    b: Int\n  }\n",
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			require.Equal(t, tcase.diff, schemaDiff(tcase.old, tcase.new))
+		})
+	}
+}
+
+func TestSchemaDiffTooLarge(t *testing.T) {
+	lines := func(line string, n int) string {
+		return strings.Repeat(line+"\n", n)
+	}
+	// Past maxDiffSize the webhooks are only sent the schemas.
+	require.Empty(t, schemaDiff(lines("a", 4096), lines("b", 2048)))
+	require.NotEmpty(t, schemaDiff(lines("a", 1024), lines("b", 1024)))
+}
+
+func TestNotifySchemaWebhooks(t *testing.T) {
+	updates := make(chan schemaUpdate, 2)
+	handler := func(status int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var update schemaUpdate
+			if err := json.NewDecoder(r.Body).Decode(&update); err == nil &&
+				r.Header.Get("Content-Type") == "application/json" {
+				updates <- update
+			}
+			w.WriteHeader(status)
+		}
+	}
+	ok := httptest.NewServer(handler(http.StatusOK))
+	defer ok.Close()
+	// A webhook that fails doesn't keep the others from being notified.
+	failing := httptest.NewServer(handler(http.StatusInternalServerError))
+	defer failing.Close()
+
+	defer func(urls []string) { x.Config.GraphqlSchemaWebhooks = urls }(
+		x.Config.GraphqlSchemaWebhooks)
+	x.Config.GraphqlSchemaWebhooks = []string{failing.URL, ok.URL}
+
+	notifySchemaWebhooks("type A {\n  a: String\n}", "type A {\n  a: Int\n}")
+	want := schemaUpdate{
+		OldSchema: "type A {\n  a: String\n}",
+		NewSchema: "type A {\n  a: Int\n}",
+		Diff:      "  type A {\n-   a: String\n+   a: Int\n  }\n",
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case update := <-updates:
+			require.Equal(t, want, update)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the webhooks weren't notified of the schema update")
+		}
+	}
+}
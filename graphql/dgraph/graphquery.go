@@ -66,6 +66,10 @@ func writeQuery(b *strings.Builder, query *gql.GraphQuery, prefix string) {
 		x.Check2(b.WriteString(" : "))
 	}
 	x.Check2(b.WriteString(query.Attr))
+	if len(query.Langs) > 0 {
+		x.Check2(b.WriteRune('@'))
+		x.Check2(b.WriteString(strings.Join(query.Langs, ":")))
+	}
 
 	if query.Func != nil {
 		writeRoot(b, query)
@@ -77,6 +81,28 @@ func writeQuery(b *strings.Builder, query *gql.GraphQuery, prefix string) {
 		x.Check2(b.WriteRune(')'))
 	}
 
+	if query.FacetsFilter != nil {
+		x.Check2(b.WriteString(" @facets("))
+		writeFilter(b, query.FacetsFilter)
+		x.Check2(b.WriteRune(')'))
+	}
+
+	if len(query.FacetsOrder) > 0 {
+		x.Check2(b.WriteString(" @facets("))
+		for i, ord := range query.FacetsOrder {
+			if i != 0 {
+				x.Check2(b.WriteString(", "))
+			}
+			if ord.Desc {
+				x.Check2(b.WriteString("orderdesc: "))
+			} else {
+				x.Check2(b.WriteString("orderasc: "))
+			}
+			x.Check2(b.WriteString(ord.Key))
+		}
+		x.Check2(b.WriteRune(')'))
+	}
+
 	if query.Func == nil && hasOrderOrPage(query) {
 		x.Check2(b.WriteString(" ("))
 		writeOrderAndPage(b, query, false)
@@ -210,14 +236,15 @@ func writeFilter(b *strings.Builder, ft *gql.FilterTree) {
 func hasOrderOrPage(q *gql.GraphQuery) bool {
 	_, hasFirst := q.Args["first"]
 	_, hasOffset := q.Args["offset"]
-	return len(q.Order) > 0 || hasFirst || hasOffset
+	_, hasAfter := q.Args["after"]
+	return len(q.Order) > 0 || hasFirst || hasOffset || hasAfter
 }
 
 func writeOrderAndPage(b *strings.Builder, query *gql.GraphQuery, root bool) {
-	var wroteOrder, wroteFirst bool
+	var wroteOrder, wroteFirst, wroteOffset bool
 
 	for _, ord := range query.Order {
-		if root {
+		if root || wroteOrder {
 			x.Check2(b.WriteString(", "))
 		}
 		if ord.Desc {
@@ -244,5 +271,14 @@ func writeOrderAndPage(b *strings.Builder, query *gql.GraphQuery, root bool) {
 		}
 		x.Check2(b.WriteString("offset: "))
 		x.Check2(b.WriteString(offset))
+		wroteOffset = true
+	}
+
+	if after, ok := query.Args["after"]; ok {
+		if root || wroteOrder || wroteFirst || wroteOffset {
+			x.Check2(b.WriteString(", "))
+		}
+		x.Check2(b.WriteString("after: "))
+		x.Check2(b.WriteString(after))
 	}
 }
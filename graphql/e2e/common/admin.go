@@ -285,6 +285,8 @@ func health(t *testing.T) {
           uptime
           lastEcho
           ee_features
+          last_applied_index
+          protocol_version
         }
       }`,
 	}
@@ -313,6 +315,7 @@ func health(t *testing.T) {
 		cmpopts.IgnoreFields(pb.HealthInfo{}, "LastEcho"),
 		cmpopts.IgnoreFields(pb.HealthInfo{}, "Ongoing"),
 		cmpopts.IgnoreFields(pb.HealthInfo{}, "MaxAssigned"),
+		cmpopts.IgnoreFields(pb.HealthInfo{}, "LastAppliedIndex"),
 		cmpopts.EquateEmpty(),
 	}
 	if diff := cmp.Diff(health, result.Health, opts...); diff != "" {
@@ -228,6 +228,7 @@ type GqlSchema struct {
 	Id              string
 	Schema          string
 	GeneratedSchema string
+	DgraphSchema    string
 }
 
 func probeGraphQL(authority string) (*ProbeGraphQLResp, error) {
@@ -293,6 +294,7 @@ func getGQLSchema(t *testing.T, authority string) *GraphQLResponse {
 				id
 				schema
 				generatedSchema
+				dgraphSchema
 			}
 		}`,
 	}
@@ -330,6 +332,7 @@ func updateGQLSchema(t *testing.T, authority, schema string, headers http.Header
 					id
 					schema
 					generatedSchema
+					dgraphSchema
 				}
 			}
 		}`,
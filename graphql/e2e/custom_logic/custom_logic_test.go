@@ -530,10 +530,12 @@ func TestCustomQueryShouldPropagateErrorFromFields(t *testing.T) {
 	expectedErrors := x.GqlErrorList{
 		&x.GqlError{Message: "Evaluation of custom field failed because external request " +
 			"returned an error: unexpected error with: 404 for field: cars within type: Person.",
-			Locations: []x.Location{{Line: 6, Column: 4}}},
+			Locations: []x.Location{{Line: 6, Column: 4}},
+			Path:      []interface{}{"queryPerson", float64(0), "cars"}},
 		&x.GqlError{Message: "Evaluation of custom field failed because external request returned" +
 			" an error: unexpected error with: 404 for field: bikes within type: Person.",
-			Locations: []x.Location{{Line: 9, Column: 4}}},
+			Locations: []x.Location{{Line: 9, Column: 4}},
+			Path:      []interface{}{"queryPerson", float64(0), "bikes"}},
 	}
 	require.Contains(t, result.Errors, expectedErrors[0])
 	require.Contains(t, result.Errors, expectedErrors[1])
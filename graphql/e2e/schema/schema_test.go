@@ -561,8 +561,13 @@ func TestUpdateGQLSchemaFields(t *testing.T) {
 
 	generatedSchema, err := ioutil.ReadFile("generatedSchema.graphql")
 	require.NoError(t, err)
-	require.Equal(t, string(generatedSchema), common.SafelyUpdateGQLSchema(t, groupOneHTTP,
-		schema, nil).GeneratedSchema)
+	updated := common.SafelyUpdateGQLSchema(t, groupOneHTTP, schema, nil)
+	require.Equal(t, string(generatedSchema), updated.GeneratedSchema)
+	require.Contains(t, updated.DgraphSchema, "Author.name: string .")
+
+	current := common.AssertGetGQLSchemaRequireId(t, groupOneHTTP)
+	require.Equal(t, updated.GeneratedSchema, current.GeneratedSchema)
+	require.Equal(t, updated.DgraphSchema, current.DgraphSchema)
 }
 
 func TestIntrospection(t *testing.T) {
@@ -617,7 +622,7 @@ func TestDeleteSchemaAndExport(t *testing.T) {
 	exportReq := &common.GraphQLParams{
 		Query: `mutation {
 		  export(input: {format: "rdf"}) {
-			exportedFiles
+			taskId
 		  }
 		}`,
 	}
@@ -0,0 +1,252 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/authorization"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxCachedResults is the most results a resultCache holds, and maxCachedBytes the most bytes of
+// results and keys. Once either is reached, expired results are dropped to make room, and if that
+// doesn't make enough the new result isn't cached.
+const (
+	maxCachedResults = 1000
+	maxCachedBytes   = 64 << 20
+)
+
+// A resultCache holds the results of queries that asked for caching with @cacheControl, for the
+// maxAge given in the directive. Results are cached per query, variables and the JWTs of the
+// request, so users with different auth never see each other's results. A mutation resolved
+// by the same RequestResolver drops the cached results of every query involving a type that
+// the mutation could have changed.
+//
+// The cache lives in a RequestResolver, so it's emptied when the GraphQL schema changes.
+// Mutations made through another alpha, or through DQL, don't invalidate it; for those, a
+// cached result can be stale for at most its maxAge.
+type resultCache struct {
+	sync.Mutex
+	results map[string]*cachedResult
+	// size is the number of bytes of the cached results and their keys.
+	size int
+	// generation is bumped by every invalidation. A query reads it before it runs, and its
+	// result is only cached if no mutation invalidated the cache while it ran, as the result
+	// could have been read before the mutation was committed.
+	generation uint64
+}
+
+type cachedResult struct {
+	data    []byte
+	expires time.Time
+	// types are the names of all the GraphQL types the query read.
+	types map[string]bool
+}
+
+func newResultCache() *resultCache {
+	return &resultCache{results: make(map[string]*cachedResult)}
+}
+
+// cacheKey returns the key of the result of gqlReq in the cache, or "" if the result of op
+// can't be cached.
+func cacheKey(ctx context.Context, gqlReq *schema.Request, op schema.Operation) string {
+	if !op.IsQuery() || op.CacheMaxAge() <= 0 {
+		return ""
+	}
+	for _, q := range op.Queries() {
+		// The results of @custom and @lambda fields can depend on headers of the request,
+		// and on data outside Dgraph, so they are never cached.
+		if hasCustomOrLambdaField(q) {
+			return ""
+		}
+	}
+
+	vars, err := json.Marshal(gqlReq.Variables)
+	if err != nil {
+		return ""
+	}
+	var accessJwt string
+	if jwt, err := x.ExtractJwt(ctx); err == nil {
+		accessJwt = jwt[0]
+	}
+	key, err := json.Marshal([]string{gqlReq.Query, gqlReq.OperationName, string(vars),
+		authorization.GetJwtToken(ctx), accessJwt})
+	if err != nil {
+		return ""
+	}
+	return string(key)
+}
+
+func hasCustomOrLambdaField(f schema.Field) bool {
+	if custom, _ := f.HasCustomDirective(); custom || f.HasLambdaDirective() {
+		return true
+	}
+	for _, child := range f.SelectionSet() {
+		if hasCustomOrLambdaField(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the cached result for key, if there is one that hasn't expired, and the current
+// generation of the cache, which set needs to cache a new result for key.
+func (c *resultCache) get(key string) ([]byte, uint64, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	res, ok := c.results[key]
+	if !ok {
+		return nil, c.generation, false
+	}
+	if time.Now().After(res.expires) {
+		c.remove(key)
+		return nil, c.generation, false
+	}
+	return res.data, c.generation, true
+}
+
+// set caches data as the result of op for the maxAge given in op, unless the cache was
+// invalidated since generation was read from it.
+func (c *resultCache) set(key string, generation uint64, op schema.Operation, data []byte) {
+	size := len(key) + len(data)
+	if size > maxCachedBytes {
+		return
+	}
+	types := make(map[string]bool)
+	for _, q := range op.Queries() {
+		addQueriedTypes(q, types)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.generation != generation {
+		return
+	}
+	if _, ok := c.results[key]; ok {
+		c.remove(key)
+	}
+	if len(c.results) >= maxCachedResults || c.size+size > maxCachedBytes {
+		now := time.Now()
+		for k, res := range c.results {
+			if now.After(res.expires) {
+				c.remove(k)
+			}
+		}
+		if len(c.results) >= maxCachedResults || c.size+size > maxCachedBytes {
+			return
+		}
+	}
+	c.results[key] = &cachedResult{
+		data:    append([]byte(nil), data...),
+		expires: time.Now().Add(op.CacheMaxAge()),
+		types:   types,
+	}
+	c.size += size
+}
+
+// remove drops the cached result for key. c must be locked.
+func (c *resultCache) remove(key string) {
+	c.size -= len(key) + len(c.results[key].data)
+	delete(c.results, key)
+}
+
+// invalidate drops the cached results of all queries that read any of the given types.
+func (c *resultCache) invalidate(types map[string]bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.generation++
+	for k, res := range c.results {
+		for typ := range types {
+			if res.types[typ] {
+				c.remove(k)
+				break
+			}
+		}
+	}
+}
+
+// invalidateAll drops all the cached results.
+func (c *resultCache) invalidateAll() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.generation++
+	c.results = make(map[string]*cachedResult)
+	c.size = 0
+}
+
+// addQueriedTypes adds the names of the types read by f, and its selection set, to types.
+// For an interface or union, the types that make it up are added as well.
+func addQueriedTypes(f schema.Field, types map[string]bool) {
+	for _, typ := range []schema.Type{f.Type(), f.ConstructedFor()} {
+		if typ == nil || typ.IsInbuiltOrEnumType() {
+			continue
+		}
+		types[typ.Name()] = true
+		for _, impl := range typ.ImplementingTypes() {
+			types[impl.Name()] = true
+		}
+	}
+	for _, child := range f.SelectionSet() {
+		addQueriedTypes(child, types)
+	}
+}
+
+// mutatedTypes returns the names of the types that m could change. That's the mutated type,
+// and, because a mutation can add, update or unlink nested objects, any type that can be
+// reached from it through its fields. For an interface or union, that includes all the types
+// that make it up.
+func mutatedTypes(m schema.Mutation) map[string]bool {
+	types := make(map[string]bool)
+	var visit func(typ schema.Type)
+	visit = func(typ schema.Type) {
+		if typ.IsInbuiltOrEnumType() || types[typ.Name()] {
+			return
+		}
+		types[typ.Name()] = true
+		for _, impl := range typ.ImplementingTypes() {
+			visit(impl)
+		}
+		for _, fld := range typ.Fields() {
+			visit(fld.Type())
+		}
+	}
+	visit(m.MutatedType())
+	return types
+}
+
+// invalidateFor drops the cached results that the mutations of op could have made stale.
+// Custom mutations can change anything, so they drop the whole cache.
+func (c *resultCache) invalidateFor(op schema.Operation) {
+	for _, m := range op.Mutations() {
+		switch m.MutationType() {
+		case schema.AddMutation, schema.UpdateMutation, schema.DeleteMutation:
+			c.invalidate(mutatedTypes(m))
+		default:
+			c.invalidateAll()
+			return
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/graphql/test"
 	"github.com/stretchr/testify/require"
 )
@@ -226,3 +227,50 @@ func TestMultipleMutationsPropagateExtensionsCorrectly(t *testing.T) {
 		}
 	}
 }
+
+func TestQueriesReturnCursors(t *testing.T) {
+	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
+	query := `
+	query {
+      queryAuthor(first: 2) {
+        name
+        postsNullable(order: { asc: title }, first: 1, after: "eyJvIjoiYXNjOnRpdGxlIiwibiI6MjB9") {
+          title
+        }
+      }
+    }`
+	dgResponse := `{"queryAuthor": [
+		{"name": "A", "dgraph.uid": "0x1", "postsNullable": [{"title": "T", "dgraph.uid": "0x3"}]},
+		{"name": "B", "dgraph.uid": "0x2", "postsNullable": []}
+	]}`
+
+	resp := resolveWithClient(gqlSchema, query, nil, &executor{resp: dgResponse})
+	require.Nil(t, resp.Errors)
+
+	// Only full pages get a cursor, and the ordered ones count the nodes already seen.
+	require.Equal(t, map[string]string{
+		"queryAuthor":                 schema.Cursor{After: 0x2}.String(),
+		"queryAuthor.0.postsNullable": schema.Cursor{Order: "asc:title", Offset: 21}.String(),
+	}, resp.Extensions.Cursors)
+}
+
+func TestQueriesRejectBadCursors(t *testing.T) {
+	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
+	query := `
+	query($after: ID) {
+      queryAuthor(order: { asc: name }, after: $after) {
+        name
+      }
+    }`
+
+	for after, msg := range map[string]string{
+		"0x1":                              "invalid after cursor",
+		schema.Cursor{After: 0x1}.String(): "after cursor for a different order",
+		schema.Cursor{Order: "desc:name"}.String(): "after cursor for a different order",
+	} {
+		resp := resolveWithClient(gqlSchema, query, map[string]interface{}{"after": after},
+			&executor{})
+		require.Len(t, resp.Errors, 1, after)
+		require.Contains(t, resp.Errors[0].Message, msg, after)
+	}
+}
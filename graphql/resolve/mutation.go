@@ -30,9 +30,15 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	otrace "go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-const touchedUidsKey = "_total"
+const (
+	touchedUidsKey = "_total"
+
+	atomicTxnKey resolveCtxKey = "atomicTxn"
+)
 
 // Mutations come in like this with variables:
 //
@@ -157,6 +163,48 @@ func NewDgraphResolver(
 	}
 }
 
+// An atomicTxn is the Dgraph transaction that all the mutations of an atomic request run in.
+// The mutations don't commit it themselves.  Once they have all run, the request commits it
+// if they all succeeded, and aborts it otherwise, so either all or none of them are applied.
+type atomicTxn struct {
+	txn      *dgoapi.TxnContext
+	executor DgraphExecutor
+}
+
+// withAtomicTxn returns a context under which all the mutations run in the same transaction.
+func withAtomicTxn(ctx context.Context) (context.Context, *atomicTxn) {
+	atomic := &atomicTxn{}
+	return context.WithValue(ctx, atomicTxnKey, atomic), atomic
+}
+
+// startTs returns the start timestamp of the transaction, or 0 if nothing has run in it yet.
+func (a *atomicTxn) startTs() uint64 {
+	return a.txn.GetStartTs()
+}
+
+// merge adds the keys and predicates that a request written by ex changed in the transaction.
+func (a *atomicTxn) merge(tc *dgoapi.TxnContext, ex DgraphExecutor) {
+	if tc == nil {
+		return
+	}
+
+	a.executor = ex
+	if a.txn == nil {
+		a.txn = &dgoapi.TxnContext{StartTs: tc.StartTs}
+	}
+	a.txn.Keys = append(a.txn.Keys, tc.Keys...)
+	a.txn.Preds = append(a.txn.Preds, tc.Preds...)
+}
+
+// commitOrAbort commits the transaction, or aborts it if abort is true.
+func (a *atomicTxn) commitOrAbort(ctx context.Context, abort bool) error {
+	if a.txn == nil {
+		return nil
+	}
+	a.txn.Aborted = abort
+	return a.executor.CommitOrAbort(ctx, a.txn)
+}
+
 // mutationResolver can resolve a single GraphQL mutation field
 type dgraphResolver struct {
 	mutationRewriter MutationRewriter
@@ -203,9 +251,12 @@ func (mr *dgraphResolver) rewriteAndExecute(ctx context.Context,
 	mutation schema.Mutation) (*Resolved, bool) {
 	var mutResp *dgoapi.Response
 	commit := false
+	atomic, _ := ctx.Value(atomicTxnKey).(*atomicTxn)
 
 	defer func() {
-		if !commit && mutResp != nil && mutResp.Txn != nil {
+		// The transaction of an atomic request is aborted by the request, once all its
+		// mutations have run.
+		if !commit && atomic == nil && mutResp != nil && mutResp.Txn != nil {
 			mutResp.Txn.Aborted = true
 			err := mr.executor.CommitOrAbort(ctx, mutResp.Txn)
 			if err != nil {
@@ -260,6 +311,9 @@ func (mr *dgraphResolver) rewriteAndExecute(ctx context.Context,
 
 	result := make(map[string]interface{})
 	req := &dgoapi.Request{}
+	if atomic != nil {
+		req.StartTs = atomic.startTs()
+	}
 	newNodes := make(map[string]schema.Type)
 
 	mutationTimer := newtimer(ctx, &dgraphMutationDuration.OffsetDuration)
@@ -271,10 +325,13 @@ func (mr *dgraphResolver) rewriteAndExecute(ctx context.Context,
 		mutResp, err = mr.executor.Execute(ctx, req)
 		if err != nil {
 			gqlErr := schema.GQLWrapLocationf(
-				err, mutation.Location(), "mutation %s failed", mutation.Name())
+				withTxnConflictCode(err), mutation.Location(), "mutation %s failed", mutation.Name())
 			return emptyResult(gqlErr), resolverFailed
 
 		}
+		if atomic != nil {
+			atomic.merge(mutResp.Txn, mr.executor)
+		}
 
 		ext.TouchedUids += mutResp.GetMetrics().GetNumUids()[touchedUidsKey]
 		if req.Query != "" && len(mutResp.GetJson()) != 0 {
@@ -302,18 +359,28 @@ func (mr *dgraphResolver) rewriteAndExecute(ctx context.Context,
 		return emptyResult(errs), resolverFailed
 	}
 
-	err = mr.executor.CommitOrAbort(ctx, mutResp.Txn)
-	if err != nil {
-		return emptyResult(
-				schema.GQLWrapf(authErr, "mutation failed, couldn't commit transaction")),
-			resolverFailed
+	qryReq := &dgoapi.Request{Query: dgraph.AsString(dgQuery), ReadOnly: true}
+	if atomic == nil {
+		err = mr.executor.CommitOrAbort(ctx, mutResp.Txn)
+		if err != nil {
+			return emptyResult(
+					schema.GQLWrapf(withTxnConflictCode(err),
+						"mutation failed, couldn't commit transaction")),
+				resolverFailed
+		}
+		commit = true
+	} else {
+		// The transaction isn't committed yet, so the result is read in it, to see what the
+		// mutation wrote.
+		qryReq = &dgoapi.Request{
+			Query:   dgraph.AsString(dgQuery),
+			StartTs: mutResp.GetTxn().GetStartTs(),
+		}
 	}
-	commit = true
 
 	queryTimer := newtimer(ctx, &dgraphQueryDuration.OffsetDuration)
 	queryTimer.Start()
-	qryResp, err := mr.executor.Execute(ctx, &dgoapi.Request{Query: dgraph.AsString(dgQuery),
-		ReadOnly: true})
+	qryResp, err := mr.executor.Execute(ctx, qryReq)
 	queryTimer.Stop()
 
 	errs = schema.AppendGQLErrs(errs, schema.GQLWrapf(err,
@@ -370,6 +437,20 @@ func deleteCompletion() CompletionFunc {
 	})
 }
 
+// authorizationFailed returns the error for a mutation that the auth rules don't allow.
+func authorizationFailed() error {
+	return schema.WithErrorCode(x.GqlErrorf("authorization failed"), schema.ErrAuthDenied)
+}
+
+// withTxnConflictCode sets the ErrTxnConflict code on err if the transaction was aborted
+// because it conflicted with another one, so that clients know they can retry the mutation.
+func withTxnConflictCode(err error) error {
+	if status.Code(err) != codes.Aborted {
+		return err
+	}
+	return schema.WithErrorCode(err, schema.ErrTxnConflict)
+}
+
 // authorizeNewNodes takes the new nodes (uids) actually created by a GraphQL mutation and
 // the types that mutation rewriting expects those nodes to be (newNodeTypes) and checks if
 // the JWT that came in with the request is authorized to create those nodes.  We can't check
@@ -439,7 +520,7 @@ func authorizeNewNodes(
 		rbac := rn.EvaluateStatic(newRw.authVariables)
 
 		if rbac == schema.Negative {
-			return x.GqlErrorf("authorization failed")
+			return authorizationFailed()
 		}
 
 		if rbac == schema.Positive {
@@ -516,17 +597,17 @@ func authorizeNewNodes(
 
 			// FIXME: what do we actually want to return to users when auth failed?
 			// Is this too much?
-			return x.GqlErrorf("authorization failed")
+			return authorizationFailed()
 		}
 
 		foundUIDs, ok := check.([]interface{})
 		if !ok {
-			return x.GqlErrorf("authorization failed")
+			return authorizationFailed()
 		}
 
 		if len(newByType[typeName]) != len(foundUIDs) {
 			// Some of the created nodes passed auth and some failed.
-			return x.GqlErrorf("authorization failed")
+			return authorizationFailed()
 		}
 	}
 
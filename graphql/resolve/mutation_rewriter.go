@@ -262,6 +262,10 @@ func (xidMetadata *xidMetadata) isDuplicateXid(atTopLevel bool, xidVar string,
 //   "Author.friends":[ {"uid":"0x123"} ],
 // }
 func (mrw *AddRewriter) Rewrite(ctx context.Context, m schema.Mutation) ([]*UpsertMutation, error) {
+	if err := validateCursors(m); err != nil {
+		return nil, err
+	}
+
 	mutatedType := m.MutatedType()
 	val, _ := m.ArgValue(schema.InputArgName).([]interface{})
 	varGen := NewVariableGenerator()
@@ -425,6 +429,10 @@ func (urw *UpdateRewriter) Rewrite(
 	ctx context.Context,
 	m schema.Mutation) ([]*UpsertMutation, error) {
 
+	if err := validateCursors(m); err != nil {
+		return nil, err
+	}
+
 	mutatedType := m.MutatedType()
 
 	inp := m.ArgValue(schema.InputArgName).(map[string]interface{})
@@ -704,11 +712,11 @@ func RewriteUpsertQueryFromMutation(
 		addTypeFunc(dgQuery[0], m.MutatedType().DgraphName())
 	}
 
-	_ = addFilter(dgQuery[0], m.MutatedType(), filter)
+	_, filterQrys := addFilter(dgQuery[0], m.MutatedType(), filter, authRw.varGen)
 
 	dgQuery = authRw.addAuthQueries(m.MutatedType(), dgQuery, rbac)
 
-	return dgQuery
+	return append(dgQuery, filterQrys...)
 }
 
 // removeNodeReference removes any reference we know about (via @hasInverse) into a node.
@@ -757,6 +765,9 @@ func (drw *deleteRewriter) Rewrite(
 			"(internal error) call to build delete mutation for %s mutation type",
 			m.MutationType())
 	}
+	if err := validateCursors(m); err != nil {
+		return nil, err
+	}
 
 	varGen := NewVariableGenerator()
 
@@ -1825,11 +1836,11 @@ func authCheck(chk resultChecker, qry string) resultChecker {
 
 				authVal, authExists := m[qry+".auth"]
 				if !authExists || authVal == nil {
-					return x.GqlErrorf("authorization failed")
+					return authorizationFailed()
 				}
 
 				if authData, ok := authVal.([]interface{}); ok && len(authData) != len(data) {
-					return x.GqlErrorf("authorization failed")
+					return authorizationFailed()
 				}
 
 				// auth passed, but still need to check the existing conditions
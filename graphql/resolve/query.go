@@ -155,10 +155,95 @@ func (qr *queryResolver) rewriteAndExecute(ctx context.Context, query schema.Que
 	ext.TouchedUids = resp.GetMetrics().GetNumUids()[touchedUidsKey]
 	resolved := completeDgraphResult(ctx, query, resp.GetJson(), err)
 	resolved.Extensions = ext
+	if data, ok := resolved.Data.(map[string]interface{}); ok && query.QueryType() != schema.DQLQuery {
+		cursors := make(map[string]string)
+		addCursors(cursors, query, query.ResponseName(), data[query.DgraphAlias()])
+		if len(cursors) > 0 {
+			ext.Cursors = cursors
+		}
+	}
 
 	return resolved
 }
 
+// addCursors adds to cursors the cursor of the next page of each list in val, the Dgraph result
+// of field at path in the response. Only lists paged with `first` that came back full get one, so
+// a list without a cursor has no more pages.
+func addCursors(cursors map[string]string, field schema.Field, path string, val interface{}) {
+	switch val := val.(type) {
+	case map[string]interface{}:
+		addChildCursors(cursors, field, path, val)
+	case []interface{}:
+		first := intArgValue(field, "first")
+		if len(val) > 0 && int64(len(val)) == first && field.Type().ListType() != nil &&
+			field.Type().IDField() != nil {
+			if cursor, ok := nextCursor(field, val[len(val)-1], first); ok {
+				cursors[path] = cursor.String()
+			}
+		}
+		for i, v := range val {
+			if obj, ok := v.(map[string]interface{}); ok {
+				addChildCursors(cursors, field, path+"."+strconv.Itoa(i), obj)
+			}
+		}
+	}
+}
+
+// addChildCursors adds the cursors of the lists in obj, a node that field returned. The fields
+// are looked up in obj by the aliases that completeObject uses.
+func addChildCursors(cursors map[string]string, field schema.Field, path string,
+	obj map[string]interface{}) {
+	fieldSeenCount := make(map[string]int)
+	seenField := make(map[string]bool)
+	for _, f := range field.SelectionSet() {
+		if f.Skip() || !f.Include() || seenField[f.ResponseName()] {
+			continue
+		}
+		seenField[f.ResponseName()] = true
+		if !f.Type().IsInbuiltOrEnumType() && !f.IsAggregateField() {
+			addCursors(cursors, f, path+"."+f.ResponseName(),
+				obj[generateUniqueDgraphAlias(f, fieldSeenCount)])
+		}
+		fieldSeenCount[f.DgraphAlias()]++
+	}
+}
+
+// nextCursor returns the cursor of the page of field that follows the page ending at last, a
+// page of first nodes.
+func nextCursor(field schema.Field, last interface{}, first int64) (schema.Cursor, bool) {
+	if order := schema.OrderKey(field.ArgValue("order")); order != "" {
+		var seen int64
+		if after, ok := field.ArgValue("after").(string); ok {
+			cursor, _ := schema.ParseCursor(after)
+			seen = cursor.Offset
+		}
+		return schema.Cursor{
+			Order:  order,
+			Offset: seen + intArgValue(field, "offset") + first,
+		}, true
+	}
+
+	node, _ := last.(map[string]interface{})
+	// The uid is in the ID field if it was asked for, or else under the alias that the rewriter
+	// adds for it.
+	id, ok := node["dgraph.uid"].(string)
+	for _, f := range field.SelectionSet() {
+		if f.Type().Name() == schema.IDType {
+			if fid, ok2 := node[f.DgraphAlias()].(string); ok2 {
+				id, ok = fid, true
+			}
+		}
+	}
+	if !ok {
+		return schema.Cursor{}, false
+	}
+	uid, err := strconv.ParseUint(id, 0, 64)
+	if err != nil || uid == 0 {
+		return schema.Cursor{}, false
+	}
+	return schema.Cursor{After: uid}, true
+}
+
 func resolveIntrospection(ctx context.Context, q schema.Query) *Resolved {
 	data, err := schema.Introspect(q)
 
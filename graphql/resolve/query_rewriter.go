@@ -129,6 +129,10 @@ func (qr *queryRewriter) Rewrite(
 	authRw.hasAuthRules = hasAuthRules(gqlQuery, authRw)
 	authRw.hasCascade = hasCascadeDirective(gqlQuery)
 
+	if err := validateCursors(gqlQuery); err != nil {
+		return nil, err
+	}
+
 	switch gqlQuery.QueryType() {
 	case schema.GetQuery:
 
@@ -172,9 +176,10 @@ func aggregateQuery(query schema.Query, authRw *authRewriter) []*gql.GraphQuery
 
 	// Add filter
 	filter, _ := query.ArgValue("filter").(map[string]interface{})
-	_ = addFilter(dgQuery[0], mainType, filter)
+	_, filterQrys := addFilter(dgQuery[0], mainType, filter, authRw.varGen)
 
 	dgQuery = authRw.addAuthQueries(mainType, dgQuery, rbac)
+	dgQuery = append(dgQuery, filterQrys...)
 
 	// mainQuery is the query with Attr: query.Name()
 	// It is the first query in dgQuery list.
@@ -394,7 +399,7 @@ func rewriteAsQueryByIds(
 		addUIDFunc(dgQuery[0], intersection(ids, uids))
 	}
 
-	addArgumentsToField(dgQuery[0], field)
+	filterQrys := addArgumentsToField(dgQuery[0], field, authRw.varGen)
 
 	// The function getQueryByIds is called for passwordQuery or fetching query result types
 	// after making a mutation. In both cases, we want the selectionSet to use the `query` auth
@@ -410,6 +415,7 @@ func rewriteAsQueryByIds(
 	addCascadeDirective(dgQuery[0], field)
 
 	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, rbac)
+	dgQuery = append(dgQuery, filterQrys...)
 
 	if len(selectionAuth) > 0 {
 		dgQuery = append(dgQuery, selectionAuth...)
@@ -419,12 +425,16 @@ func rewriteAsQueryByIds(
 }
 
 // addArgumentsToField adds various different arguments to a field, such as
-// filter, order and pagination.
-func addArgumentsToField(dgQuery *gql.GraphQuery, field schema.Field) {
+// filter, order and pagination. It returns the var blocks needed by the filter.
+func addArgumentsToField(
+	dgQuery *gql.GraphQuery,
+	field schema.Field,
+	varGen *VariableGenerator) []*gql.GraphQuery {
 	filter, _ := field.ArgValue("filter").(map[string]interface{})
-	_ = addFilter(dgQuery, field.Type(), filter)
+	_, filterQrys := addFilter(dgQuery, field.Type(), filter, varGen)
 	addOrder(dgQuery, field)
 	addPagination(dgQuery, field)
+	return filterQrys
 }
 
 func addTopLevelTypeFilter(query *gql.GraphQuery, field schema.Field) {
@@ -482,24 +492,10 @@ func rewriteAsGet(
 		},
 	}
 
-	if uid > 0 {
-		dgQuery = []*gql.GraphQuery{{
-			Attr: query.Name(),
-			Func: &gql.Function{
-				Name: "uid",
-				UID:  []uint64{uid},
-			},
-		}}
-		dgQuery[0].Filter = &gql.FilterTree{
-			Func: eqXidFunc,
-		}
-
-	} else {
-		dgQuery = []*gql.GraphQuery{{
-			Attr: query.Name(),
-			Func: eqXidFunc,
-		}}
-	}
+	dgQuery = []*gql.GraphQuery{{
+		Attr: query.Name(),
+		Func: eqXidFunc,
+	}}
 
 	// Apply query auth rules even for password query
 	oldAuthSelector := auth.selector
@@ -564,7 +560,7 @@ func rewriteAsQuery(field schema.Field, authRw *authRewriter) []*gql.GraphQuery
 		return dgQuery
 	}
 
-	addArgumentsToField(dgQuery[0], field)
+	filterQrys := addArgumentsToField(dgQuery[0], field, authRw.varGen)
 	selectionAuth := addSelectionSetFrom(dgQuery[0], field, authRw)
 	// we don't need to query uid for auth queries, as they always have at least one field in their
 	// selection set.
@@ -574,6 +570,7 @@ func rewriteAsQuery(field schema.Field, authRw *authRewriter) []*gql.GraphQuery
 	addCascadeDirective(dgQuery[0], field)
 
 	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, rbac)
+	dgQuery = append(dgQuery, filterQrys...)
 
 	if len(selectionAuth) > 0 {
 		return append(dgQuery, selectionAuth...)
@@ -906,7 +903,8 @@ func (authRw *authRewriter) rewriteRuleNode(
 		r1[0].Attr = "var"
 		r1[0].Cascade = append(r1[0].Cascade, "__all__")
 
-		return []*gql.GraphQuery{r1[0]}, &gql.FilterTree{
+		// Any other queries in r1 are the var blocks of filters on child objects in the rule.
+		return r1, &gql.FilterTree{
 			Func: &gql.Function{
 				Name: "uid",
 				Args: []gql.Arg{{Value: varName}},
@@ -1035,7 +1033,7 @@ func buildAggregateFields(
 	// Filter for aggregate Fields. This is added to all count aggregate fields
 	// and mainField
 	fieldFilter, _ := f.ArgValue("filter").(map[string]interface{})
-	_ = addFilter(mainField, constructedForType, fieldFilter)
+	_, filterQrys := addFilter(mainField, constructedForType, fieldFilter, auth.varGen)
 
 	// addedAggregateFields is a map from aggregate field name to boolean
 	addedAggregateField := make(map[string]bool)
@@ -1051,8 +1049,9 @@ func buildAggregateFields(
 		Alias: "count_" + fieldAlias,
 		Attr:  "count(" + constructedForDgraphPredicate + ")",
 	}
-	// Add filter to count aggregation field.
-	_ = addFilter(aggregateChild, constructedForType, fieldFilter)
+	// Add filter to count aggregation field. It's the same filter as mainField, so they can share
+	// any var blocks written for it.
+	aggregateChild.Filter = mainField.Filter
 	aggregateChildren = append(aggregateChildren, aggregateChild)
 
 	// Iterate over fields queried inside aggregate.
@@ -1165,6 +1164,7 @@ func buildAggregateFields(
 	// not added to them.
 	aggregateChildren = append(aggregateChildren, otherAggregateChildren...)
 	retAuthQueries = append(retAuthQueries, fieldAuth...)
+	retAuthQueries = append(retAuthQueries, filterQrys...)
 	return aggregateChildren, retAuthQueries
 }
 
@@ -1180,6 +1180,23 @@ func generateUniqueDgraphAlias(f schema.Field, fieldSeenCount map[string]int) st
 	return alias + "." + strconv.Itoa(fieldSeenCount[alias])
 }
 
+// langDgraphAlias returns the alias in the Dgraph query of a field that's asked for in the
+// languages given in its lang argument, or "" if it has no lang argument.  Unlike other scalar
+// fields, each occurrence of such a field is queried separately, so that aliases for different
+// languages each get their own value.
+func langDgraphAlias(f schema.Field, fieldSeenCount map[string]int) string {
+	if _, ok := f.ArgValue("lang").(string); !ok {
+		return ""
+	}
+	key := f.DgraphAlias() + ".lang"
+	alias := key
+	if fieldSeenCount[key] > 0 {
+		alias = key + "." + strconv.Itoa(fieldSeenCount[key])
+	}
+	fieldSeenCount[key]++
+	return alias
+}
+
 // TODO(GRAPHQL-874), Optimise Query rewriting in case of multiple alias with same filter.
 // addSelectionSetFrom adds all the selections from field into q, and returns a list
 // of extra queries needed to satisfy auth requirements
@@ -1260,12 +1277,22 @@ func addSelectionSetFrom(
 			child.Attr = f.DgraphPredicate()
 		}
 
+		// name(lang: "en:fr:.") -> name.lang : Author.name@en:fr:.
+		langAlias := langDgraphAlias(f, fieldSeenCount)
+		if langAlias != "" {
+			child.Alias = langAlias
+			child.Langs = strings.Split(f.ArgValue("lang").(string), ":")
+		}
+
 		filter, _ := f.ArgValue("filter").(map[string]interface{})
 		// if this field has been filtered out by the filter, then don't add it in DQL query
-		if includeField := addFilter(child, f.Type(), filter); !includeField {
+		includeField, filterQrys := addFilter(child, f.Type(), filter, auth.varGen)
+		if !includeField {
 			continue
 		}
+		authQueries = append(authQueries, filterQrys...)
 		addOrder(child, f)
+		addFacets(child, f)
 		addPagination(child, f)
 		addCascadeDirective(child, f)
 		rbac := auth.evaluateStaticRules(f.Type())
@@ -1293,7 +1320,8 @@ func addSelectionSetFrom(
 			}
 		}
 
-		if f.Type().IsInbuiltOrEnumType() && (fieldSeenCount[f.DgraphAlias()] > 0) {
+		if f.Type().IsInbuiltOrEnumType() && langAlias == "" &&
+			(fieldSeenCount[f.DgraphAlias()] > 0) {
 			restoreAuthState()
 			continue
 		}
@@ -1405,6 +1433,86 @@ func addOrder(q *gql.GraphQuery, field schema.Field) {
 	}
 }
 
+// addFacets adds the facet filter and ordering of an edge with @facets, e.g.
+// friends(facetFilter: { since: { ge: "2020" } }, facetOrder: { asc: since })
+// ->
+// Person.friends @facets(ge(since, "2020")) @facets(orderasc: since)
+func addFacets(q *gql.GraphQuery, field schema.Field) {
+	if filter, ok := field.ArgValue("facetFilter").(map[string]interface{}); ok {
+		q.FacetsFilter = buildFacetFilter(filter)
+	}
+
+	order, ok := field.ArgValue("facetOrder").(map[string]interface{})
+	for ok {
+		if asc, ok := order["asc"].(string); ok {
+			q.FacetsOrder = append(q.FacetsOrder, &gql.FacetOrder{Key: asc})
+		} else if desc, ok := order["desc"].(string); ok {
+			q.FacetsOrder = append(q.FacetsOrder, &gql.FacetOrder{Key: desc, Desc: true})
+		}
+		order, ok = order["then"].(map[string]interface{})
+	}
+}
+
+// buildFacetFilter builds the filter tree for a facetFilter argument. It's like buildFilter,
+// but the keys are facet names rather than fields, so there are no predicates to look up.
+func buildFacetFilter(filter map[string]interface{}) *gql.FilterTree {
+	var ands []*gql.FilterTree
+	var keys []string
+	for key := range filter {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		val := filter[key]
+		if obj, ok := val.(map[string]interface{}); ok && (key == "and" || key == "or") {
+			// and: { ... } is the same as and: [{ ... }]
+			val = []interface{}{obj}
+		}
+		switch v := val.(type) {
+		case []interface{}:
+			// and: [{ ... }, { ... }] or or: [{ ... }, { ... }]
+			children := make([]*gql.FilterTree, 0, len(v))
+			for _, obj := range v {
+				if ft := buildFacetFilter(obj.(map[string]interface{})); ft != nil {
+					children = append(children, ft)
+				}
+			}
+			if len(children) > 0 {
+				ands = append(ands, &gql.FilterTree{Op: key, Child: children})
+			}
+		case map[string]interface{}:
+			if key == "not" {
+				if not := buildFacetFilter(v); not != nil {
+					ands = append(ands,
+						&gql.FilterTree{Op: "not", Child: []*gql.FilterTree{not}})
+				}
+				continue
+			}
+			// since: { ge: "2020" } -> ge(since, "2020")
+			fn, val := first(v)
+			if val == nil {
+				continue
+			}
+			ands = append(ands, &gql.FilterTree{
+				Func: &gql.Function{
+					Name: fn,
+					Args: []gql.Arg{{Value: key}, {Value: maybeQuoteArg(fn, val)}},
+				},
+			})
+		}
+	}
+
+	switch len(ands) {
+	case 0:
+		return nil
+	case 1:
+		return ands[0]
+	default:
+		return &gql.FilterTree{Op: "and", Child: ands}
+	}
+}
+
 func addPagination(q *gql.GraphQuery, field schema.Field) {
 	q.Args = make(map[string]string)
 
@@ -1417,6 +1525,54 @@ func addPagination(q *gql.GraphQuery, field schema.Field) {
 	if offset != nil {
 		q.Args["offset"] = fmt.Sprintf("%v", offset)
 	}
+
+	// after: "eyJhIjo2M30" -> after: 0x3f
+	// after: "eyJvIjoiYXNjOnRpdGxlIiwibiI6MjB9" -> offset: 20
+	// Lists in uid order page from the uid in the cursor, and ordered lists skip as many
+	// nodes as the cursor has seen, plus any offset. validateCursors has checked the cursor.
+	if after, ok := field.ArgValue("after").(string); ok {
+		cursor, err := schema.ParseCursor(after)
+		switch {
+		case err != nil:
+		case cursor.Order == "":
+			q.Args["after"] = fmt.Sprintf("%#x", cursor.After)
+		default:
+			skip := cursor.Offset + intArgValue(field, "offset")
+			if skip > 0 {
+				q.Args["offset"] = strconv.FormatInt(skip, 10)
+			}
+		}
+	}
+}
+
+// intArgValue returns the value of the Int argument name of field, or 0 if it wasn't given.
+func intArgValue(field schema.Field, name string) int64 {
+	v, _ := strconv.ParseInt(fmt.Sprintf("%v", field.ArgValue(name)), 10, 64)
+	return v
+}
+
+// validateCursors returns an error if field, or a field in its selection set, has an `after`
+// cursor that Dgraph didn't return, or that was returned for the list in a different order.
+// Validation catches this for cursors written in the query, but not for those in variables.
+func validateCursors(field schema.Field) error {
+	if after, ok := field.ArgValue("after").(string); ok {
+		cursor, err := schema.ParseCursor(after)
+		if err != nil {
+			return schema.GQLWrapLocationf(err, field.Location(),
+				"field %s has an invalid after cursor", field.Name())
+		}
+		if cursor.Order != schema.OrderKey(field.ArgValue("order")) {
+			return x.GqlErrorf("field %s has an after cursor for a different order, cursors "+
+				"only page through the list in the order they were returned for",
+				field.Name()).WithLocations(field.Location())
+		}
+	}
+	for _, f := range field.SelectionSet() {
+		if err := validateCursors(f); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func addCascadeDirective(q *gql.GraphQuery, field schema.Field) {
@@ -1457,9 +1613,15 @@ func idFilter(filter map[string]interface{}, idField schema.FieldDefinition) []u
 // addFilter adds a filter to the input DQL query. It returns false if the field for which the
 // filter was specified should not be included in the DQL query.
 // Currently, it would only be false for a union field when no memberTypes are queried.
-func addFilter(q *gql.GraphQuery, typ schema.Type, filter map[string]interface{}) bool {
+// It also returns the var blocks needed by filters on child objects, which the caller must add
+// to the final DQL query.
+func addFilter(
+	q *gql.GraphQuery,
+	typ schema.Type,
+	filter map[string]interface{},
+	varGen *VariableGenerator) (bool, []*gql.GraphQuery) {
 	if len(filter) == 0 {
-		return true
+		return true, nil
 	}
 
 	// There are two cases here.
@@ -1480,19 +1642,32 @@ func addFilter(q *gql.GraphQuery, typ schema.Type, filter map[string]interface{}
 		delete(filter, idName)
 	}
 
+	// The var blocks of filters on child objects start from the nodes of the root function, if
+	// there's one. Otherwise, they start from all the nodes of the type.
+	var scope filterScope
+	if q.Func != nil {
+		scope = func() *gql.Function {
+			fn := *q.Func
+			return &fn
+		}
+	}
+
+	var filterQrys []*gql.GraphQuery
 	if typ.IsUnion() {
-		if filter, includeField := buildUnionFilter(typ, filter); includeField {
+		if filter, qrys, includeField := buildUnionFilter(typ, filter, scope,
+			varGen); includeField {
 			q.Filter = filter
+			filterQrys = qrys
 		} else {
-			return false
+			return false, nil
 		}
 	} else {
-		q.Filter = buildFilter(typ, filter)
+		q.Filter, filterQrys = buildFilter(typ, filter, scope, varGen)
 	}
 	if filterAtRoot {
 		addTypeFilter(q, typ)
 	}
-	return true
+	return true, filterQrys
 }
 
 // buildFilter builds a Dgraph gql.FilterTree from a GraphQL 'filter' arg.
@@ -1516,15 +1691,29 @@ func addFilter(q *gql.GraphQuery, typ schema.Type, filter map[string]interface{}
 //
 // Filters with `or:` and `not:` get translated to Dgraph OR and NOT.
 //
+// A filter on a child object, like posts in
+// filter: { posts: { title: { anyofterms: "GraphQL" } } }
+// can't be written inside @filter. It becomes a uid(Author1) filter, and the var block
+// Author1 as var(func: type(Author)) @cascade {
+//   Author.posts @filter(anyofterms(Post.title, "GraphQL"))
+// }
+// is returned for the caller to add to the DQL query. The var block starts from the nodes given
+// by scope, or from all the nodes of typ if scope is nil.
+//
 // TODO: There's cases that don't make much sense like
 // filter: { or: { title: { anyofterms: "GraphQL" } } }
 // ATM those will probably generate junk that might cause a Dgraph error.  And
 // bubble back to the user as a GraphQL error when the query fails. Really,
 // they should fail query validation and never get here.
-func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree {
+func buildFilter(
+	typ schema.Type,
+	filter map[string]interface{},
+	scope filterScope,
+	varGen *VariableGenerator) (*gql.FilterTree, []*gql.GraphQuery) {
 
 	var ands []*gql.FilterTree
 	var or *gql.FilterTree
+	var varQrys []*gql.GraphQuery
 	// Get a stable ordering so we generate the same thing each time.
 	var keys []string
 	for key := range filter {
@@ -1556,12 +1745,14 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 			// ... and: [{}]
 			switch v := filter[field].(type) {
 			case map[string]interface{}:
-				ft := buildFilter(typ, v)
+				ft, qrys := buildFilter(typ, v, scope, varGen)
 				ands = append(ands, ft)
+				varQrys = append(varQrys, qrys...)
 			case []interface{}:
 				for _, obj := range v {
-					ft := buildFilter(typ, obj.(map[string]interface{}))
+					ft, qrys := buildFilter(typ, obj.(map[string]interface{}), scope, varGen)
 					ands = append(ands, ft)
+					varQrys = append(varQrys, qrys...)
 				}
 			}
 		case "or":
@@ -1576,12 +1767,15 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 			// ... or: [{}]
 			switch v := filter[field].(type) {
 			case map[string]interface{}:
-				or = buildFilter(typ, v)
+				var qrys []*gql.GraphQuery
+				or, qrys = buildFilter(typ, v, scope, varGen)
+				varQrys = append(varQrys, qrys...)
 			case []interface{}:
 				ors := make([]*gql.FilterTree, 0, len(v))
 				for _, obj := range v {
-					ft := buildFilter(typ, obj.(map[string]interface{}))
+					ft, qrys := buildFilter(typ, obj.(map[string]interface{}), scope, varGen)
 					ors = append(ors, ft)
+					varQrys = append(varQrys, qrys...)
 				}
 				or = &gql.FilterTree{
 					Child: ors,
@@ -1593,13 +1787,37 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 			//                       we are here ^^
 			// ->
 			// @filter(anyofterms(Post.title, "GraphQL") AND NOT eq(Post.isPublished, true))
-			not := buildFilter(typ, filter[field].(map[string]interface{}))
+			not, qrys := buildFilter(typ, filter[field].(map[string]interface{}), scope,
+				varGen)
+			varQrys = append(varQrys, qrys...)
 			ands = append(ands,
 				&gql.FilterTree{
 					Op:    "not",
 					Child: []*gql.FilterTree{not},
 				})
+		case "has":
+			// has: [comments, text] -> has(Post.comments) AND has(Post.text)
+			// A single value like `has: comments` is coerced into a list during validation.
+			for _, fld := range filter[field].([]interface{}) {
+				ands = append(ands, &gql.FilterTree{
+					Func: &gql.Function{
+						Name: field,
+						Args: []gql.Arg{
+							{Value: typ.DgraphPredicate(fmt.Sprintf("%v", fld))},
+						},
+					},
+				})
+			}
 		default:
+			if fd := typ.Field(field); fd != nil && !fd.Type().IsInbuiltOrEnumType() {
+				// posts: { title: { anyofterms: "GraphQL" } } -> uid(Author1)
+				ft, qrys := buildNestedFilter(typ, fd, filter[field].(map[string]interface{}),
+					scope, varGen)
+				ands = append(ands, ft)
+				varQrys = append(varQrys, qrys...)
+				continue
+			}
+
 			//// It's a base case like:
 			//// title: { anyofterms: "GraphQL" } ->  anyofterms(Post.title: "GraphQL")
 			//// numLikes: { between : { min : 10,  max:100 }}
@@ -1662,12 +1880,11 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 						buildPoint(point, &buf)
 					}
 					args = append(args, gql.Arg{Value: buf.String()})
-					// TODO: for both contains and intersects, we should use @oneOf in the inbuilt
-					// schema. Once we have variable validation hook available in gqlparser, we can
-					// do this. So, if either both the children are given or none of them is given,
-					// we should get an error at parser level itself. Right now, if both "polygon"
-					// and "point" are given, we only use polygon. If none of them are given,
-					// an incorrect DQL query will be formed and will error out from Dgraph.
+					// Literal contains and intersects filters are checked to give exactly one
+					// shape during validation (geoFilterShapeCheck). Those given through variables
+					// aren't, so if both "polygon" and "point" are given, we only use polygon. If
+					// none of them are given, an incorrect DQL query will be formed and will error
+					// out from Dgraph.
 				case "intersects":
 					// For Geo type we have `intersects` filter which is either multi-polygon or polygon and is written as follows:
 					// For polygon: { intersect: { polygon: { coordinates: [ { points: [{ latitude: 11.11, longitude: 22.22}, { latitude: 15.15, longitude: 16.16} , { latitude: 20.20, longitude: 21.21} ]}] } } }
@@ -1699,42 +1916,26 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 					},
 				})
 			case interface{}:
-				// has: comments -> has(Post.comments)
-				// OR
 				// isPublished: true -> eq(Post.isPublished, true)
 				// OR an enum case
 				// postType: Question -> eq(Post.postType, "Question")
-				switch field {
-				case "has":
-					fieldName := fmt.Sprintf("%v", dgFunc)
-					ands = append(ands, &gql.FilterTree{
-						Func: &gql.Function{
-							Name: field,
-							Args: []gql.Arg{
-								{Value: typ.DgraphPredicate(fieldName)},
-							},
-						},
-					})
-
-				default:
-					fn := "eq"
-					ands = append(ands, &gql.FilterTree{
-						Func: &gql.Function{
-							Name: fn,
-							Args: []gql.Arg{
-								{Value: typ.DgraphPredicate(field)},
-								{Value: fmt.Sprintf("%v", dgFunc)},
-							},
+				fn := "eq"
+				ands = append(ands, &gql.FilterTree{
+					Func: &gql.Function{
+						Name: fn,
+						Args: []gql.Arg{
+							{Value: typ.DgraphPredicate(field)},
+							{Value: fmt.Sprintf("%v", dgFunc)},
 						},
-					})
-				}
+					},
+				})
 			}
 		}
 	}
 
 	var andFt *gql.FilterTree
 	if len(ands) == 0 {
-		return or
+		return or, varQrys
 	} else if len(ands) == 1 {
 		andFt = ands[0]
 	} else if len(ands) > 1 {
@@ -1745,13 +1946,86 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 	}
 
 	if or == nil {
-		return andFt
+		return andFt, varQrys
 	}
 
 	return &gql.FilterTree{
 		Op:    "or",
 		Child: []*gql.FilterTree{andFt, or},
+	}, varQrys
+}
+
+// filterScope returns the root function of the var blocks built for filters on child objects.
+type filterScope func() *gql.Function
+
+// buildNestedFilter builds the filter for a field fd of typ that holds a child object. The nodes
+// of typ which have at least one child matching the filter are found by a var block, eg:
+// Author1 as var(func: type(Author)) @cascade {
+//   Author.posts @filter(anyofterms(Post.title, "GraphQL"))
+// }
+// and the returned filter is uid(Author1). The var block starts from the nodes given by scope,
+// or from all the nodes of typ if scope is nil. If the child's own filter is on its children too,
+// its var blocks start from the children of those nodes, found by another var block, eg:
+// var(func: type(Author)) {
+//   Post2 as Author.posts
+// }
+// All the var blocks are returned with the filter.
+func buildNestedFilter(
+	typ schema.Type,
+	fd schema.FieldDefinition,
+	filter map[string]interface{},
+	scope filterScope,
+	varGen *VariableGenerator) (*gql.FilterTree, []*gql.GraphQuery) {
+
+	root := func() *gql.Function {
+		if scope == nil {
+			return buildTypeFunc(typ.DgraphName())
+		}
+		return scope()
 	}
+
+	var scopeQry *gql.GraphQuery
+	childScope := func() *gql.Function {
+		if scopeQry == nil {
+			scopeQry = &gql.GraphQuery{
+				Attr: "var",
+				Func: root(),
+				Children: []*gql.GraphQuery{{
+					Var:  varGen.Next(fd.Type(), "", "", false),
+					Attr: fd.DgraphPredicate(),
+				}},
+			}
+		}
+		return &gql.Function{
+			Name: "uid",
+			Args: []gql.Arg{{Value: scopeQry.Children[0].Var}},
+		}
+	}
+
+	child := &gql.GraphQuery{Attr: fd.DgraphPredicate()}
+	var varQrys []*gql.GraphQuery
+	if len(filter) > 0 {
+		child.Filter, varQrys = buildFilter(fd.Type(), filter, childScope, varGen)
+	}
+	if scopeQry != nil {
+		varQrys = append([]*gql.GraphQuery{scopeQry}, varQrys...)
+	}
+
+	varName := varGen.Next(typ, "", "", false)
+	varQry := &gql.GraphQuery{
+		Var:      varName,
+		Attr:     "var",
+		Func:     root(),
+		Cascade:  []string{"__all__"},
+		Children: []*gql.GraphQuery{child},
+	}
+
+	return &gql.FilterTree{
+		Func: &gql.Function{
+			Name: "uid",
+			Args: []gql.Arg{{Value: varName}},
+		},
+	}, append(varQrys, varQry)
 }
 
 func buildPoint(point map[string]interface{}, buf *bytes.Buffer) {
@@ -1797,13 +2071,18 @@ func buildMultiPolygon(multipolygon map[string]interface{}, buf *bytes.Buffer) {
 	x.Check2(buf.WriteString("]"))
 }
 
-func buildUnionFilter(typ schema.Type, filter map[string]interface{}) (*gql.FilterTree, bool) {
+func buildUnionFilter(
+	typ schema.Type,
+	filter map[string]interface{},
+	scope filterScope,
+	varGen *VariableGenerator) (*gql.FilterTree, []*gql.GraphQuery, bool) {
 	memberTypesList, ok := filter["memberTypes"].([]interface{})
 	// if memberTypes was specified to be an empty list like: { memberTypes: [], ...},
 	// then we don't need to include the field, on which the filter was specified, in the query.
 	if ok && len(memberTypesList) == 0 {
-		return nil, false
+		return nil, nil, false
 	}
+	var varQrys []*gql.GraphQuery
 
 	ft := &gql.FilterTree{
 		Op: "or",
@@ -1820,19 +2099,21 @@ func buildUnionFilter(typ schema.Type, filter map[string]interface{}) (*gql.Filt
 			memberTypeFt = &gql.FilterTree{Func: buildTypeFunc(memberType.DgraphName())}
 		} else {
 			// else we need to query only the nodes which match the filter for that member type
+			memberFt, qrys := buildFilter(memberType, memberTypeFilter, scope, varGen)
 			memberTypeFt = &gql.FilterTree{
 				Op: "and",
 				Child: []*gql.FilterTree{
 					{Func: buildTypeFunc(memberType.DgraphName())},
-					buildFilter(memberType, memberTypeFilter),
+					memberFt,
 				},
 			}
+			varQrys = append(varQrys, qrys...)
 		}
 		ft.Child = append(ft.Child, memberTypeFt)
 	}
 
 	// return true because we want to include the field with filter in query
-	return ft, true
+	return ft, varQrys, true
 }
 
 func maybeQuoteArg(fn string, arg interface{}) string {
@@ -40,6 +40,8 @@ import (
 	"github.com/dgraph-io/dgraph/graphql/api"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
+	ostats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opencensus.io/trace"
 	otrace "go.opencensus.io/trace"
 
@@ -130,6 +132,7 @@ type ResultCompleter interface {
 type RequestResolver struct {
 	schema    schema.Schema
 	resolvers ResolverFactory
+	cache     *resultCache
 }
 
 // A resolverFactory is the main implementation of ResolverFactory.  It stores a
@@ -212,6 +215,7 @@ func NewAdminExecutor() DgraphExecutor {
 func (aex *adminExecutor) Execute(ctx context.Context, req *dgoapi.Request) (
 	*dgoapi.Response, error) {
 	ctx = context.WithValue(ctx, edgraph.Authorize, false)
+	defer recordExecution(ctx, req, time.Now())
 	return aex.dg.Execute(ctx, req)
 }
 
@@ -221,6 +225,7 @@ func (aex *adminExecutor) CommitOrAbort(ctx context.Context, tc *dgoapi.TxnConte
 
 func (de *dgraphExecutor) Execute(ctx context.Context, req *dgoapi.Request) (
 	*dgoapi.Response, error) {
+	defer recordExecution(ctx, req, time.Now())
 	return de.dg.Execute(ctx, req)
 }
 
@@ -395,6 +400,7 @@ func New(s schema.Schema, resolverFactory ResolverFactory) *RequestResolver {
 	return &RequestResolver{
 		schema:    s,
 		resolvers: resolverFactory,
+		cache:     newResultCache(),
 	}
 }
 
@@ -432,17 +438,27 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 		resp.Extensions.Tracing.Duration = endTime.Sub(startTime).Nanoseconds()
 	}()
 	ctx = context.WithValue(ctx, resolveStartTime, startTime)
+	var sq *slowQuery
+	if x.Config.GraphqlSlowQueryThreshold > 0 {
+		sq = &slowQuery{}
+		ctx = context.WithValue(ctx, slowQueryKey, sq)
+		defer func() { sq.logIfSlow(ctx, gqlReq, time.Since(startTime)) }()
+	}
 
 	op, err := r.schema.Operation(gqlReq)
+	if sq != nil {
+		sq.parse = time.Since(startTime)
+	}
 	if err != nil {
-		return schema.ErrorResponse(err)
+		return schema.ErrorResponse(schema.WithErrorCode(err, schema.ErrBadInput))
 	}
+	ctx, _ = tag.New(ctx, tag.Upsert(x.KeyGraphQLOperation, op.Type()))
 
 	if glog.V(3) {
 		// don't log the introspection queries they are sent too frequently
 		// by GraphQL dev tools
-		if !op.IsQuery() ||
-			(op.IsQuery() && !strings.HasPrefix(op.Queries()[0].Name(), "__")) {
+		if !op.IsQuery() || (op.IsQuery() && len(op.Queries()) > 0 &&
+			!strings.HasPrefix(op.Queries()[0].Name(), "__")) {
 			b, err := json.Marshal(gqlReq.Variables)
 			if err != nil {
 				glog.Infof("Failed to marshal variables for logging : %s", err)
@@ -459,6 +475,7 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 
 		var wg sync.WaitGroup
 		allResolved := make([]*Resolved, len(op.Queries()))
+		took := make([]time.Duration, len(op.Queries()))
 
 		for i, q := range op.Queries() {
 			wg.Add(1)
@@ -473,17 +490,19 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 							Err:   err,
 						}
 					})
+				start := time.Now()
 				allResolved[storeAt] = r.resolvers.queryResolverFor(q).Resolve(ctx, q)
+				took[storeAt] = time.Since(start)
 			}(q, i)
 		}
 		wg.Wait()
 
 		// The GraphQL data response needs to be written in the same order as the
 		// queries in the request.
-		for _, res := range allResolved {
+		for i, res := range allResolved {
 			// Errors and data in the same response is valid.  Both WithError and
 			// AddData handle nil cases.
-			addResult(resp, res)
+			recordFieldMetrics(ctx, res, took[i], addResult(resp, res))
 
 		}
 	}
@@ -499,7 +518,21 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 			resp.Header.Set(schema.CacheControlHeader, op.CacheControl())
 			resp.Header.Set("Vary", "Accept-Encoding")
 		}
+
+		key := cacheKey(ctx, gqlReq, op)
+		var generation uint64
+		if key != "" {
+			data, gen, ok := r.cache.get(key)
+			if ok {
+				resp.Data.Write(data)
+				return resp
+			}
+			generation = gen
+		}
 		resolveQueries()
+		if key != "" && len(resp.Errors) == 0 {
+			r.cache.set(key, generation, op, resp.Data.Bytes())
+		}
 	case op.IsMutation():
 		// A mutation operation can contain any number of mutation fields.  Those should be executed
 		// serially.
@@ -513,6 +546,16 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 		//
 		// A reasonable interpretation of that is to stop a list of mutations after the first error -
 		// which seems like the natural semantics and is what we enforce here.
+		//
+		// With the atomic request extension, all the mutations run in one transaction that's
+		// only committed if they all succeed, so a later error also rolls back the mutations
+		// before it.
+		if gqlReq.Extensions.Atomic {
+			resolveAtomicMutations(ctx, r.resolvers, op, resp)
+			r.cache.invalidateFor(op)
+			break
+		}
+
 		allSuccessful := true
 
 		for _, m := range op.Mutations() {
@@ -526,9 +569,11 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 			}
 
 			var res *Resolved
+			start := time.Now()
 			res, allSuccessful = r.resolvers.mutationResolverFor(m).Resolve(ctx, m)
-			addResult(resp, res)
+			recordFieldMetrics(ctx, res, time.Since(start), addResult(resp, res))
 		}
+		r.cache.invalidateFor(op)
 	case op.IsSubscription():
 		resolveQueries()
 	}
@@ -536,6 +581,69 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 	return resp
 }
 
+// resolveAtomicMutations resolves the mutations of op in one Dgraph transaction, and adds
+// their results to resp.  The transaction is committed only if all the mutations succeed;
+// otherwise, it's aborted and the mutations that had succeeded are reported as rolled back.
+// Only add, update and delete mutations can run in a transaction.
+func resolveAtomicMutations(ctx context.Context, resolvers ResolverFactory,
+	op schema.Operation, resp *schema.Response) {
+	for _, m := range op.Mutations() {
+		switch m.MutationType() {
+		case schema.AddMutation, schema.UpdateMutation, schema.DeleteMutation:
+		default:
+			resp.WithError(schema.WithErrorCode(x.GqlErrorf(
+				"Mutation %s can't be part of an atomic request, only add, update and "+
+					"delete mutations can.", m.ResponseName()).
+				WithLocations(m.Location()), schema.ErrBadInput))
+			return
+		}
+	}
+
+	ctx, atomic := withAtomicTxn(ctx)
+	var results []*Resolved
+	var took []time.Duration
+	failed := false
+	for _, m := range op.Mutations() {
+		start := time.Now()
+		res, success := resolvers.mutationResolverFor(m).Resolve(ctx, m)
+		results = append(results, res)
+		took = append(took, time.Since(start))
+		if !success {
+			failed = true
+			break
+		}
+	}
+
+	// The mutations that succeeded are rolled back if a later one failed, or if the
+	// transaction couldn't be committed.
+	rollback := failed
+	if err := atomic.commitOrAbort(ctx, failed); err != nil && !failed {
+		resp.WithError(schema.GQLWrapf(withTxnConflictCode(err),
+			"mutations failed, couldn't commit transaction"))
+		rollback = true
+	}
+
+	for i, m := range op.Mutations() {
+		if i >= len(results) {
+			resp.WithError(x.GqlErrorf(
+				"Mutation %s was not executed because of a previous error.",
+				m.ResponseName()).
+				WithLocations(m.Location()))
+			continue
+		}
+
+		succeeded := !failed || i < len(results)-1
+		if rollback && succeeded {
+			results[i].Data = map[string]interface{}{m.DgraphAlias(): nil}
+			results[i].Err = x.GqlErrorf(
+				"Mutation %s was rolled back because the transaction was aborted.",
+				m.ResponseName()).
+				WithLocations(m.Location())
+		}
+		recordFieldMetrics(ctx, results[i], took[i], addResult(resp, results[i]))
+	}
+}
+
 // ValidateSubscription will check the given subscription query is valid or not.
 func (r *RequestResolver) ValidateSubscription(req *schema.Request) error {
 	if r.schema == nil {
@@ -574,7 +682,8 @@ func validateCustomFieldsRecursively(field schema.Field) error {
 	return nil
 }
 
-func addResult(resp *schema.Response, res *Resolved) {
+// addResult adds res to resp, and returns whether res had any errors.
+func addResult(resp *schema.Response, res *Resolved) bool {
 	// Errors should report the "path" into the result where the error was found.
 	//
 	// The definition of a path in a GraphQL error is here:
@@ -596,6 +705,43 @@ func addResult(resp *schema.Response, res *Resolved) {
 	resp.WithError(gqlErr)
 	resp.AddData(b)
 	resp.MergeExtensions(res.Extensions)
+	return len(schema.AsGQLErrors(res.Err)) > 0 || len(gqlErr) > 0
+}
+
+// recordFieldMetrics records the metrics of resolving res, which took the given time, and
+// failed if it had errors. The type of the operation is already tagged in ctx.
+func recordFieldMetrics(ctx context.Context, res *Resolved, took time.Duration, failed bool) {
+	ctx, err := tag.New(ctx, tag.Upsert(x.KeyGraphQLField, res.Field.Name()))
+	if err != nil {
+		return
+	}
+
+	measurements := []ostats.Measurement{
+		x.NumGraphQLFieldRequests.M(1),
+		x.GraphQLFieldLatencyMs.M(float64(took) / float64(time.Millisecond)),
+	}
+	if failed {
+		measurements = append(measurements, x.NumGraphQLFieldErrors.M(1))
+	}
+	// Fields resolved without Dgraph, like @custom fields, have no DQL latency.
+	if res.Extensions != nil && res.Extensions.Tracing != nil &&
+		res.Extensions.Tracing.Execution != nil {
+		var dql int64
+		ran := false
+		for _, rt := range res.Extensions.Tracing.Execution.Resolvers {
+			for _, d := range rt.Dgraph {
+				if d.Duration > 0 {
+					dql += d.Duration
+					ran = true
+				}
+			}
+		}
+		if ran {
+			measurements = append(measurements,
+				x.GraphQLFieldDQLLatencyMs.M(float64(dql)/float64(time.Millisecond)))
+		}
+	}
+	ostats.Record(ctx, measurements...)
 }
 
 // noopCompletion just passes back it's result and err arguments
@@ -857,6 +1003,25 @@ type graphqlResp struct {
 	Errors x.GqlErrorList         `json:"errors,omitempty"`
 }
 
+// A fieldError stands in for the value of a @custom field in an object for which resolving the
+// field failed. Completion resolves the field as null, and reports the errors at the path of the
+// field, so that the failure doesn't take the rest of the result with it.
+type fieldError struct {
+	errs x.GqlErrorList
+}
+
+// setFieldError sets the value of f in each of the objects in vals to a fieldError for err.
+func setFieldError(f schema.Field, vals []interface{}, mu *sync.RWMutex, err error) {
+	fe := &fieldError{errs: schema.AsGQLErrors(err)}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, v := range vals {
+		if val, ok := v.(map[string]interface{}); ok {
+			val[f.Name()] = fe
+		}
+	}
+}
+
 func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{}, mu *sync.RWMutex,
 	errCh chan error) {
 	defer api.PanicHandler(func(err error) {
@@ -910,84 +1075,39 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 	}
 
 	if fconf.Mode == schema.BATCH {
-		var requestInput interface{}
-		requestInput = inputs
-
-		if graphql {
-			body := make(map[string]interface{})
-			body["query"] = fconf.RemoteGqlQuery
-			body["variables"] = map[string]interface{}{fconf.GraphqlBatchModeArgument: requestInput}
-			requestInput = body
-		} else if f.HasLambdaDirective() {
-			requestInput = getBodyForLambda(ctx, f, requestInput, nil)
-		}
-
-		b, err := json.Marshal(requestInput)
-		if err != nil {
-			errCh <- x.GqlErrorList{jsonMarshalError(err, f, inputs)}
-			return
-		}
-
-		b, status, err := makeRequest(nil, fconf.Method, fconf.URL, string(b), fconf.ForwardHeaders)
-		if err != nil {
-			errCh <- x.GqlErrorList{externalRequestError(err, f)}
-			return
+		// Send the objects in batches of at most fconf.BatchSize, at most maxBatchRequests at a
+		// time, each batch filling in its own part of vals.
+		size := len(inputs)
+		if fconf.BatchSize > 0 && fconf.BatchSize < size {
+			size = fconf.BatchSize
+		}
+		var batches int
+		errChan := make(chan error, len(inputs))
+		inFlight := make(chan struct{}, maxBatchRequests)
+		for start := 0; start < len(inputs); start += size {
+			end := start + size
+			if end > len(inputs) {
+				end = len(inputs)
+			}
+			batches++
+			inFlight <- struct{}{}
+			go func(start, end int) {
+				defer func() { <-inFlight }()
+				defer api.PanicHandler(
+					func(err error) {
+						errChan <- internalServerError(err, f)
+					})
+				errChan <- resolveCustomFieldBatch(ctx, f, fconf, graphql, inputs[start:end],
+					vals[start:end], mu)
+			}(start, end)
 		}
 
-		// To collect errors from remote GraphQL endpoint and those encountered during execution.
 		var errs error
-		var result []interface{}
-		var rerr restErr
-		if graphql {
-			resp := &graphqlResp{}
-			err = json.Unmarshal(b, resp)
-			if err != nil {
-				errCh <- x.GqlErrorList{jsonUnmarshalError(err, f)}
-				return
-			}
-
-			if len(resp.Errors) > 0 {
-				errs = schema.AppendGQLErrs(errs, resp.Errors)
-			}
-			var ok bool
-			result, ok = resp.Data[fconf.RemoteGqlQueryName].([]interface{})
-			if !ok {
-				errCh <- schema.AppendGQLErrs(errs, keyNotFoundError(f, fconf.RemoteGqlQueryName))
-				return
-			}
-		} else {
-			if status >= 200 && status < 300 {
-				if err = json.Unmarshal(b, &result); err != nil {
-					errCh <- x.GqlErrorList{jsonUnmarshalError(err, f)}
-					return
-				}
-			} else {
-				if err = json.Unmarshal(b, &rerr); err != nil {
-					err = errors.Errorf("unexpected error with: %v", status)
-					errCh <- x.GqlErrorList{externalRequestError(err, f)}
-					return
-				} else {
-					errCh <- rerr.Errors
-					return
-				}
+		for i := 0; i < batches; i++ {
+			if e := <-errChan; e != nil {
+				errs = schema.AppendGQLErrs(errs, e)
 			}
 		}
-		if len(result) != len(vals) {
-			gqlErr := x.GqlErrorf("Evaluation of custom field failed because expected result of "+
-				"external request to be of size %v, got: %v for field: %s within type: %s.",
-				len(vals), len(result), f.Name(), f.GetObjectName()).WithLocations(f.Location())
-			errCh <- schema.AppendGQLErrs(errs, gqlErr)
-			return
-		}
-
-		// Here we walk through all the objects in the array and substitute the value
-		// that we got from the remote endpoint with the right key in the object.
-		mu.Lock()
-		for idx, val := range vals {
-			val.(map[string]interface{})[f.Name()] = result[idx]
-			vals[idx] = val
-		}
-		mu.Unlock()
 		errCh <- errs
 		return
 	}
@@ -1001,6 +1121,12 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 					errChan <- internalServerError(err, f)
 				})
 
+			// If the request fails, the field fails only for this object.
+			fail := func(err error) {
+				setFieldError(f, vals[idx:idx+1], mu, err)
+				errChan <- nil
+			}
+
 			requestInput := input
 			if graphql {
 				body := make(map[string]interface{})
@@ -1011,7 +1137,7 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 
 			b, err := json.Marshal(requestInput)
 			if err != nil {
-				errChan <- x.GqlErrorList{jsonMarshalError(err, f, requestInput)}
+				fail(x.GqlErrorList{jsonMarshalError(err, f, requestInput)})
 				return
 			}
 
@@ -1027,7 +1153,7 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 						"variables into URL for remote endpoint with an error: %s for field: %s "+
 						"within type: %s.", err, f.Name(),
 						f.GetObjectName()).WithLocations(f.Location())
-					errChan <- x.GqlErrorList{gqlErr}
+					fail(x.GqlErrorList{gqlErr})
 					return
 				}
 				mu.RUnlock()
@@ -1035,7 +1161,7 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 
 			b, status, err := makeRequest(nil, fconf.Method, url, string(b), fconf.ForwardHeaders)
 			if err != nil {
-				errChan <- x.GqlErrorList{externalRequestError(err, f)}
+				fail(x.GqlErrorList{externalRequestError(err, f)})
 				return
 			}
 
@@ -1045,7 +1171,7 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 			if graphql {
 				resp := &graphqlResp{}
 				if err = json.Unmarshal(b, resp); err != nil {
-					errChan <- x.GqlErrorList{jsonUnmarshalError(err, f)}
+					fail(x.GqlErrorList{jsonUnmarshalError(err, f)})
 					return
 				}
 
@@ -1055,23 +1181,23 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 				var ok bool
 				result, ok = resp.Data[fconf.RemoteGqlQueryName]
 				if !ok {
-					errChan <- schema.AppendGQLErrs(errs,
-						keyNotFoundError(f, fconf.RemoteGqlQueryName))
+					fail(schema.AppendGQLErrs(errs,
+						keyNotFoundError(f, fconf.RemoteGqlQueryName)))
 					return
 				}
 			} else {
 				if status >= 200 && status < 300 {
 					if err = json.Unmarshal(b, &result); err != nil {
-						errCh <- x.GqlErrorList{jsonUnmarshalError(err, f)}
+						fail(x.GqlErrorList{jsonUnmarshalError(err, f)})
 						return
 					}
 				} else {
 					if err = json.Unmarshal(b, &rerr); err != nil {
 						err = errors.Errorf("unexpected error with: %v", status)
-						errCh <- x.GqlErrorList{externalRequestError(err, f)}
+						fail(x.GqlErrorList{externalRequestError(err, f)})
 						return
 					} else {
-						errCh <- rerr.Errors
+						fail(rerr.Errors)
 						return
 					}
 				}
@@ -1098,6 +1224,94 @@ func resolveCustomField(ctx context.Context, f schema.Field, vals []interface{},
 	errCh <- errs
 }
 
+// maxBatchRequests is how many requests a BATCH mode custom field makes at once, so that a large
+// result split into small batches doesn't flood the remote endpoint.
+var maxBatchRequests = 8
+
+// resolveCustomFieldBatch resolves f for the objects in vals with a single request in BATCH
+// mode, sending inputs as its body.
+func resolveCustomFieldBatch(ctx context.Context, f schema.Field, fconf schema.FieldHTTPConfig,
+	graphql bool, inputs []interface{}, vals []interface{}, mu *sync.RWMutex) error {
+	// If the request fails, the field fails for all the objects in the batch.
+	fail := func(err error) error {
+		setFieldError(f, vals, mu, err)
+		return nil
+	}
+
+	var requestInput interface{}
+	requestInput = inputs
+
+	if graphql {
+		body := make(map[string]interface{})
+		body["query"] = fconf.RemoteGqlQuery
+		body["variables"] = map[string]interface{}{fconf.GraphqlBatchModeArgument: requestInput}
+		requestInput = body
+	} else if f.HasLambdaDirective() {
+		requestInput = getBodyForLambda(ctx, f, requestInput, nil)
+	}
+
+	b, err := json.Marshal(requestInput)
+	if err != nil {
+		return fail(x.GqlErrorList{jsonMarshalError(err, f, inputs)})
+	}
+
+	b, status, err := makeRequest(nil, fconf.Method, fconf.URL, string(b), fconf.ForwardHeaders)
+	if err != nil {
+		return fail(x.GqlErrorList{externalRequestError(err, f)})
+	}
+
+	// To collect errors from remote GraphQL endpoint and those encountered during execution.
+	var errs error
+	var result []interface{}
+	var rerr restErr
+	if graphql {
+		resp := &graphqlResp{}
+		err = json.Unmarshal(b, resp)
+		if err != nil {
+			return fail(x.GqlErrorList{jsonUnmarshalError(err, f)})
+		}
+
+		if len(resp.Errors) > 0 {
+			errs = schema.AppendGQLErrs(errs, resp.Errors)
+		}
+		var ok bool
+		result, ok = resp.Data[fconf.RemoteGqlQueryName].([]interface{})
+		if !ok {
+			return fail(schema.AppendGQLErrs(errs,
+				keyNotFoundError(f, fconf.RemoteGqlQueryName)))
+		}
+	} else {
+		if status >= 200 && status < 300 {
+			if err = json.Unmarshal(b, &result); err != nil {
+				return fail(x.GqlErrorList{jsonUnmarshalError(err, f)})
+			}
+		} else {
+			if err = json.Unmarshal(b, &rerr); err != nil {
+				err = errors.Errorf("unexpected error with: %v", status)
+				return fail(x.GqlErrorList{externalRequestError(err, f)})
+			} else {
+				return fail(rerr.Errors)
+			}
+		}
+	}
+	if len(result) != len(vals) {
+		gqlErr := x.GqlErrorf("Evaluation of custom field failed because expected result of "+
+			"external request to be of size %v, got: %v for field: %s within type: %s.",
+			len(vals), len(result), f.Name(), f.GetObjectName()).WithLocations(f.Location())
+		return fail(schema.AppendGQLErrs(errs, gqlErr))
+	}
+
+	// Here we walk through all the objects in the array and substitute the value
+	// that we got from the remote endpoint with the right key in the object.
+	mu.Lock()
+	for idx, val := range vals {
+		val.(map[string]interface{})[f.Name()] = result[idx]
+		vals[idx] = val
+	}
+	mu.Unlock()
+	return errs
+}
+
 // resolveNestedFields resolves fields which themselves don't have the @custom directive but their
 // children might
 //
@@ -1387,8 +1601,11 @@ func completeObject(
 
 		var uniqueDgraphAlias string
 		// In case of InbuiltType or Enums, only one alias was passed into the dgraph query.
-		// So just map its value to all of its occurences.
-		if f.Type().IsInbuiltOrEnumType() {
+		// So just map its value to all of its occurences.  Fields asked for in some languages
+		// are the exception, each of those was passed with its own alias.
+		if langAlias := langDgraphAlias(f, fieldSeenCount); langAlias != "" {
+			uniqueDgraphAlias = langAlias
+		} else if f.Type().IsInbuiltOrEnumType() {
 			uniqueDgraphAlias = f.DgraphAlias()
 		} else {
 			uniqueDgraphAlias = generateUniqueDgraphAlias(f, fieldSeenCount)
@@ -1427,7 +1644,7 @@ func completeObject(
 		// f.Type().ListType() to be non-nil.
 		if val != nil && f.Type().ListType() != nil {
 			switch val.(type) {
-			case []interface{}, []map[string]interface{}:
+			case []interface{}, []map[string]interface{}, *fieldError:
 			default:
 				// We were expecting a list but got a value which wasn't a list. Lets return an
 				// error.
@@ -1492,6 +1709,18 @@ func completeValue(
 	val interface{}) ([]byte, x.GqlErrorList) {
 
 	switch val := val.(type) {
+	case *fieldError:
+		errs := make(x.GqlErrorList, 0, len(val.errs))
+		for _, err := range val.errs {
+			// The same fieldError can be in many objects, so each gets its own copy of the errors.
+			gqlErr := *err
+			gqlErr.Path = copyPath(path)
+			errs = append(errs, &gqlErr)
+		}
+		if field.Type().Nullable() {
+			return []byte("null"), errs
+		}
+		return nil, errs
 	case map[string]interface{}:
 		switch field.Type().Name() {
 		case "String", "ID", "Boolean", "Float", "Int", "Int64", "DateTime":
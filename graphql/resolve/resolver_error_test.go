@@ -19,6 +19,7 @@ package resolve
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
 )
 
@@ -399,11 +402,141 @@ func TestManyMutationsWithError(t *testing.T) {
 	}
 }
 
+// txnExecutor runs its requests in a transaction that starts at the first request, like
+// Dgraph does, and records the start timestamps of the requests and what gets committed.
+type txnExecutor struct {
+	executor
+	startTs   []uint64
+	commits   []*dgoapi.TxnContext
+	commitErr error
+}
+
+func (ex *txnExecutor) Execute(ctx context.Context, req *dgoapi.Request) (*dgoapi.Response, error) {
+	ex.startTs = append(ex.startTs, req.StartTs)
+	if req.StartTs == 0 {
+		req.StartTs = 10
+	}
+
+	resp, err := ex.executor.Execute(ctx, req)
+	if resp != nil {
+		resp.Txn = &dgoapi.TxnContext{StartTs: req.StartTs}
+		if len(req.Mutations) > 0 {
+			resp.Txn.Keys = []string{fmt.Sprintf("key%d", len(ex.startTs))}
+		}
+	}
+	return resp, err
+}
+
+func (ex *txnExecutor) CommitOrAbort(ctx context.Context, tc *dgoapi.TxnContext) error {
+	ex.commits = append(ex.commits, tc)
+	return ex.commitErr
+}
+
+// Tests that the mutations of an atomic request all run in one transaction, and that the
+// mutations before a failed one are rolled back rather than left committed.
+func TestAtomicMutations(t *testing.T) {
+	multiMutation := `mutation {
+			add1: addPost(input: [{title: "A Post", text: "Some text", author: {id: "0x1"}}]) {
+				post { title }
+			}
+
+			add2: addPost(input: [{title: "A Post", text: "Some text", author: {id: "0x1"}}]) {
+				post { title }
+			}
+		}`
+	add1Loc := []x.Location{{Line: 2, Column: 4}}
+	add2Loc := []x.Location{{Line: 6, Column: 4}}
+
+	tests := map[string]struct {
+		failMutation int
+		commitErr    error
+		expected     string
+		errors       x.GqlErrorList
+		startTs      []uint64
+		commit       *dgoapi.TxnContext
+	}{
+		"all mutations succeed": {
+			expected: `{
+				"add1": { "post": [{ "title": "A Post" }] },
+				"add2": { "post": [{ "title": "A Post" }] }
+			}`,
+			startTs: []uint64{0, 10, 10, 10},
+			commit:  &dgoapi.TxnContext{StartTs: 10, Keys: []string{"key1", "key3"}},
+		},
+		"a mutation fails": {
+			failMutation: 2,
+			expected:     `{ "add1": null, "add2": null }`,
+			errors: x.GqlErrorList{
+				&x.GqlError{Message: `Mutation add1 was rolled back because the ` +
+					`transaction was aborted.`,
+					Locations: add1Loc},
+				&x.GqlError{Message: `mutation addPost failed because ` +
+					`Dgraph mutation failed because _bad stuff happend_`,
+					Locations: add2Loc}},
+			startTs: []uint64{0, 10, 10},
+			commit: &dgoapi.TxnContext{StartTs: 10, Keys: []string{"key1"},
+				Aborted: true},
+		},
+		"the transaction conflicts": {
+			commitErr: status.Error(codes.Aborted, "Transaction has been aborted. Please retry"),
+			expected:  `{ "add1": null, "add2": null }`,
+			errors: x.GqlErrorList{
+				&x.GqlError{Message: `mutations failed, couldn't commit transaction because ` +
+					`rpc error: code = Aborted desc = Transaction has been aborted. Please retry`,
+					Extensions: map[string]interface{}{
+						"code": schema.ErrTxnConflict, "retryable": true}},
+				&x.GqlError{Message: `Mutation add1 was rolled back because the ` +
+					`transaction was aborted.`,
+					Locations: add1Loc},
+				&x.GqlError{Message: `Mutation add2 was rolled back because the ` +
+					`transaction was aborted.`,
+					Locations: add2Loc}},
+			startTs: []uint64{0, 10, 10, 10},
+			commit:  &dgoapi.TxnContext{StartTs: 10, Keys: []string{"key1", "key3"}},
+		},
+	}
+
+	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
+
+	for name, tcase := range tests {
+		t.Run(name, func(t *testing.T) {
+			ex := &txnExecutor{
+				executor: executor{
+					resp:         `{ "post" : [{ "title": "A Post" } ] }`,
+					assigned:     map[string]string{"Post1": "0x2"},
+					failMutation: tcase.failMutation},
+				commitErr: tcase.commitErr,
+			}
+			resolver := New(
+				gqlSchema,
+				NewResolverFactory(nil, nil).WithConventionResolvers(gqlSchema, &ResolverFns{
+					Arw: NewAddRewriter,
+					Ex:  ex,
+				}))
+
+			resp := resolver.Resolve(context.Background(), &schema.Request{
+				Query:      multiMutation,
+				Extensions: schema.RequestExtensions{Atomic: true},
+			})
+
+			if diff := cmp.Diff(tcase.errors, resp.Errors); diff != "" {
+				t.Errorf("errors mismatch (-want +got):\n%s", diff)
+			}
+			require.JSONEq(t, tcase.expected, resp.Data.String())
+			require.Equal(t, tcase.startTs, ex.startTs)
+			require.Equal(t, []*dgoapi.TxnContext{tcase.commit}, ex.commits)
+		})
+	}
+}
+
 func TestSubscriptionErrorWhenNoneDefined(t *testing.T) {
 	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
 	resp := resolveWithClient(gqlSchema, `subscription { foo }`, nil, nil)
-	test.RequireJSONEq(t, x.GqlErrorList{{Message: "Not resolving subscription because schema" +
-		" doesn't have any fields defined for subscription operation."}}, resp.Errors)
+	test.RequireJSONEq(t, x.GqlErrorList{{
+		Message: "Not resolving subscription because schema" +
+			" doesn't have any fields defined for subscription operation.",
+		Extensions: map[string]interface{}{"code": schema.ErrBadInput, "retryable": false},
+	}}, resp.Errors)
 }
 
 func resolve(gqlSchema schema.Schema, gqlQuery string, dgResponse string) *schema.Response {
@@ -17,12 +17,24 @@
 package resolve
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	dgoapi "github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/graphql/test"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
 )
 
 func TestErrorOnIncorrectValueType(t *testing.T) {
@@ -350,6 +362,359 @@ func TestQueryAlias(t *testing.T) {
 	}
 }
 
+// byQueryExecutor answers each Dgraph query with the response for the first of its keys that
+// the query contains.
+type byQueryExecutor struct {
+	executor
+	resps map[string]string
+}
+
+func (ex *byQueryExecutor) Execute(ctx context.Context, req *dgoapi.Request) (*dgoapi.Response, error) {
+	for key, resp := range ex.resps {
+		if strings.Contains(req.Query, key) {
+			return &dgoapi.Response{Json: []byte(resp)}, nil
+		}
+	}
+	return ex.executor.Execute(ctx, req)
+}
+
+// Each alias of a root query is rewritten into its own Dgraph query, so the same query can
+// be asked for many times with different arguments, and each result ends up under its alias.
+func TestAliasesOfTheSameQuery(t *testing.T) {
+	gqlQuery := `query {
+		a: queryAuthor(filter: {name: {eq: "A"}}) { name }
+		b: queryAuthor(filter: {name: {eq: "B"}}) { authName: name }
+		c: getAuthor(id: "0x1") { name }
+		d: getAuthor(id: "0x2") { name }
+		e: aggregateAuthor(filter: {name: {eq: "A"}}) { count }
+		f: aggregateAuthor { total: count }
+	}`
+	ex := &byQueryExecutor{
+		executor: executor{resp: `{ "aggregateAuthor": [ { "count": 7 } ] }`},
+		resps: map[string]string{
+			`queryAuthor(func: type(Author)) @filter(eq(Author.name, "A"))`: `{
+				"queryAuthor": [ { "name": "A" } ] }`,
+			`queryAuthor(func: type(Author)) @filter(eq(Author.name, "B"))`: `{
+				"queryAuthor": [ { "name": "B" } ] }`,
+			`var(func: type(Author)) @filter(eq(Author.name, "A"))`: `{
+				"aggregateAuthor": [ { "count": 1 } ] }`,
+			"uid(0x1)": `{ "getAuthor": [ { "name": "C" } ] }`,
+			"uid(0x2)": `{ "getAuthor": [ { "name": "D" } ] }`,
+		},
+	}
+
+	gqlSchema := test.LoadSchemaFromFile(t, "schema.graphql")
+	resp := resolveWithClient(gqlSchema, gqlQuery, nil, ex)
+
+	require.Nil(t, resp.Errors)
+	require.JSONEq(t, `{
+		"a": [ { "name": "A" } ],
+		"b": [ { "authName": "B" } ],
+		"c": { "name": "C" },
+		"d": { "name": "D" },
+		"e": { "count": 1 },
+		"f": { "total": 7 }
+	}`, resp.Data.String())
+}
+
+func TestLangArguments(t *testing.T) {
+	gqlQuery := `query {
+		queryAuthor {
+			bio
+			english: bio(lang: "en")
+			french: bio(lang: "fr:.")
+		}
+	}`
+	resp := resolveWithClient(test.LoadSchemaFromFile(t, "schema.graphql"), gqlQuery, nil,
+		&executor{resp: `{ "queryAuthor": [ {
+			"bio": "untagged",
+			"bio.lang": "in English",
+			"bio.lang.1": "en français"
+		} ] }`})
+
+	require.Nil(t, resp.Errors)
+	require.JSONEq(t, `{ "queryAuthor": [ {
+		"bio": "untagged",
+		"english": "in English",
+		"french": "en français"
+	} ] }`, resp.Data.String())
+}
+
+func TestInvalidLangArgument(t *testing.T) {
+	gqlQuery := `query { queryAuthor { bio(lang: "en fr") } }`
+	resp := resolveWithClient(test.LoadSchemaFromFile(t, "schema.graphql"), gqlQuery, nil,
+		&executor{})
+
+	require.NotNil(t, resp.Errors)
+	require.Contains(t, resp.Errors.Error(),
+		"Field `bio` has `en fr` as its lang argument, but it must be language tags")
+}
+
+func TestFragmentsWithoutTypeCondition(t *testing.T) {
+	tests := []QueryCase{
+		{Name: "inline fragment on a union field",
+			GQLQuery: `query { queryHome { members { ... { __typename } } } }`,
+			Response: `{ "queryHome": [ { "members": [
+				{ "dgraph.type": ["Plant"], "dgraph.uid": "0x1" },
+				{ "dgraph.type": ["Dog", "Animal"], "dgraph.uid": "0x2" } ] } ] }`,
+			Expected: `{ "queryHome": [ { "members": [
+				{ "__typename": "Plant" }, { "__typename": "Dog" } ] } ] }`},
+		{Name: "inline fragment on an interface field",
+			GQLQuery: `query { queryCharacter { ... { name } } }`,
+			Response: `{ "queryCharacter": [
+				{ "dgraph.type": ["Director", "Character"], "name": "D", "dgraph.uid": "0x1" },
+				{ "dgraph.type": ["Human", "Character", "Employee"], "name": "H",
+					"dgraph.uid": "0x2" } ] }`,
+			Expected: `{ "queryCharacter": [ { "name": "D" }, { "name": "H" } ] }`},
+		{Name: "inline fragment inside a fragment on a member type",
+			GQLQuery: `query { queryHome { members { ... on Plant { ... { __typename } } } } }`,
+			Response: `{ "queryHome": [ { "members": [
+				{ "dgraph.type": ["Plant"], "dgraph.uid": "0x1" },
+				{ "dgraph.type": ["Dog", "Animal"], "dgraph.uid": "0x2" } ] } ] }`,
+			Expected: `{ "queryHome": [ { "members": [ { "__typename": "Plant" }, {} ] } ] }`},
+	}
+
+	gqlSchema := test.LoadSchemaFromFile(t, "schema.graphql")
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			resp := resolve(gqlSchema, test.GQLQuery, test.Response)
+
+			require.Nil(t, resp.Errors)
+			require.JSONEq(t, test.Expected, resp.Data.String())
+		})
+	}
+}
+
+func TestRootSkipAndInclude(t *testing.T) {
+	tests := []QueryCase{
+		{Name: "skipped query isn't resolved",
+			GQLQuery: `query {
+				getAuthor(id: "0x1") @skip(if: true) { name }
+				getPost(postID: "0x2") { title } }`,
+			Response: `{ "getPost": [ { "title": "A Post" } ] }`,
+			Expected: `{"getPost": {"title": "A Post"}}`},
+		{Name: "query that isn't included isn't resolved",
+			GQLQuery: `query {
+				getAuthor(id: "0x1") @include(if: false) { name }
+				getPost(postID: "0x2") @include(if: true) { title } }`,
+			Response: `{ "getPost": [ { "title": "A Post" } ] }`,
+			Expected: `{"getPost": {"title": "A Post"}}`},
+	}
+
+	gqlSchema := test.LoadSchemaFromFile(t, "schema.graphql")
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			resp := resolve(gqlSchema, test.GQLQuery, test.Response)
+
+			require.Nil(t, resp.Errors)
+			require.JSONEq(t, test.Expected, resp.Data.String())
+		})
+	}
+}
+
+func TestGetQueryNeedsExactlyOneID(t *testing.T) {
+	tests := []QueryCase{
+		{Name: "get with both id and xid",
+			GQLQuery: `query { getEditor(id: "0x1", code: "tolstoy") { name } }`,
+			Expected: `{"getEditor": null}`,
+			Errors: x.GqlErrorList{{
+				Message: "couldn't rewrite query getEditor because getEditor requires exactly " +
+					"one of the arguments (id, code) to be given",
+				Locations: []x.Location{{Line: 1, Column: 9}}}}},
+		{Name: "get with neither id nor xid",
+			GQLQuery: `query { getEditor { name } }`,
+			Expected: `{"getEditor": null}`,
+			Errors: x.GqlErrorList{{
+				Message: "couldn't rewrite query getEditor because getEditor requires exactly " +
+					"one of the arguments (id, code) to be given",
+				Locations: []x.Location{{Line: 1, Column: 9}}}}},
+	}
+
+	gqlSchema := test.LoadSchemaFromFile(t, "schema.graphql")
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			resp := resolve(gqlSchema, test.GQLQuery, `{}`)
+
+			require.Equal(t, test.Errors, resp.Errors)
+			require.JSONEq(t, test.Expected, resp.Data.String())
+		})
+	}
+}
+
+func TestCachedQueryResults(t *testing.T) {
+	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
+	ex := &executor{
+		resp:     `{ "getAuthor": [ { "name": "A.N. Author" } ] }`,
+		assigned: map[string]string{"Post1": "0x2"},
+		result: map[string]interface{}{
+			"Author2": []interface{}{map[string]string{"uid": "0x1"}}},
+	}
+	resolver := New(
+		gqlSchema,
+		NewResolverFactory(nil, nil).WithConventionResolvers(gqlSchema, &ResolverFns{
+			Qrw: NewQueryRewriter(),
+			Arw: NewAddRewriter,
+			Urw: NewUpdateRewriter,
+			Ex:  ex,
+		}))
+	run := func(query string) string {
+		resp := resolver.Resolve(context.Background(), &schema.Request{Query: query})
+		require.Nil(t, resp.Errors)
+		return resp.Data.String()
+	}
+
+	cached := `query @cacheControl(maxAge: 60) { getAuthor(id: "0x1") { name } }`
+	uncached := `query { getAuthor(id: "0x1") { name } }`
+
+	require.JSONEq(t, `{ "getAuthor": { "name": "A.N. Author" } }`, run(cached))
+
+	ex.resp = `{ "getAuthor": [ { "name": "Another Author" } ] }`
+	require.JSONEq(t, `{ "getAuthor": { "name": "A.N. Author" } }`, run(cached))
+	require.JSONEq(t, `{ "getAuthor": { "name": "Another Author" } }`, run(uncached))
+
+	// Adding a post can change the author it links to, so the cached result is dropped.
+	ex.resp = `{ "post": [ { "title": "A Post" } ] }`
+	run(`mutation {
+		addPost(input: [{title: "A Post", author: {id: "0x1"}}]) { post { title } }
+	}`)
+
+	ex.resp = `{ "getAuthor": [ { "name": "Another Author" } ] }`
+	require.JSONEq(t, `{ "getAuthor": { "name": "Another Author" } }`, run(cached))
+}
+
+// batchServer serves a BATCH mode custom field that returns "Bio of <id>" for each author. It
+// records the number of requests, and the most it served at once.
+func batchServer(t *testing.T, maxAuthors int) (srv *httptest.Server, requests,
+	maxInFlight *int32) {
+	requests, maxInFlight = new(int32), new(int32)
+	var inFlight int32
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+		// Give the other requests a chance to overlap with this one.
+		time.Sleep(10 * time.Millisecond)
+
+		// This runs outside the test goroutine, so it can't use require.
+		var authors []map[string]string
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&authors)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.LessOrEqual(t, len(authors), maxAuthors)
+		bios := make([]string, 0, len(authors))
+		for _, a := range authors {
+			bios = append(bios, "Bio of "+a["id"])
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(bios))
+	}))
+	return srv, requests, maxInFlight
+}
+
+func resolveBatchAuthors(t *testing.T, url string, batchSize int) *schema.Response {
+	gqlSchema := test.LoadSchemaFromString(t, fmt.Sprintf(`
+	type Author {
+		id: ID!
+		name: String!
+		bio: String @custom(http: {
+			url: "%s",
+			method: "POST",
+			body: "{ id: $id }",
+			mode: BATCH,
+			batchSize: %d
+		})
+	}`, url, batchSize))
+
+	return resolve(gqlSchema, `query { queryAuthor { name bio } }`,
+		`{ "queryAuthor": [
+			{ "id": "0x1", "name": "A" },
+			{ "id": "0x2", "name": "B" },
+			{ "id": "0x3", "name": "C" },
+			{ "id": "0x4", "name": "D" },
+			{ "id": "0x5", "name": "E" } ] }`)
+}
+
+func TestCustomFieldBatchSize(t *testing.T) {
+	srv, requests, _ := batchServer(t, 2)
+	defer srv.Close()
+
+	resp := resolveBatchAuthors(t, srv.URL, 2)
+	require.Nil(t, resp.Errors)
+	require.Equal(t, int32(3), atomic.LoadInt32(requests))
+	require.JSONEq(t, `{ "queryAuthor": [
+		{ "name": "A", "bio": "Bio of 0x1" },
+		{ "name": "B", "bio": "Bio of 0x2" },
+		{ "name": "C", "bio": "Bio of 0x3" },
+		{ "name": "D", "bio": "Bio of 0x4" },
+		{ "name": "E", "bio": "Bio of 0x5" } ] }`, resp.Data.String())
+}
+
+func TestCustomFieldBatchRequestsBounded(t *testing.T) {
+	defer func(n int) { maxBatchRequests = n }(maxBatchRequests)
+	maxBatchRequests = 2
+
+	srv, requests, maxInFlight := batchServer(t, 1)
+	defer srv.Close()
+
+	resp := resolveBatchAuthors(t, srv.URL, 1)
+	require.Nil(t, resp.Errors)
+	require.Equal(t, int32(5), atomic.LoadInt32(requests))
+	require.LessOrEqual(t, atomic.LoadInt32(maxInFlight), int32(2))
+	require.JSONEq(t, `{ "queryAuthor": [
+		{ "name": "A", "bio": "Bio of 0x1" },
+		{ "name": "B", "bio": "Bio of 0x2" },
+		{ "name": "C", "bio": "Bio of 0x3" },
+		{ "name": "D", "bio": "Bio of 0x4" },
+		{ "name": "E", "bio": "Bio of 0x5" } ] }`, resp.Data.String())
+}
+
+func TestResultCacheGenerationAndSize(t *testing.T) {
+	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
+	op, err := gqlSchema.Operation(&schema.Request{
+		Query: `query @cacheControl(maxAge: 60) { getAuthor(id: "0x1") { name } }`})
+	require.NoError(t, err)
+	c := newResultCache()
+
+	// A result read before an invalidation isn't cached after it.
+	_, generation, ok := c.get("a")
+	require.False(t, ok)
+	c.invalidate(map[string]bool{"Post": true})
+	c.set("a", generation, op, []byte("stale"))
+	_, generation, ok = c.get("a")
+	require.False(t, ok)
+	c.set("a", generation, op, []byte("fresh"))
+	data, _, ok := c.get("a")
+	require.True(t, ok)
+	require.Equal(t, "fresh", string(data))
+	require.Equal(t, len("a")+len("fresh"), c.size)
+
+	// Replacing a result doesn't count it twice.
+	c.set("a", generation, op, []byte("fresher"))
+	require.Equal(t, len("a")+len("fresher"), c.size)
+
+	// Results past the size of the cache aren't cached.
+	big := make([]byte, maxCachedBytes/2)
+	c.set("b", generation, op, big)
+	c.set("c", generation, op, big)
+	_, _, ok = c.get("b")
+	require.True(t, ok)
+	_, _, ok = c.get("c")
+	require.False(t, ok)
+
+	c.invalidate(map[string]bool{"Author": true})
+	require.Empty(t, c.results)
+	require.Zero(t, c.size)
+}
+
 func TestMutationAlias(t *testing.T) {
 
 	tests := map[string]struct {
@@ -485,3 +850,102 @@ func TestResponseOrder(t *testing.T) {
 		})
 	}
 }
+
+// Slow requests are logged with the shape of their variables, but none of the data in them.
+func TestSanitizeVariables(t *testing.T) {
+	vars := map[string]interface{}{
+		"name":  "A.N. Author",
+		"first": json.Number("10"),
+		"filter": map[string]interface{}{
+			"ids":      []interface{}{"0x1", "0x2"},
+			"verified": true,
+			"score":    nil,
+		},
+	}
+
+	require.Equal(t, map[string]interface{}{
+		"name":  "<string>",
+		"first": "<number>",
+		"filter": map[string]interface{}{
+			"ids":      []interface{}{"<string>", "<string>"},
+			"verified": "<bool>",
+			"score":    nil,
+		},
+	}, sanitizeVariables(vars))
+	require.Nil(t, sanitizeVariables(nil))
+}
+
+// The literals of slow requests aren't logged either, as variables are inlined into the DQL.
+func TestRedactLiterals(t *testing.T) {
+	dql := `query {
+  queryAuthor(func: uid(0x1, 0x2), first: 10) @filter((eq(Author.name, "A. \"N\" Author") ` +
+		`AND ge(Author.reputation, -4.5e2))) {
+    Author1 as uid
+  }
+}`
+	require.Equal(t, `query {
+  queryAuthor(func: uid(0x1, 0x2), first: <number>) @filter((eq(Author.name, "<string>") `+
+		`AND ge(Author.reputation, <number>))) {
+    Author1 as uid
+  }
+}`, redactLiterals(dql))
+
+	require.Equal(t, `query { getAuthor(id: "<string>") { name(x: "<string>") } }`,
+		redactLiterals(`query { getAuthor(id: "0x1") { name(x: """a "b" c""") } }`))
+}
+
+func TestFieldMetrics(t *testing.T) {
+	gqlSchema := test.LoadSchemaFromString(t, testGQLSchema)
+	// queryAuthor resolves with an error, because name can't be null.
+	ex := &executor{resp: `{ "getAuthor": [ { "name": "A.N. Author" } ],
+		"queryAuthor": [ { "dob": "2000-01-01" } ] }`}
+
+	count := func(metric, field string) int64 {
+		rows, err := view.RetrieveData(metric)
+		require.NoError(t, err)
+		for _, row := range rows {
+			tags := make(map[string]string)
+			for _, tg := range row.Tags {
+				tags[tg.Key.Name()] = tg.Value
+			}
+			if tags["graphql_operation"] == "query" && tags["graphql_field"] == field {
+				switch data := row.Data.(type) {
+				case *view.CountData:
+					return data.Value
+				case *view.DistributionData:
+					return data.Count
+				}
+			}
+		}
+		return 0
+	}
+
+	// Other tests resolve the same fields, so only the counts added here are checked.
+	metrics := []struct {
+		metric, field string
+		added         int64
+	}{
+		{"num_graphql_field_requests_total", "getAuthor", 2},
+		{"num_graphql_field_errors_total", "getAuthor", 0},
+		{"graphql_field_latency", "getAuthor", 2},
+		{"graphql_field_dql_latency", "getAuthor", 2},
+		{"num_graphql_field_requests_total", "queryAuthor", 1},
+		{"num_graphql_field_errors_total", "queryAuthor", 1},
+	}
+	before := make([]int64, len(metrics))
+	for i, m := range metrics {
+		before[i] = count(m.metric, m.field)
+	}
+
+	resp := resolveWithClient(gqlSchema, `query authorMetrics {
+		getAuthor(id: "0x1") { name }
+		a: getAuthor(id: "0x2") { name }
+		queryAuthor { name }
+	}`, nil, ex)
+	require.NotNil(t, resp.Errors)
+
+	for i, m := range metrics {
+		require.Equal(t, m.added, count(m.metric, m.field)-before[i], "%s of %s", m.metric,
+			m.field)
+	}
+}
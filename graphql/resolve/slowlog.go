@@ -0,0 +1,183 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dgoapi "github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/graphql/authorization"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/peer"
+)
+
+const slowQueryKey resolveCtxKey = "slowQuery"
+
+// A slowQuery collects what a request ran in Dgraph, so that it can be logged if the request
+// turns out to be slower than x.Config.GraphqlSlowQueryThreshold. It's only added to the
+// context of a request when that threshold is set.
+type slowQuery struct {
+	sync.Mutex
+	parse   time.Duration
+	execute time.Duration
+	dql     []string
+}
+
+// recordExecution adds req, and the time it's taken to run in Dgraph since start, to the
+// slowQuery in ctx, if there is one.
+func recordExecution(ctx context.Context, req *dgoapi.Request, start time.Time) {
+	sq, ok := ctx.Value(slowQueryKey).(*slowQuery)
+	if !ok {
+		return
+	}
+
+	var dql []string
+	if req.Query != "" {
+		dql = append(dql, req.Query)
+	}
+	for _, mu := range req.Mutations {
+		// The data of mutations is left out, as it's user data.
+		if mu.Cond != "" {
+			dql = append(dql, "mutation "+mu.Cond)
+		}
+	}
+
+	sq.Lock()
+	defer sq.Unlock()
+	sq.execute += time.Since(start)
+	sq.dql = append(sq.dql, dql...)
+}
+
+// logIfSlow logs gqlReq, and what it ran in Dgraph, if it took longer than
+// x.Config.GraphqlSlowQueryThreshold.
+func (sq *slowQuery) logIfSlow(ctx context.Context, gqlReq *schema.Request, took time.Duration) {
+	if took < x.Config.GraphqlSlowQueryThreshold {
+		return
+	}
+
+	sq.Lock()
+	defer sq.Unlock()
+
+	vars, err := json.Marshal(sanitizeVariables(gqlReq.Variables))
+	if err != nil {
+		vars = []byte(fmt.Sprintf("<%v>", err))
+	}
+	// Whatever wasn't parsing or running in Dgraph was mostly rewriting the request to DQL and
+	// completing the results.
+	rewrite := took - sq.parse - sq.execute
+	if rewrite < 0 {
+		rewrite = 0
+	}
+
+	// The values of variables are inlined into the DQL, and queries can have literals in them,
+	// so all of them are redacted unless the full text is asked for.
+	query, dql := gqlReq.Query, strings.Join(sq.dql, "\n")
+	if !x.Config.GraphqlSlowQueryLogLiterals {
+		query, dql = redactLiterals(query), redactLiterals(dql)
+	}
+
+	glog.Warningf("Slow GraphQL request took %s (parse: %s, rewrite and complete: %s, "+
+		"execute: %s), from %s.\nOperation: %q\nQuery:\n%s\nVariables: %s\nDQL:\n%s",
+		took, sq.parse, rewrite, sq.execute, requestIdentity(ctx), gqlReq.OperationName,
+		query, vars, dql)
+}
+
+var (
+	// stringLiteral matches the strings of GraphQL and DQL, including block strings.
+	stringLiteral = regexp.MustCompile(`"""(?s:.*?)"""|"(?:[^"\\\n]|\\.)*"`)
+	// numberLiteral matches numbers that aren't part of a name, like Author1, or of a uid,
+	// like 0x1.
+	numberLiteral = regexp.MustCompile(`(^|[^\w.])-?\d+(?:\.\d+)?(?:[eE][+-]?\d+)?\b`)
+)
+
+// redactLiterals returns query with its string and number literals replaced by their types, so
+// that its shape can be logged without the data in it.
+func redactLiterals(query string) string {
+	query = stringLiteral.ReplaceAllString(query, `"<string>"`)
+	return numberLiteral.ReplaceAllString(query, "${1}<number>")
+}
+
+// requestIdentity describes who sent the request in ctx, by the subject of their JWT if they
+// sent a valid one, and their IP.
+func requestIdentity(ctx context.Context) string {
+	var who []string
+	if token := authorization.GetJwtToken(ctx); token != "" {
+		if claims, err := authorization.ExtractCustomClaims(ctx); err != nil {
+			who = append(who, "invalid JWT")
+		} else if claims.Subject != "" {
+			who = append(who, "JWT subject "+strconv.Quote(claims.Subject))
+		} else {
+			who = append(who, "JWT "+strconv.FormatUint(farm.Fingerprint64([]byte(token)), 16))
+		}
+	}
+	if _, err := x.ExtractJwt(ctx); err == nil {
+		who = append(who, "ACL access JWT")
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		who = append(who, "IP "+p.Addr.String())
+	}
+	if len(who) == 0 {
+		return "an unknown client"
+	}
+	return strings.Join(who, ", ")
+}
+
+// sanitizeVariables returns a copy of vars with every value replaced by its type, so that the
+// shape of the variables can be logged without the data in them.
+func sanitizeVariables(vars map[string]interface{}) map[string]interface{} {
+	if vars == nil {
+		return nil
+	}
+	res := make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		res[k] = sanitizeValue(v)
+	}
+	return res
+}
+
+func sanitizeValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return sanitizeVariables(v)
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, item := range v {
+			res[i] = sanitizeValue(item)
+		}
+		return res
+	case string:
+		return "<string>"
+	case bool:
+		return "<bool>"
+	case json.Number, int64, float64:
+		return "<number>"
+	case nil:
+		return nil
+	default:
+		return fmt.Sprintf("<%T>", v)
+	}
+}
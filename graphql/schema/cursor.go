@@ -0,0 +1,91 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A Cursor is where the next page of a list starts, as the `after` argument gives it. Clients
+// get cursors in the `cursors` extension of a response and pass them back as they are, so the
+// encoding is free to change.
+//
+// Lists in the default uid order page by the uid of the last node seen, so a page doesn't shift
+// when nodes are added or removed before it. Ordered lists page by how many nodes were seen.
+type Cursor struct {
+	// Order is the order of the list the cursor was made for, as OrderKey gives it. It's empty
+	// for lists in uid order.
+	Order string `json:"o,omitempty"`
+	// After is the uid of the last node seen, for lists in uid order.
+	After uint64 `json:"a,omitempty"`
+	// Offset is the number of nodes seen, for ordered lists.
+	Offset int64 `json:"n,omitempty"`
+}
+
+// String returns the opaque form of c, which ParseCursor reads.
+func (c Cursor) String() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		// A struct of strings and integers always marshals.
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseCursor reads a cursor that Cursor.String returned.
+func ParseCursor(s string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.DisallowUnknownFields()
+		err = d.Decode(&c)
+	}
+	switch {
+	case err != nil:
+	case c.Order == "" && (c.After == 0 || c.Offset != 0):
+		err = errors.New("a cursor for a list in uid order needs a uid, and no offset")
+	case c.Order != "" && (c.After != 0 || c.Offset < 0):
+		err = errors.New("a cursor for an ordered list needs an offset, and no uid")
+	}
+	if err != nil {
+		return Cursor{}, errors.Errorf("%q is not a cursor that Dgraph returned", s)
+	}
+	return c, nil
+}
+
+// OrderKey returns the key of the value of an `order` argument. Cursors record it, so that one
+// can't be used to page through the list in a different order. For example,
+// { asc: title, then: { desc: score } } is "asc:title,desc:score".
+func OrderKey(order interface{}) string {
+	var keys []string
+	o, ok := order.(map[string]interface{})
+	for ok {
+		if asc, ok := o["asc"].(string); ok {
+			keys = append(keys, "asc:"+asc)
+		} else if desc, ok := o["desc"].(string); ok {
+			keys = append(keys, "desc:"+desc)
+		}
+		o, ok = o["then"].(map[string]interface{})
+	}
+	return strings.Join(keys, ",")
+}
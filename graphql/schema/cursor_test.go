@@ -0,0 +1,62 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, c := range []Cursor{
+		{After: 0x3f},
+		{Order: "asc:title"},
+		{Order: "desc:title,asc:numLikes", Offset: 20},
+	} {
+		parsed, err := ParseCursor(c.String())
+		require.NoError(t, err)
+		require.Equal(t, c, parsed)
+	}
+}
+
+func TestParseCursorInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"0x3f",
+		"not base64!",
+		// {"a":0}
+		"eyJhIjowfQ",
+		// {"x":1}
+		"eyJ4IjoxfQ",
+		Cursor{After: 1, Offset: 2}.String(),
+		Cursor{Order: "asc:title", After: 1}.String(),
+		Cursor{Order: "asc:title", Offset: -1}.String(),
+	} {
+		_, err := ParseCursor(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestOrderKey(t *testing.T) {
+	require.Equal(t, "", OrderKey(nil))
+	require.Equal(t, "asc:title", OrderKey(map[string]interface{}{"asc": "title"}))
+	require.Equal(t, "desc:title,asc:numLikes", OrderKey(map[string]interface{}{
+		"desc": "title",
+		"then": map[string]interface{}{"asc": "numLikes"},
+	}))
+}
@@ -25,6 +25,49 @@ import (
 	"github.com/dgraph-io/gqlparser/v2/gqlerror"
 )
 
+// Codes of GraphQL errors. They're set as the "code" extension of an error, along with a
+// "retryable" extension that says whether the same request could succeed if it's retried, so
+// that clients can act on the kind of error without parsing messages.
+const (
+	// ErrTxnConflict is the code of errors from transactions that were aborted because they
+	// conflicted with another transaction.
+	ErrTxnConflict = "ErrTxnConflict"
+	// ErrAuthDenied is the code of errors from operations that the auth rules don't allow.
+	ErrAuthDenied = "ErrAuthDenied"
+	// ErrBadInput is the code of errors from requests that aren't valid.
+	ErrBadInput = "ErrBadInput"
+	// ErrQueryLimit is the code of errors from operations that are over the depth, root field or
+	// cost limits.
+	ErrQueryLimit = "ErrQueryLimit"
+)
+
+// retryableCodes are the codes of errors for which retrying the request can succeed.
+var retryableCodes = map[string]bool{
+	ErrTxnConflict: true,
+}
+
+// WithErrorCode formats err as a list of GraphQL errors, and sets code as the code of each of
+// them that doesn't have one yet. So, when an error is wrapped as it bubbles up the stack, the
+// code it got closest to where it happened is the one that's kept.
+func WithErrorCode(err error, code string) error {
+	if err == nil {
+		return nil
+	}
+
+	errs := AsGQLErrors(err)
+	for _, e := range errs {
+		if _, ok := e.Extensions["code"]; ok {
+			continue
+		}
+		if e.Extensions == nil {
+			e.Extensions = make(map[string]interface{})
+		}
+		e.Extensions["code"] = code
+		e.Extensions["retryable"] = retryableCodes[code]
+	}
+	return errs
+}
+
 // AsGQLErrors formats an error as a list of GraphQL errors.
 // A []*x.GqlError (x.GqlErrorList) gets returned as is, an x.GqlError gets returned as a one
 // item list, and all other errors get printed into a x.GqlError .  A nil input results
@@ -94,9 +137,11 @@ func GQLWrapf(err error, format string, args ...interface{}) error {
 
 	switch err := err.(type) {
 	case *x.GqlError:
-		return x.GqlErrorf("%s because %s", fmt.Sprintf(format, args...), err.Message).
+		wrapped := x.GqlErrorf("%s because %s", fmt.Sprintf(format, args...), err.Message).
 			WithLocations(err.Locations...).
 			WithPath(err.Path)
+		wrapped.Extensions = err.Extensions
+		return wrapped
 	case x.GqlErrorList:
 		var errs x.GqlErrorList
 		for _, e := range err {
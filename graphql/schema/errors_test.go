@@ -231,3 +231,61 @@ func TestAppendGQLErrs(t *testing.T) {
 		})
 	}
 }
+
+func TestWithErrorCode(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		code string
+		req  string
+	}{
+		"code an error": {
+			err:  errors.New("An error occurred"),
+			code: ErrBadInput,
+			req: `[{"message": "An error occurred",
+				"extensions": {"code": "ErrBadInput", "retryable": false}}]`,
+		},
+		"code a retryable error": {
+			err:  x.GqlErrorf("Transaction has been aborted"),
+			code: ErrTxnConflict,
+			req: `[{"message": "Transaction has been aborted",
+				"extensions": {"code": "ErrTxnConflict", "retryable": true}}]`,
+		},
+		"code a list": {
+			err: x.GqlErrorList{
+				x.GqlErrorf("An error occurred"),
+				x.GqlErrorf("Another error"),
+			},
+			code: ErrAuthDenied,
+			req: `[{"message": "An error occurred",
+				"extensions": {"code": "ErrAuthDenied", "retryable": false}},
+				{"message": "Another error",
+				"extensions": {"code": "ErrAuthDenied", "retryable": false}}]`,
+		},
+		"keep an existing code": {
+			err:  WithErrorCode(errors.New("authorization failed"), ErrAuthDenied),
+			code: ErrBadInput,
+			req: `[{"message": "authorization failed",
+				"extensions": {"code": "ErrAuthDenied", "retryable": false}}]`,
+		},
+		"keep the code when wrapped": {
+			err: GQLWrapf(WithErrorCode(errors.New("Transaction has been aborted"),
+				ErrTxnConflict), "mutation failed"),
+			code: ErrBadInput,
+			req: `[{"message": "mutation failed because Transaction has been aborted",
+				"extensions": {"code": "ErrTxnConflict", "retryable": true}}]`,
+		},
+	}
+
+	for name, tcase := range tests {
+		t.Run(name, func(t *testing.T) {
+			gqlErrs, err := json.Marshal(WithErrorCode(tcase.err, tcase.code))
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tcase.req, string(gqlErrs))
+		})
+	}
+}
+
+func TestWithErrorCode_nil(t *testing.T) {
+	require.Nil(t, WithErrorCode(nil, ErrBadInput))
+}
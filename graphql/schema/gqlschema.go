@@ -46,6 +46,14 @@ const (
 	remoteDirective       = "remote" // types with this directive are not stored in Dgraph.
 	lambdaDirective       = "lambda"
 
+	langDirective = "lang"
+	langArg       = "lang"
+
+	facetsDirective = "facets"
+	facetsNamesArg  = "names"
+	facetFilterArg  = "facetFilter"
+	facetOrderArg   = "facetOrder"
+
 	generateDirective       = "generate"
 	generateQueryArg        = "query"
 	generateGetField        = "get"
@@ -72,6 +80,7 @@ const (
 	httpBody    = "body"
 	httpGraphql = "graphql"
 	mode        = "mode"
+	batchSize   = "batchSize"
 	BATCH       = "BATCH"
 	SINGLE      = "SINGLE"
 
@@ -176,6 +185,7 @@ input CustomHTTP {
 	body: String
 	graphql: String
 	mode: Mode
+	batchSize: Int
 	forwardHeaders: [String!]
 	secretHeaders: [String!]
 	introspectionHeaders: [String!]
@@ -276,6 +286,8 @@ directive @custom(http: CustomHTTP, dql: String) on FIELD_DEFINITION
 directive @remote on OBJECT | INTERFACE | UNION | INPUT_OBJECT | ENUM
 directive @cascade(fields: [String]) on FIELD
 directive @lambda on FIELD_DEFINITION
+directive @lang on FIELD_DEFINITION
+directive @facets(names: [String!]!) on FIELD_DEFINITION
 directive @cacheControl(maxAge: Int!) on QUERY
 directive @generate(
 	query: GenerateQueryParams,
@@ -284,6 +296,7 @@ directive @generate(
 
 input IntFilter {
 	eq: Int
+	in: [Int]
 	le: Int
 	lt: Int
 	ge: Int
@@ -293,6 +306,7 @@ input IntFilter {
 
 input Int64Filter {
 	eq: Int64
+	in: [Int64]
 	le: Int64
 	lt: Int64
 	ge: Int64
@@ -302,6 +316,7 @@ input Int64Filter {
 
 input FloatFilter {
 	eq: Float
+	in: [Float]
 	le: Float
 	lt: Float
 	ge: Float
@@ -311,6 +326,7 @@ input FloatFilter {
 
 input DateTimeFilter {
 	eq: DateTime
+	in: [DateTime]
 	le: DateTime
 	lt: DateTime
 	ge: DateTime
@@ -511,6 +527,8 @@ var directiveValidators = map[string]directiveValidator{
 	deprecatedDirective:   ValidatorNoOp,
 	lambdaDirective:       lambdaDirectiveValidation,
 	generateDirective:     ValidatorNoOp,
+	langDirective:         langValidation,
+	facetsDirective:       facetsValidation,
 }
 
 // directiveLocationMap stores the directives and their locations for the ones which can be
@@ -1175,7 +1193,17 @@ func addFieldFilters(schema *ast.Schema, defn *ast.Definition) {
 
 			// Pagination even makes sense when there's no orderables because
 			// Dgraph will do UID order by default.
-			addPaginationArguments(fld)
+			addPaginationArguments(schema, fld)
+		}
+
+		// Fields with @lang can be queried in any of the languages they are stored in.
+		if fld.Directives.ForName(langDirective) != nil {
+			addLangArgument(fld)
+		}
+
+		// Edges with @facets can be filtered and ordered by the facets they carry.
+		if fld.Directives.ForName(facetsDirective) != nil {
+			addFacetArguments(schema, defn, fld)
 		}
 	}
 }
@@ -1266,11 +1294,107 @@ func addOrderArgument(schema *ast.Schema, fld *ast.FieldDefinition) {
 	}
 }
 
-func addPaginationArguments(fld *ast.FieldDefinition) {
+func addPaginationArguments(schema *ast.Schema, fld *ast.FieldDefinition) {
 	fld.Arguments = append(fld.Arguments,
 		&ast.ArgumentDefinition{Name: "first", Type: &ast.Type{NamedType: "Int"}},
 		&ast.ArgumentDefinition{Name: "offset", Type: &ast.Type{NamedType: "Int"}},
 	)
+
+	// Cursor based pagination uses the ID of the last node seen as the cursor, so it is only
+	// possible for types that have an ID field.
+	if fldType := schema.Types[fld.Type.Name()]; fldType != nil && hasID(fldType) {
+		fld.Arguments = append(fld.Arguments,
+			&ast.ArgumentDefinition{Name: "after", Type: &ast.Type{NamedType: "ID"}})
+	}
+}
+
+// addLangArgument adds the `lang` argument, which picks the languages a field is returned in,
+// e.g. `name(lang: "en:fr:.")` is the name in English, or else French, or else any language.
+func addLangArgument(fld *ast.FieldDefinition) {
+	fld.Arguments = append(fld.Arguments,
+		&ast.ArgumentDefinition{Name: langArg, Type: &ast.Type{NamedType: "String"}})
+}
+
+// addFacetArguments adds the `facetFilter` and `facetOrder` arguments to an edge with
+// @facets. For `friends: [Person] @facets(names: ["since", "close"])` in type Person, we get
+// enum PersonFriendsFacet { since, close },
+// input PersonFriendsFacetFilter { since: FacetFilter, close: FacetFilter, and, or, not } and
+// input PersonFriendsFacetOrder { asc: PersonFriendsFacet, desc: PersonFriendsFacet, then }
+// which allow things like
+// friends(facetFilter: { since: { ge: "2020-01-01" } }, facetOrder: { desc: since })
+//
+// If the field comes from an interface, the types are named after the interface, so that the
+// field has the same arguments in the interface and in the types implementing it.
+func addFacetArguments(schema *ast.Schema, defn *ast.Definition, fld *ast.FieldDefinition) {
+	prefix := defn.Name
+	for _, iface := range defn.Interfaces {
+		if schema.Types[iface].Fields.ForName(fld.Name) != nil {
+			prefix = iface
+			break
+		}
+	}
+	prefix += strings.Title(fld.Name) + "Facet"
+	filterName := prefix + "Filter"
+	orderName := prefix + "Order"
+
+	facets := &ast.Definition{Kind: ast.Enum, Name: prefix}
+	filter := &ast.Definition{Kind: ast.InputObject, Name: filterName}
+	for _, name := range facetNames(fld) {
+		facets.EnumValues = append(facets.EnumValues, &ast.EnumValueDefinition{Name: name})
+		filter.Fields = append(filter.Fields,
+			&ast.FieldDefinition{Name: name, Type: &ast.Type{NamedType: "FacetFilter"}})
+	}
+	filter.Fields = append(filter.Fields,
+		&ast.FieldDefinition{Name: "and", Type: ast.ListType(&ast.Type{NamedType: filterName}, nil)},
+		&ast.FieldDefinition{Name: "or", Type: ast.ListType(&ast.Type{NamedType: filterName}, nil)},
+		&ast.FieldDefinition{Name: "not", Type: &ast.Type{NamedType: filterName}})
+
+	schema.Types[prefix] = facets
+	schema.Types[filterName] = filter
+	schema.Types[orderName] = &ast.Definition{
+		Kind: ast.InputObject,
+		Name: orderName,
+		Fields: ast.FieldList{
+			&ast.FieldDefinition{Name: "asc", Type: &ast.Type{NamedType: prefix}},
+			&ast.FieldDefinition{Name: "desc", Type: &ast.Type{NamedType: prefix}},
+			&ast.FieldDefinition{Name: "then", Type: &ast.Type{NamedType: orderName}},
+		},
+	}
+
+	// Facets are compared as whatever type Dgraph stored them as, so the values to compare
+	// with are given as strings and converted by Dgraph.
+	schema.Types["FacetFilter"] = &ast.Definition{
+		Kind: ast.InputObject,
+		Name: "FacetFilter",
+		Fields: ast.FieldList{
+			&ast.FieldDefinition{Name: "eq", Type: &ast.Type{NamedType: "String"}},
+			&ast.FieldDefinition{Name: "le", Type: &ast.Type{NamedType: "String"}},
+			&ast.FieldDefinition{Name: "lt", Type: &ast.Type{NamedType: "String"}},
+			&ast.FieldDefinition{Name: "ge", Type: &ast.Type{NamedType: "String"}},
+			&ast.FieldDefinition{Name: "gt", Type: &ast.Type{NamedType: "String"}},
+		},
+	}
+
+	fld.Arguments = append(fld.Arguments,
+		&ast.ArgumentDefinition{Name: facetFilterArg, Type: &ast.Type{NamedType: filterName}},
+		&ast.ArgumentDefinition{Name: facetOrderArg, Type: &ast.Type{NamedType: orderName}})
+}
+
+// facetNames returns the names of the facets given in the @facets directive of fld.
+func facetNames(fld *ast.FieldDefinition) []string {
+	dir := fld.Directives.ForName(facetsDirective)
+	if dir == nil {
+		return nil
+	}
+	arg := dir.Arguments.ForName(facetsNamesArg)
+	if arg == nil || arg.Value == nil {
+		return nil
+	}
+	var names []string
+	for _, child := range arg.Value.Children {
+		names = append(names, child.Value.Raw)
+	}
+	return names
 }
 
 // getFilterTypes converts search arguments of a field to graphql filter types.
@@ -1370,6 +1494,19 @@ func addFilterType(schema *ast.Schema, defn *ast.Definition) {
 			continue
 		}
 
+		// A field holding a child object can be filtered by the child's own filter, for eg:
+		// queryAuthor(filter: { posts: { title: { anyofterms: "GraphQL" } } })
+		if hasNestedFilter(schema, fld) {
+			filter.Fields = append(filter.Fields,
+				&ast.FieldDefinition{
+					Name: fld.Name,
+					Type: &ast.Type{
+						NamedType: fld.Type.Name() + "Filter",
+					},
+				})
+			continue
+		}
+
 		filterTypes := getFilterTypes(schema, fld, filterName)
 		if len(filterTypes) > 0 {
 			filterName := strings.Join(filterTypes, "_")
@@ -1388,7 +1525,10 @@ func addFilterType(schema *ast.Schema, defn *ast.Definition) {
 	// Has filter makes sense only if there is atleast one non ID field in the defn
 	if len(getFieldsWithoutIDType(schema, defn)) > 0 {
 		filter.Fields = append(filter.Fields,
-			&ast.FieldDefinition{Name: "has", Type: &ast.Type{NamedType: defn.Name + "HasFilter"}},
+			&ast.FieldDefinition{
+				Name: "has",
+				Type: &ast.Type{Elem: &ast.Type{NamedType: defn.Name + "HasFilter"}},
+			},
 		)
 	}
 
@@ -1422,6 +1562,46 @@ func hasFilterable(defn *ast.Definition) bool {
 		})
 }
 
+// hasNestedFilter returns whether fld holds a child object which can be filtered on. That's any
+// object or interface field, which isn't resolved by @custom or @lambda, whose type has a filter.
+// Types with @auth rules can't be filtered on from their parents, as the rules aren't applied to
+// the children that the filter reads, so it could be used to find out about hidden nodes.
+func hasNestedFilter(schema *ast.Schema, fld *ast.FieldDefinition) bool {
+	if _, ok := inbuiltTypeToDgraph[fld.Type.Name()]; ok || hasCustomOrLambda(fld) {
+		return false
+	}
+	fldType := schema.Types[fld.Type.Name()]
+	return fldType != nil && (fldType.Kind == ast.Object || fldType.Kind == ast.Interface) &&
+		hasFilterable(fldType) && !hasAuthRules(schema, fldType)
+}
+
+// hasAuthRules returns whether defn has @auth rules, or is a type that implements an interface
+// with them, or is an interface with a type implementing it that has them.
+func hasAuthRules(schema *ast.Schema, defn *ast.Definition) bool {
+	if defn.Directives.ForName(authDirective) != nil {
+		return true
+	}
+	for _, name := range defn.Interfaces {
+		if iface := schema.Types[name]; iface != nil &&
+			iface.Directives.ForName(authDirective) != nil {
+			return true
+		}
+	}
+	if defn.Kind == ast.Interface {
+		for _, typ := range schema.Types {
+			if typ.Kind != ast.Object || typ.Directives.ForName(authDirective) == nil {
+				continue
+			}
+			for _, name := range typ.Interfaces {
+				if name == defn.Name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // Returns if given field is a list of type
 // This returns true for list of all non scalar types
 func isTypeList(fld *ast.FieldDefinition) bool {
@@ -1593,7 +1773,7 @@ func addAddPayloadType(schema *ast.Schema, defn *ast.Definition) {
 
 	addFilterArgument(schema, qry)
 	addOrderArgument(schema, qry)
-	addPaginationArguments(qry)
+	addPaginationArguments(schema, qry)
 	if schema.Types["Add"+defn.Name+"Input"] != nil {
 		schema.Types["Add"+defn.Name+"Payload"] = &ast.Definition{
 			Kind:   ast.Object,
@@ -1626,7 +1806,7 @@ func addUpdatePayloadType(schema *ast.Schema, defn *ast.Definition) {
 
 	addFilterArgument(schema, qry)
 	addOrderArgument(schema, qry)
-	addPaginationArguments(qry)
+	addPaginationArguments(schema, qry)
 
 	schema.Types["Update"+defn.Name+"Payload"] = &ast.Definition{
 		Kind: ast.Object,
@@ -1651,7 +1831,7 @@ func addDeletePayloadType(schema *ast.Schema, defn *ast.Definition) {
 
 	addFilterArgument(schema, qry)
 	addOrderArgument(schema, qry)
-	addPaginationArguments(qry)
+	addPaginationArguments(schema, qry)
 
 	msg := &ast.FieldDefinition{
 		Name: "msg",
@@ -1783,7 +1963,7 @@ func addFilterQuery(schema *ast.Schema, defn *ast.Definition, generateSubscripti
 	}
 	addFilterArgument(schema, qry)
 	addOrderArgument(schema, qry)
-	addPaginationArguments(qry)
+	addPaginationArguments(schema, qry)
 
 	schema.Query.Fields = append(schema.Query.Fields, qry)
 	subs := defn.Directives.ForName(subscriptionDirective)
@@ -17,10 +17,16 @@
 package schema
 
 import (
+	"encoding/json"
+	"math"
 	"net/http"
 	"reflect"
+	"regexp"
+	"regexp/syntax"
 	"strconv"
+	"strings"
 
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/gqlparser/v2/gqlerror"
 
 	"github.com/pkg/errors"
@@ -43,6 +49,9 @@ type Request struct {
 // RequestExtensions represents extensions recieved in requests
 type RequestExtensions struct {
 	PersistedQuery PersistedQuery
+	// Atomic makes all the mutations of the request run in one transaction, so that either
+	// all or none of them are applied.
+	Atomic bool
 }
 
 // PersistedQuery represents the query struct received from clients like Apollo
@@ -94,6 +103,23 @@ func (s *schema) Operation(req *Request) (Operation, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := cascadeVariableCheck(op.SelectionSet, vars); err != nil {
+		return nil, err
+	}
+	if err := langArgumentCheck(selectedFields(op.SelectionSet), vars); err != nil {
+		return nil, err
+	}
+	if err := facetOrderCheck(selectedFields(op.SelectionSet), vars); err != nil {
+		return nil, err
+	}
+	if err := regexpFilterCheck(selectedFields(op.SelectionSet), vars); err != nil {
+		return nil, err
+	}
+	if !s.noQueryLimits {
+		if err := queryLimitsCheck(op, vars); err != nil {
+			return nil, WithErrorCode(err, ErrQueryLimit)
+		}
+	}
 
 	operation := &operation{op: op,
 		vars:                    vars,
@@ -216,6 +242,310 @@ func variableValidateIntRecursive(schema *ast.Schema, typ *ast.Type, val reflect
 	return nil
 }
 
+// cascadeVariableCheck validates the fields given to @cascade through variables, like in
+// `@cascade(fields: $fields)`. Fields given as literals are already checked during query
+// validation, but variable values aren't available at that point.
+func cascadeVariableCheck(selSet ast.SelectionSet, vars map[string]interface{}) *gqlerror.Error {
+	for _, sel := range selSet {
+		var subSel ast.SelectionSet
+		switch s := sel.(type) {
+		case *ast.Field:
+			if err := cascadeFieldsCheck(s.Directives.ForName(cascadeDirective), vars); err != nil {
+				return err
+			}
+			subSel = s.SelectionSet
+		case *ast.InlineFragment:
+			subSel = s.SelectionSet
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				subSel = s.Definition.SelectionSet
+			}
+		}
+		if err := cascadeVariableCheck(subSel, vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cascadeFieldsCheck(dir *ast.Directive, vars map[string]interface{}) *gqlerror.Error {
+	if dir == nil || dir.ParentDefinition == nil {
+		return nil
+	}
+	arg := dir.Arguments.ForName(cascadeArg)
+	if arg == nil || arg.Value.Kind != ast.Variable {
+		return nil
+	}
+
+	fields, _ := dir.ArgumentMap(vars)[cascadeArg].([]interface{})
+	for _, f := range fields {
+		name, _ := f.(string)
+		if dir.ParentDefinition.Fields.ForName(name) == nil {
+			return gqlerror.ErrorPosf(arg.Position, "Field `%s` is not present in type `%s`. "+
+				"You can only use fields which are in type `%s`", name, dir.ParentDefinition.Name,
+				dir.ParentDefinition.Name)
+		}
+	}
+	return nil
+}
+
+// langTagRegex matches a language tag, like `en` or `zh-Hans`.
+var langTagRegex = regexp.MustCompile(`^[a-zA-Z]+(-[a-zA-Z0-9]+)*$`)
+
+// langArgumentCheck validates the languages given in the `lang` argument of @lang fields, as
+// literals or through variables. They are written into the Dgraph query, so only language
+// tags, and `.` for any language, separated by `:` are allowed.
+func langArgumentCheck(fields []*ast.Field, vars map[string]interface{}) *gqlerror.Error {
+	for _, f := range fields {
+		arg := f.Arguments.ForName(langArg)
+		if arg != nil && f.Definition != nil &&
+			f.Definition.Directives.ForName(langDirective) != nil {
+			if lang, ok := f.ArgumentMap(vars)[langArg].(string); ok {
+				for _, tag := range strings.Split(lang, ":") {
+					if tag != "." && !langTagRegex.MatchString(tag) {
+						return gqlerror.ErrorPosf(arg.Position, "Field `%s` has `%s` as its "+
+							"lang argument, but it must be language tags separated by `:`, "+
+							"like `en:fr:.`.", f.Name, lang)
+					}
+				}
+			}
+		}
+		if err := langArgumentCheck(selectedFields(f.SelectionSet), vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// facetOrderCheck makes sure that no field is ordered both by its values, with `order`, and
+// by the facets of its edges, with `facetOrder`, because a Dgraph query can only do one of them.
+func facetOrderCheck(fields []*ast.Field, vars map[string]interface{}) *gqlerror.Error {
+	for _, f := range fields {
+		if arg := f.Arguments.ForName(facetOrderArg); arg != nil {
+			args := f.ArgumentMap(vars)
+			if args["order"] != nil && args[facetOrderArg] != nil {
+				return gqlerror.ErrorPosf(arg.Position, "Field `%s` has both order and "+
+					"facetOrder arguments, but can only be ordered by one of them.", f.Name)
+			}
+		}
+		if err := facetOrderCheck(selectedFields(f.SelectionSet), vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxRegexpInsts is the most instructions a regular expression in a `regexp` filter can
+// compile to. Counted repetitions are expanded, so it stops patterns like `(abc|def){500}`
+// that are short to write, but expensive for Dgraph to match and to build a trigram query for.
+const maxRegexpInsts = 2000
+
+// regexpFilterCheck validates the regular expressions given to `regexp` filters, as literals or
+// through variables, in a `filter` argument or the filter of an update mutation. They are
+// written into the Dgraph query as they are, so they must be a single /pattern/ with an
+// optional `i` flag, and the pattern must be a valid regular expression of a reasonable size.
+func regexpFilterCheck(fields []*ast.Field, vars map[string]interface{}) *gqlerror.Error {
+	for _, f := range fields {
+		if f.Definition != nil && !hasCustomOrLambda(f.Definition) {
+			args := f.ArgumentMap(vars)
+			filter := args["filter"]
+			if input, ok := args["input"].(map[string]interface{}); ok && filter == nil {
+				filter = input["filter"]
+			}
+			if err := checkRegexps(filter); err != nil {
+				return gqlerror.ErrorPosf(f.Position, "Field `%s` has an invalid regexp filter: "+
+					"%s.", f.Name, err)
+			}
+		}
+		if err := regexpFilterCheck(selectedFields(f.SelectionSet), vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRegexps checks the value of each `regexp` key in a filter, including in and, or, not
+// and the filters of nested fields.
+func checkRegexps(filter interface{}) error {
+	switch v := filter.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if re, ok := val.(string); ok && key == "regexp" {
+				if err := validateRegexp(re); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := checkRegexps(val); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if err := checkRegexps(val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateRegexp(re string) error {
+	end := strings.LastIndex(re, "/")
+	if !strings.HasPrefix(re, "/") || end < 1 {
+		return errors.Errorf("`%s` must be a pattern between slashes, like `/^Dgraph.*/`", re)
+	}
+	if flags := re[end+1:]; flags != "" && flags != "i" {
+		return errors.Errorf("`%s` has `%s` as its flags, but only `i` is allowed", re, flags)
+	}
+
+	pattern := re[1:end]
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i == len(pattern)-1 {
+				return errors.Errorf("`%s` ends with an escaped `/`", re)
+			}
+			i++
+		case '/':
+			return errors.Errorf("`%s` has an unescaped `/` in its pattern", re)
+		}
+	}
+
+	parsed, err := syntax.Parse(strings.Replace(pattern, "\\/", "/", -1), syntax.Perl)
+	if err != nil {
+		return errors.Errorf("`%s` isn't a valid regular expression: %s", re, err)
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil || len(prog.Inst) > maxRegexpInsts {
+		return errors.Errorf("`%s` is too large a regular expression", re)
+	}
+	return nil
+}
+
+// defaultListCost is the number of nodes a list field without a `first` argument is counted as,
+// when estimating the cost of a query.
+const defaultListCost = 100
+
+// queryLimitsCheck rejects an operation that's over the limits, from x.Config, on the number of
+// root fields, the depth of fields and the estimated cost of the query. Introspection fields
+// aren't counted, so that clients can always fetch the schema.
+func queryLimitsCheck(op *ast.OperationDefinition, vars map[string]interface{}) *gqlerror.Error {
+	rootFields := selectedFields(op.SelectionSet)
+	if x.Config.GraphqlMaxRootFields > 0 && len(rootFields) > x.Config.GraphqlMaxRootFields {
+		return gqlerror.ErrorPosf(op.Position, "Operation has %d root fields, which is more "+
+			"than the limit of %d.", len(rootFields), x.Config.GraphqlMaxRootFields)
+	}
+
+	if x.Config.GraphqlMaxDepth > 0 {
+		if err := queryDepthCheck(rootFields, 1); err != nil {
+			return err
+		}
+	}
+
+	if x.Config.GraphqlMaxCost > 0 {
+		if cost := queryCost(rootFields, 1, vars); cost > x.Config.GraphqlMaxCost {
+			return gqlerror.ErrorPosf(op.Position, "Operation has an estimated cost of %d, which "+
+				"is more than the limit of %d.", cost, x.Config.GraphqlMaxCost)
+		}
+	}
+	return nil
+}
+
+// selectedFields returns the fields in selSet, including those selected through fragments, but
+// not the introspection fields like __typename.
+func selectedFields(selSet ast.SelectionSet) []*ast.Field {
+	var fields []*ast.Field
+	for _, sel := range selSet {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if !strings.HasPrefix(s.Name, "__") {
+				fields = append(fields, s)
+			}
+		case *ast.InlineFragment:
+			fields = append(fields, selectedFields(s.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				fields = append(fields, selectedFields(s.Definition.SelectionSet)...)
+			}
+		}
+	}
+	return fields
+}
+
+func queryDepthCheck(fields []*ast.Field, depth int) *gqlerror.Error {
+	for _, f := range fields {
+		if depth > x.Config.GraphqlMaxDepth {
+			return gqlerror.ErrorPosf(f.Position, "Field `%s` is at depth %d, which is more "+
+				"than the limit of %d.", f.Name, depth, x.Config.GraphqlMaxDepth)
+		}
+		if err := queryDepthCheck(selectedFields(f.SelectionSet), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryCost estimates how many nodes the fields could fetch, when each of them is fetched for
+// the given number of parent nodes. An object field fetches one node for each parent, and a list
+// field fetches as many as its `first` argument, or defaultListCost if that isn't given. Scalar
+// fields don't add to the cost. The cost saturates at math.MaxUint64.
+func queryCost(fields []*ast.Field, parents uint64, vars map[string]interface{}) uint64 {
+	var cost uint64
+	for _, f := range fields {
+		if len(f.SelectionSet) == 0 || f.Definition == nil {
+			continue
+		}
+
+		nodes := uint64(1)
+		if f.Definition.Type.Elem != nil {
+			nodes = defaultListCost
+			if first, ok := firstArgValue(f.ArgumentMap(vars)["first"]); ok {
+				nodes = first
+			}
+		}
+		if nodes != 0 && parents > math.MaxUint64/nodes {
+			return math.MaxUint64
+		}
+		nodes *= parents
+
+		cost = addCost(cost, nodes)
+		cost = addCost(cost, queryCost(selectedFields(f.SelectionSet), nodes, vars))
+	}
+	return cost
+}
+
+func addCost(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+// firstArgValue returns the value of a `first` argument, which is an int64 if it was given in
+// the query, or a json.Number if it was given as a variable.
+func firstArgValue(val interface{}) (uint64, bool) {
+	var first int64
+	switch v := val.(type) {
+	case int64:
+		first = v
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+		first = n
+	case float64:
+		first = int64(v)
+	default:
+		return 0, false
+	}
+	if first < 0 {
+		return 0, false
+	}
+	return uint64(first), true
+}
+
 // recursivelyExpandFragmentSelections puts a fragment's selection set directly inside this
 // field's selection set, and does it recursively for all the fields in this field's selection
 // set. This eventually expands all the fragment references anywhere in the hierarchy.
@@ -293,7 +623,7 @@ func recursivelyExpandFragmentSelections(field *ast.Field, op *operation) {
 			additionalTypes[interfaceName] = true
 			for _, f := range field.SelectionSet {
 				addSelectionToInterfaceImplFragFields(interfaceName, f,
-					getTypeNamesAsMap(op.inSchema.schema.PossibleTypes[interfaceName]), op)
+					getTypeNamesAsMap(op.inSchema.schema.PossibleTypes[interfaceName]), op, "")
 			}
 		}
 	case ast.Object:
@@ -364,11 +694,19 @@ func getTypeNamesAsMap(typesDefs []*ast.Definition) map[string]bool {
 	return typeNameMap
 }
 
+// addSelectionToInterfaceImplFragFields records the type conditions of the fields inside field,
+// if it's a fragment. parentTypeCond is the type condition of the enclosing fragment, or "" at the
+// top of the selection set, where it means the type of the field being expanded. An inline
+// fragment without a type condition applies to the same type as its parent.
 func addSelectionToInterfaceImplFragFields(interfaceTypeName string, field ast.Selection,
-	interfaceImplMap map[string]bool, op *operation) {
+	interfaceImplMap map[string]bool, op *operation, parentTypeCond string) {
 	switch frag := field.(type) {
 	case *ast.InlineFragment:
-		addFragFieldsToInterfaceImplFields(interfaceTypeName, frag.TypeCondition,
+		typeCond := frag.TypeCondition
+		if typeCond == "" {
+			typeCond = parentTypeCond
+		}
+		addFragFieldsToInterfaceImplFields(interfaceTypeName, typeCond,
 			frag.SelectionSet, interfaceImplMap, op)
 	case *ast.FragmentSpread:
 		addFragFieldsToInterfaceImplFields(interfaceTypeName, frag.Definition.TypeCondition,
@@ -391,22 +729,25 @@ func addFragFieldsToInterfaceImplFields(interfaceTypeName, typeCond string, selS
 				// we got a fragment inside fragment
 				// the type condition for this fragment will be same as its parent fragment
 				addSelectionToInterfaceImplFragFields(interfaceTypeName, fragField,
-					interfaceImplMap, op)
+					interfaceImplMap, op, typeCond)
 			}
 		}
 	} else if typeCond == "" || typeCond == interfaceTypeName {
-		// otherwise, if the type condition is same as the type of the interface,
-		// then we still need to look if there are any more fragments inside this fragment
+		// otherwise, if the type condition is same as the type of the interface, or it's the type
+		// of the field being expanded, then we still need to look if there are any more
+		// fragments inside this fragment
 		for _, fragField := range selSet {
 			if f, ok := fragField.(*ast.Field); !ok {
 				// we got a fragment inside fragment
 				// the type condition for this fragment may be different that its parent fragment
 				addSelectionToInterfaceImplFragFields(interfaceTypeName, fragField,
-					interfaceImplMap, op)
-			} else {
+					interfaceImplMap, op, typeCond)
+			} else if typeCond != "" {
 				// we got a field on an interface, so save the mapping of field
 				// to the interface type name. This will later be used during completion to find
 				// out if the field should be reported back in the response or not.
+				// Fields of a fragment on the type being expanded apply to all its objects, so
+				// those aren't saved.
 				op.interfaceImplFragFields[f] = interfaceTypeName
 			}
 		}
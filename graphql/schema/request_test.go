@@ -0,0 +1,217 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLimits(t *testing.T) {
+	sch := `
+	type Author {
+		id: ID!
+		name: String!
+		posts: [Post] @hasInverse(field: author)
+	}
+
+	type Post {
+		id: ID!
+		title: String!
+		author: Author
+	}`
+
+	tests := []struct {
+		name       string
+		query      string
+		variables  map[string]interface{}
+		maxDepth   int
+		maxRoot    int
+		maxCost    uint64
+		errMessage string
+	}{
+		{name: "no limits",
+			query: `query { queryAuthor { posts { author { posts { title } } } } }`},
+		{name: "depth within the limit",
+			query:    `query { queryAuthor { posts { title } } }`,
+			maxDepth: 3},
+		{name: "depth over the limit",
+			query:    `query { queryAuthor { posts { author { name } } } }`,
+			maxDepth: 3,
+			errMessage: "Field `name` is at depth 4, which is more than the limit of 3."},
+		{name: "depth counts fields in fragments",
+			query: `query { queryAuthor { ...authorPosts } }
+				fragment authorPosts on Author { posts { author { name } } }`,
+			maxDepth: 3,
+			errMessage: "Field `name` is at depth 4, which is more than the limit of 3."},
+		{name: "introspection isn't limited",
+			query:    `query { __schema { types { fields { type { name } } } } }`,
+			maxDepth: 2,
+			maxRoot:  1,
+			maxCost:  1},
+		{name: "root fields over the limit",
+			query:   `query { a: queryAuthor { name } b: queryAuthor { name } }`,
+			maxRoot: 1,
+			errMessage: "Operation has 2 root fields, which is more than the limit of 1."},
+		{name: "cost within the limit",
+			query:   `query { queryAuthor(first: 10) { posts(first: 5) { title } } }`,
+			maxCost: 60},
+		{name: "cost over the limit",
+			query:   `query { queryAuthor(first: 10) { posts(first: 6) { title } } }`,
+			maxCost: 60,
+			errMessage: "Operation has an estimated cost of 70, which is more than " +
+				"the limit of 60."},
+		{name: "cost of a list without first",
+			query:   `query { queryAuthor { name } }`,
+			maxCost: 99,
+			errMessage: "Operation has an estimated cost of 100, which is more than " +
+				"the limit of 99."},
+		{name: "cost with first from a variable",
+			query:     `query($n: Int) { queryAuthor(first: $n) { posts(first: $n) { title } } }`,
+			variables: map[string]interface{}{"n": json.Number("7")},
+			maxCost:   56},
+	}
+
+	handler, errs := NewHandler(sch, false)
+	require.NoError(t, errs)
+	gqlSchema, err := FromString(handler.GQLSchema())
+	require.NoError(t, err)
+
+	defer func(conf x.Options) { x.Config = conf }(x.Config)
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			x.Config.GraphqlMaxDepth = tcase.maxDepth
+			x.Config.GraphqlMaxRootFields = tcase.maxRoot
+			x.Config.GraphqlMaxCost = tcase.maxCost
+
+			req := &Request{Query: tcase.query, Variables: tcase.variables}
+			_, err := gqlSchema.Operation(req)
+			if tcase.errMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			errs := AsGQLErrors(err)
+			require.Len(t, errs, 1)
+			require.Equal(t, tcase.errMessage, errs[0].Message)
+			require.Equal(t, ErrQueryLimit, errs[0].Extensions["code"])
+
+			// The /admin schema isn't limited.
+			_, err = WithoutQueryLimits(gqlSchema).Operation(req)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFacetOrderCheck(t *testing.T) {
+	sch := `
+	type Person {
+		id: ID!
+		name: String! @search(by: [hash])
+		friends: [Person] @facets(names: ["since"])
+	}`
+
+	handler, errs := NewHandler(sch, false)
+	require.NoError(t, errs)
+	gqlSchema, err := FromString(handler.GQLSchema())
+	require.NoError(t, err)
+
+	_, err = gqlSchema.Operation(&Request{
+		Query: `query { queryPerson { friends(facetOrder: { asc: since }) { name } } }`})
+	require.NoError(t, err)
+
+	_, err = gqlSchema.Operation(&Request{
+		Query: `query($o: PersonFriendsFacetOrder) {
+			queryPerson { friends(order: { asc: name }, facetOrder: $o) { name } } }`,
+		Variables: map[string]interface{}{"o": map[string]interface{}{"asc": "since"}}})
+	require.EqualError(t, err, "input:2: Field `friends` has both order and facetOrder "+
+		"arguments, but can only be ordered by one of them.")
+}
+
+func TestRegexpFilterCheck(t *testing.T) {
+	sch := `
+	type Author {
+		id: ID!
+		name: String! @search(by: [trigram])
+		posts: [Post]
+	}
+
+	type Post {
+		id: ID!
+		title: String! @search(by: [trigram])
+	}`
+
+	tests := []struct {
+		name       string
+		query      string
+		variables  map[string]interface{}
+		errMessage string
+	}{
+		{name: "valid regexp",
+			query: `query { queryAuthor(filter: { name: { regexp: "/^Dg.*h$/i" } }) { name } }`},
+		{name: "escaped slash in the pattern",
+			query: `query { queryAuthor(filter: { name: { regexp: "/a\\/b/" } }) { name } }`},
+		{name: "regexp without slashes",
+			query: `query { queryAuthor(filter: { name: { regexp: "^Dg.*" } }) { name } }`,
+			errMessage: "input:1: Field `queryAuthor` has an invalid regexp filter: `^Dg.*` " +
+				"must be a pattern between slashes, like `/^Dgraph.*/`."},
+		{name: "unsupported flag",
+			query: `query { queryAuthor(filter: { name: { regexp: "/Dg.*/m" } }) { name } }`,
+			errMessage: "input:1: Field `queryAuthor` has an invalid regexp filter: `/Dg.*/m` " +
+				"has `m` as its flags, but only `i` is allowed."},
+		{name: "unescaped slash in the pattern",
+			query: `query { queryAuthor(filter: { name: { regexp: "/a/) OR has(x/" } }) { name } }`,
+			errMessage: "input:1: Field `queryAuthor` has an invalid regexp filter: " +
+				"`/a/) OR has(x/` has an unescaped `/` in its pattern."},
+		{name: "invalid regular expression in a nested filter",
+			query: `query { queryAuthor { posts(filter: { or: [{ title: { regexp: "/(ab/" } }] }) {
+				title } } }`,
+			errMessage: "input:1: Field `posts` has an invalid regexp filter: `/(ab/` isn't a " +
+				"valid regular expression: error parsing regexp: missing closing ): `(ab`."},
+		{name: "too large regular expression from a variable",
+			query: `query($f: AuthorFilter) { queryAuthor(filter: $f) { name } }`,
+			variables: map[string]interface{}{"f": map[string]interface{}{
+				"name": map[string]interface{}{"regexp": "/(abc|def){500}/"}}},
+			errMessage: "input:1: Field `queryAuthor` has an invalid regexp filter: " +
+				"`/(abc|def){500}/` is too large a regular expression."},
+		{name: "regexp in the filter of an update mutation",
+			query: `mutation { updatePost(input: { filter: { title: { regexp: "/a" } },
+				set: { title: "b" } }) { numUids } }`,
+			errMessage: "input:1: Field `updatePost` has an invalid regexp filter: `/a` must " +
+				"be a pattern between slashes, like `/^Dgraph.*/`."},
+	}
+
+	handler, errs := NewHandler(sch, false)
+	require.NoError(t, errs)
+	gqlSchema, err := FromString(handler.GQLSchema())
+	require.NoError(t, err)
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := gqlSchema.Operation(
+				&Request{Query: tcase.query, Variables: tcase.variables})
+			if tcase.errMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tcase.errMessage)
+			}
+		})
+	}
+}
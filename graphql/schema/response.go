@@ -162,6 +162,9 @@ func (r *Response) Output() interface{} {
 
 	if x.Config.GraphqlExtension {
 		res.Extensions = r.Extensions
+	} else if r.Extensions != nil && len(r.Extensions.Cursors) > 0 {
+		// Clients can't page through lists without the cursors, so they're always given.
+		res.Extensions = &Extensions{Cursors: r.Extensions.Cursors}
 	}
 	return res
 }
@@ -170,6 +173,9 @@ func (r *Response) Output() interface{} {
 type Extensions struct {
 	TouchedUids uint64 `json:"touched_uids,omitempty"`
 	Tracing     *Trace `json:"tracing,omitempty"`
+	// Cursors are the `after` cursors of the next pages of the lists in the response, by the
+	// path of the list, like "queryAuthor" or "queryAuthor.0.posts".
+	Cursors map[string]string `json:"cursors,omitempty"`
 }
 
 // GetTouchedUids returns TouchedUids
@@ -193,6 +199,13 @@ func (e *Extensions) Merge(ext *Extensions) {
 	} else {
 		e.Tracing.Merge(ext.Tracing)
 	}
+
+	for path, cursor := range ext.Cursors {
+		if e.Cursors == nil {
+			e.Cursors = make(map[string]string)
+		}
+		e.Cursors[path] = cursor
+	}
 }
 
 // Trace : Apollo Tracing is a GraphQL extension for tracing resolver performance.Response
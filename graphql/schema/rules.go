@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -46,6 +47,9 @@ func init() {
 	validator.AddRule("Check variable type is correct", variableTypeCheck)
 	validator.AddRule("Check arguments of cascade directive", directiveArgumentsCheck)
 	validator.AddRule("Check range for Int type", intRangeCheck)
+	validator.AddRule("Check min and max of between filter", betweenRangeCheck)
+	validator.AddRule("Check cursors of cursor pagination", cursorPaginationCheck)
+	validator.AddRule("Check geo filters have exactly one shape", geoFilterShapeCheck)
 	validator.AddRule("Input Coercion to List", listInputCoercion)
 
 }
@@ -1224,12 +1228,13 @@ func lambdaDirectiveValidation(sch *ast.Schema,
 	field *ast.FieldDefinition,
 	dir *ast.Directive,
 	secrets map[string]x.SensitiveByteSlice) gqlerror.List {
-	// if the lambda url wasn't specified during alpha startup,
+	// if the lambda url wasn't specified during alpha startup, or registered since,
 	// just return that error. Don't confuse the user with errors from @custom yet.
-	if x.Config.GraphqlLambdaUrl == "" {
+	if x.LambdaUrl() == "" {
 		return []*gqlerror.Error{gqlerror.ErrorPosf(dir.Position,
 			"Type %s; Field %s: has the @lambda directive, but the "+
-				"`--graphql_lambda_url` flag wasn't specified during alpha startup.",
+				"`--graphql_lambda_url` flag wasn't specified during alpha startup, and no "+
+				"lambda URL was registered with the registerLambda admin mutation.",
 			typ.Name, field.Name)}
 	}
 	// reuse @custom directive validation
@@ -1582,6 +1587,19 @@ func customDirectiveValidation(sch *ast.Schema,
 				typ.Name, field.Name))
 		}
 	}
+	if bs := httpArg.Value.Children.ForName(batchSize); bs != nil {
+		if !isBatchMode {
+			errs = append(errs, gqlerror.ErrorPosf(
+				bs.Position,
+				"Type %s; Field %s; batchSize field inside @custom directive can only be "+
+					"present if mode is BATCH.", typ.Name, field.Name))
+		} else if size, err := strconv.Atoi(bs.Raw); err != nil || size <= 0 {
+			errs = append(errs, gqlerror.ErrorPosf(
+				bs.Position,
+				"Type %s; Field %s; batchSize field inside @custom directive must be a "+
+					"positive integer, found: `%s`.", typ.Name, field.Name, bs.Raw))
+		}
+	}
 
 	// 7. Validating graphql combination with url params, method and body
 	body := httpArg.Value.Children.ForName(httpBody)
@@ -2003,6 +2021,94 @@ func idValidation(sch *ast.Schema,
 		typ.Name, field.Name, field.Type.String())}
 }
 
+func langValidation(sch *ast.Schema,
+	typ *ast.Definition,
+	field *ast.FieldDefinition,
+	dir *ast.Directive,
+	secrets map[string]x.SensitiveByteSlice) gqlerror.List {
+	if field.Type.Name() != "String" || field.Type.Elem != nil {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: with @lang directive must be of type String or String!, not %s",
+			typ.Name, field.Name, field.Type.String())}
+	}
+	if field.Directives.ForName(idDirective) != nil {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: can't have both @lang and @id directives, as an @id field "+
+				"must have a single value to identify the object.",
+			typ.Name, field.Name)}
+	}
+	if hasCustomOrLambda(field) {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: can't have @lang directive, as fields with @custom or "+
+				"@lambda aren't stored in Dgraph.",
+			typ.Name, field.Name)}
+	}
+	return nil
+}
+
+// facetNameRegex matches the names that can be given to facets in @facets. They become enum
+// values in the generated schema, so they must be GraphQL names.
+var facetNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+func facetsValidation(sch *ast.Schema,
+	typ *ast.Definition,
+	field *ast.FieldDefinition,
+	dir *ast.Directive,
+	secrets map[string]x.SensitiveByteSlice) gqlerror.List {
+	if fldType := sch.Types[field.Type.Name()]; fldType == nil ||
+		(fldType.Kind != ast.Object && fldType.Kind != ast.Interface &&
+			fldType.Kind != ast.Union) {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: has the @facets directive, but only edges to other types "+
+				"can have facets.",
+			typ.Name, field.Name)}
+	}
+	if hasCustomOrLambda(field) {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: can't have @facets directive, as fields with @custom or "+
+				"@lambda aren't stored in Dgraph.",
+			typ.Name, field.Name)}
+	}
+
+	names := facetNames(field)
+	if len(names) == 0 {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: @facets directive must name at least one facet.",
+			typ.Name, field.Name)}
+	}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		switch {
+		case !facetNameRegex.MatchString(name):
+			return []*gqlerror.Error{gqlerror.ErrorPosf(
+				dir.Position,
+				"Type %s; Field %s: @facets directive has `%s` as a facet name, but facet "+
+					"names must be valid GraphQL names.",
+				typ.Name, field.Name, name)}
+		case name == "and" || name == "or" || name == "not" ||
+			name == "true" || name == "false" || name == "null":
+			return []*gqlerror.Error{gqlerror.ErrorPosf(
+				dir.Position,
+				"Type %s; Field %s: @facets directive has `%s` as a facet name, which is "+
+					"reserved.",
+				typ.Name, field.Name, name)}
+		case seen[name]:
+			return []*gqlerror.Error{gqlerror.ErrorPosf(
+				dir.Position,
+				"Type %s; Field %s: @facets directive has `%s` more than once.",
+				typ.Name, field.Name, name)}
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
 func searchMessage(sch *ast.Schema, field *ast.FieldDefinition) string {
 	var possibleSearchArgs []string
 	for name, typ := range supportedSearches {
@@ -258,7 +258,8 @@ func NewHandler(input string, validateOnly bool) (Handler, error) {
 type headersConfig struct {
 	// comma separated list of allowed headers. These are parsed from the forwardHeaders specified
 	// in the @custom directive. They are returned to the client as part of
-	// Access-Control-Allow-Headers.
+	// Access-Control-Allow-Headers. Until a schema is set, it's empty, and only the headers
+	// allowed by x.CorsAllowedHeaders are returned.
 	allowed string
 	// secrets are key value pairs stored in the GraphQL schema which can be added as headers
 	// to requests which resolve custom queries/mutations.
@@ -266,9 +267,7 @@ type headersConfig struct {
 	sync.RWMutex
 }
 
-var hc = headersConfig{
-	allowed: x.AccessControlAllowedHeaders,
-}
+var hc = headersConfig{}
 
 func getAllowedHeaders(sch *ast.Schema, definitions []string, authHeader string) string {
 	headers := make(map[string]struct{})
@@ -315,7 +314,7 @@ func getAllowedHeaders(sch *ast.Schema, definitions []string, authHeader string)
 		finalHeaders = append(finalHeaders, authHeader)
 	}
 
-	allowed := x.AccessControlAllowedHeaders
+	allowed := x.CorsAllowedHeaders()
 	customHeaders := strings.Join(finalHeaders, ",")
 	if len(customHeaders) > 0 {
 		allowed += "," + customHeaders
@@ -327,6 +326,9 @@ func getAllowedHeaders(sch *ast.Schema, definitions []string, authHeader string)
 func AllowedHeaders() string {
 	hc.RLock()
 	defer hc.RUnlock()
+	if hc.allowed == "" {
+		return x.CorsAllowedHeaders()
+	}
 	return hc.allowed
 }
 
@@ -382,6 +384,7 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string) string {
 		indexes map[string]bool
 		upsert  string
 		reverse string
+		lang    string
 	}
 
 	type field struct {
@@ -509,7 +512,11 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string) string {
 					}
 
 					if parentInt == nil {
-						dgPreds[fname] = getUpdatedPred(fname, typStr, upsertStr, indexes)
+						pred := getUpdatedPred(fname, typStr, upsertStr, indexes)
+						if f.Directives.ForName(langDirective) != nil {
+							pred.lang = "@lang "
+						}
+						dgPreds[fname] = pred
 					}
 					typ.fields = append(typ.fields, field{fname, parentInt != nil})
 				case ast.Enum:
@@ -566,8 +573,8 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string) string {
 					sort.Strings(indexes)
 					indexStr = fmt.Sprintf(" @index(%s)", strings.Join(indexes, ", "))
 				}
-				fmt.Fprintf(&preds, "%s: %s%s %s%s.\n", fld.name, f.typ, indexStr, f.upsert,
-					f.reverse)
+				fmt.Fprintf(&preds, "%s: %s%s %s%s%s.\n", fld.name, f.typ, indexStr, f.upsert,
+					f.reverse, f.lang)
 				predWritten[fld.name] = true
 			}
 		}
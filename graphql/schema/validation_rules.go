@@ -19,7 +19,9 @@ package schema
 import (
 	"errors"
 	"strconv"
+	"strings"
 
+	dgTypes "github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/gqlparser/v2/ast"
 	"github.com/dgraph-io/gqlparser/v2/validator"
 )
@@ -132,6 +134,127 @@ func intRangeCheck(observers *validator.Events, addError validator.AddErrFunc) {
 	})
 }
 
+// betweenRangeCheck rejects literal `between: {min, max}` filter values where min is greater
+// than max. Dgraph's between() returns nothing for such a range, which is almost always a
+// mistake on the client side rather than an intended empty result.
+func betweenRangeCheck(observers *validator.Events, addError validator.AddErrFunc) {
+	observers.OnValue(func(walker *validator.Walker, value *ast.Value) {
+		if value.Definition == nil || value.Kind != ast.ObjectValue ||
+			!strings.HasSuffix(value.Definition.Name, "Range") {
+			return
+		}
+
+		min, max := value.Children.ForName("min"), value.Children.ForName("max")
+		if min == nil || max == nil || min.Kind == ast.Variable || max.Kind == ast.Variable ||
+			min.Kind == ast.NullValue || max.Kind == ast.NullValue {
+			return
+		}
+
+		var inverted bool
+		switch value.Definition.Name {
+		case "IntRange", "Int64Range":
+			lo, err1 := strconv.ParseInt(min.Raw, 10, 64)
+			hi, err2 := strconv.ParseInt(max.Raw, 10, 64)
+			inverted = err1 == nil && err2 == nil && lo > hi
+		case "FloatRange":
+			lo, err1 := strconv.ParseFloat(min.Raw, 64)
+			hi, err2 := strconv.ParseFloat(max.Raw, 64)
+			inverted = err1 == nil && err2 == nil && lo > hi
+		case "DateTimeRange":
+			lo, err1 := dgTypes.ParseTime(min.Raw)
+			hi, err2 := dgTypes.ParseTime(max.Raw)
+			inverted = err1 == nil && err2 == nil && lo.After(hi)
+		case "StringRange":
+			inverted = min.Raw > max.Raw
+		default:
+			return
+		}
+
+		if inverted {
+			addError(validator.Message("Invalid range for between filter, min value '%s' is "+
+				"greater than max value '%s'", min.Raw, max.Raw), validator.At(value.Position))
+		}
+	})
+}
+
+// cursorPaginationCheck rejects literal `after` cursors that Dgraph didn't return, or that were
+// returned for the list in a different order. Cursors given in variables are checked when the
+// query is rewritten.
+func cursorPaginationCheck(observers *validator.Events, addError validator.AddErrFunc) {
+	observers.OnField(func(walker *validator.Walker, field *ast.Field) {
+		// Arguments of @custom and @lambda fields are defined by the user, not generated.
+		if field.Definition == nil || field.Definition.Directives.ForName(customDirective) != nil ||
+			field.Definition.Directives.ForName(lambdaDirective) != nil {
+			return
+		}
+		after := field.Arguments.ForName("after")
+		if after == nil || after.Value.Kind == ast.Variable || after.Value.Kind == ast.NullValue {
+			return
+		}
+		cursor, err := ParseCursor(after.Value.Raw)
+		if err != nil {
+			addError(validator.Message("Field `%s` has an invalid `after` cursor: %s.",
+				field.Name, err), validator.At(after.Position))
+			return
+		}
+
+		var order interface{}
+		if arg := field.Arguments.ForName("order"); arg != nil {
+			if hasVariable(arg.Value) {
+				return
+			}
+			order, _ = arg.Value.Value(nil)
+		}
+		if cursor.Order != OrderKey(order) {
+			addError(validator.Message("Field `%s` has an `after` cursor for a different "+
+				"`order`, cursors only page through the list in the order they were returned "+
+				"for.", field.Name), validator.At(after.Position))
+		}
+	})
+}
+
+// hasVariable returns true if value, or any value in it, is given by a variable.
+func hasVariable(value *ast.Value) bool {
+	if value.Kind == ast.Variable {
+		return true
+	}
+	for _, child := range value.Children {
+		if hasVariable(child.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// geoFilterShapeCheck rejects literal `contains` and `intersects` geo filter values that don't
+// give exactly one shape. Both of them take either of two shapes, and without exactly one, there's
+// no DQL geo function to rewrite the filter into.
+func geoFilterShapeCheck(observers *validator.Events, addError validator.AddErrFunc) {
+	observers.OnValue(func(walker *validator.Walker, value *ast.Value) {
+		if value.Definition == nil || value.Kind != ast.ObjectValue ||
+			(value.Definition.Name != "ContainsFilter" &&
+				value.Definition.Name != "IntersectsFilter") {
+			return
+		}
+
+		var shapes []string
+		for _, child := range value.Children {
+			if child.Value.Kind != ast.NullValue {
+				shapes = append(shapes, child.Name)
+			}
+		}
+		if len(shapes) != 1 {
+			var names []string
+			for _, fld := range value.Definition.Fields {
+				names = append(names, "`"+fld.Name+"`")
+			}
+			addError(validator.Message("%s requires exactly one of %s to be given.",
+				value.Definition.Name, strings.Join(names, " or ")),
+				validator.At(value.Position))
+		}
+	})
+}
+
 func valueKindToString(valKind ast.ValueKind) string {
 	switch valKind {
 	case ast.Variable:
@@ -25,6 +25,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/gqlparser/v2/parser"
 
@@ -69,6 +70,10 @@ type FieldHTTPConfig struct {
 	// the GraphqlBatchModeArgument would be sinput, we use it to know the GraphQL variable that
 	// we should send the data in.
 	GraphqlBatchModeArgument string
+
+	// BatchSize is the most objects sent in a single request in BATCH mode. It's 0 if there is
+	// no limit.
+	BatchSize int
 }
 
 // Query/Mutation types and arg names
@@ -101,6 +106,8 @@ type Schema interface {
 // An Operation is a single valid GraphQL operation.  It contains either
 // Queries or Mutations, but not both.  Subscriptions are not yet supported.
 type Operation interface {
+	// Type is the type of the operation: "query", "mutation" or "subscription".
+	Type() string
 	Queries() []Query
 	Mutations() []Mutation
 	Schema() Schema
@@ -108,6 +115,7 @@ type Operation interface {
 	IsMutation() bool
 	IsSubscription() bool
 	CacheControl() string
+	CacheMaxAge() time.Duration
 }
 
 // A Field is one field from an Operation.
@@ -246,6 +254,8 @@ type schema struct {
 	lambdaDirectives map[string]map[string]bool
 	// Map from typename to auth rules
 	authRules map[string]*TypeAuth
+	// noQueryLimits is set for schemas whose operations aren't checked against the query limits.
+	noQueryLimits bool
 }
 
 type operation struct {
@@ -308,6 +318,10 @@ func (s *schema) Mutations(t MutationType) []string {
 	return result
 }
 
+func (o *operation) Type() string {
+	return string(o.op.Operation)
+}
+
 func (o *operation) IsQuery() bool {
 	return o.op.Operation == ast.Query
 }
@@ -331,6 +345,9 @@ func (o *operation) Queries() (qs []Query) {
 
 	for _, s := range o.op.SelectionSet {
 		if f, ok := s.(*ast.Field); ok {
+			if fld := (&field{field: f, op: o}); fld.Skip() || !fld.Include() {
+				continue
+			}
 			qs = append(qs, &query{field: f, op: o, sel: s})
 		}
 	}
@@ -345,6 +362,9 @@ func (o *operation) Mutations() (ms []Mutation) {
 
 	for _, s := range o.op.SelectionSet {
 		if f, ok := s.(*ast.Field); ok {
+			if fld := (&field{field: f, op: o}); fld.Skip() || !fld.Include() {
+				continue
+			}
 			ms = append(ms, &mutation{field: f, op: o})
 		}
 	}
@@ -359,6 +379,17 @@ func (o *operation) CacheControl() string {
 	return "public,max-age=" + o.op.Directives.ForName(cacheControlDirective).Arguments[0].Value.Raw
 }
 
+// CacheMaxAge returns the maxAge given in the @cacheControl directive of the operation, or 0 if
+// the operation has no @cacheControl.
+func (o *operation) CacheMaxAge() time.Duration {
+	dir := o.op.Directives.ForName(cacheControlDirective)
+	if dir == nil {
+		return 0
+	}
+	maxAge, _ := dir.ArgumentMap(o.vars)["maxAge"].(int64)
+	return time.Duration(maxAge) * time.Second
+}
+
 // parentInterface returns the name of an interface that a field belonging to a type definition
 // typDef inherited from. If there is no such interface, then it returns an empty string.
 //
@@ -757,7 +788,7 @@ func buildCustomDirectiveForLambda(defn *ast.Definition, field *ast.FieldDefinit
 
 	// build the children for http argument
 	httpArgChildrens := []*ast.ChildValue{
-		getChildValue(httpUrl, x.Config.GraphqlLambdaUrl, ast.StringValue, lambdaDir.Position),
+		getChildValue(httpUrl, x.LambdaUrl(), ast.StringValue, lambdaDir.Position),
 		getChildValue(httpMethod, http.MethodPost, ast.EnumValue, lambdaDir.Position),
 		getChildValue(httpBody, bodyTemplate.String(), ast.StringValue, lambdaDir.Position),
 	}
@@ -815,6 +846,19 @@ func AsSchema(s *ast.Schema) (Schema, error) {
 	return sch, nil
 }
 
+// WithoutQueryLimits returns a copy of s whose operations aren't checked against the depth, root
+// field and cost limits. The /admin schema uses it, so that the limits set for the GraphQL API
+// never keep an operator from administering the cluster.
+func WithoutQueryLimits(s Schema) Schema {
+	sch, ok := s.(*schema)
+	if !ok {
+		return s
+	}
+	noLimits := *sch
+	noLimits.noQueryLimits = true
+	return &noLimits
+}
+
 func responseName(f *ast.Field) string {
 	if f.Alias == "" {
 		return f.Name
@@ -928,19 +972,25 @@ func (f *field) Cascade() []string {
 	if dir == nil {
 		return nil
 	}
-	arg := dir.Arguments.ForName(cascadeArg)
-	if arg == nil || arg.Value == nil || len(arg.Value.Children) == 0 {
+	// The fields may be given either as a literal list or through a variable. A @cascade
+	// propagated from a mutation to its query field has no definition, and no arguments.
+	var args []interface{}
+	if dir.Definition != nil {
+		args, _ = dir.ArgumentMap(f.op.vars)[cascadeArg].([]interface{})
+	}
+	if len(args) == 0 {
 		return []string{"__all__"}
 	}
-	fields := make([]string, 0, len(arg.Value.Children))
+	fields := make([]string, 0, len(args))
 	typ := f.Type()
 	idField := typ.IDField()
 
-	for _, child := range arg.Value.Children {
-		if idField != nil && idField.Name() == child.Value.Raw {
+	for _, arg := range args {
+		name, _ := arg.(string)
+		if idField != nil && idField.Name() == name {
 			fields = append(fields, "uid")
 		} else {
-			fields = append(fields, typ.DgraphPredicate(child.Value.Raw))
+			fields = append(fields, typ.DgraphPredicate(name))
 		}
 
 	}
@@ -1098,6 +1148,19 @@ func (f *field) IDArgValue() (xid *string, uid uint64, err error) {
 		}
 	}
 
+	// A type with both an ID and an @id field makes both arguments optional, so it's only
+	// here that we can check that the query identifies the node by exactly one of them.
+	if (xid == nil) == (idArg == nil) {
+		var argNames []string
+		for _, arg := range f.field.Definition.Arguments {
+			argNames = append(argNames, arg.Name)
+		}
+		pos := f.field.GetPosition()
+		err = x.GqlErrorf("%s requires exactly one of the arguments (%s) to be given",
+			f.Name(), strings.Join(argNames, ", ")).
+			WithLocations(x.Location{Line: pos.Line, Column: pos.Column})
+	}
+
 	return
 }
 
@@ -1146,6 +1209,11 @@ func getCustomHTTPConfig(f *field, isQueryOrMutation bool) (FieldHTTPConfig, err
 	if op != nil {
 		fconf.Mode = op.Raw
 	}
+	if bs := httpArg.Value.Children.ForName(batchSize); bs != nil {
+		// Safe to ignore the error here since we should already have validated it during
+		// schema update.
+		fconf.BatchSize, _ = strconv.Atoi(bs.Raw)
+	}
 
 	// both body and graphql can't be present together
 	bodyArg := httpArg.Value.Children.ForName("body")
@@ -1393,11 +1461,11 @@ func (q *query) IsArgListType(name string) bool {
 }
 
 func (q *query) Skip() bool {
-	return false
+	return (*field)(q).Skip()
 }
 
 func (q *query) Include() bool {
-	return true
+	return (*field)(q).Include()
 }
 
 func (q *query) Cascade() []string {
@@ -1632,11 +1700,11 @@ func (m *mutation) ArgValue(name string) interface{} {
 }
 
 func (m *mutation) Skip() bool {
-	return false
+	return (*field)(m).Skip()
 }
 
 func (m *mutation) Include() bool {
-	return true
+	return (*field)(m).Include()
 }
 
 func (m *mutation) Cascade() []string {
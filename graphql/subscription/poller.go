@@ -218,12 +218,30 @@ func (p *Poller) poll(req *pollRequest) {
 
 		}
 		for _, subscriber := range subscribers {
-			subscriber.updateCh <- res.Output()
+			publish(subscriber.updateCh, res.Output())
 		}
 		p.Unlock()
 	}
 }
 
+// publish sends update to a subscriber without blocking, so that a client that's slow to read
+// its updates can't hold up the poller, and with it every other subscriber. Each update is the
+// whole result of the subscription, so if the subscriber's buffer is full, its oldest pending
+// update is dropped to make room for the latest one.
+func publish(updateCh chan interface{}, update interface{}) {
+	for {
+		select {
+		case updateCh <- update:
+			return
+		default:
+		}
+		select {
+		case <-updateCh:
+		default:
+		}
+	}
+}
+
 // UpdateResolver will update the resolver.
 func (p *Poller) UpdateResolver(resolver *resolve.RequestResolver) {
 	p.Lock()
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishDropsOldestUpdateForSlowSubscriber(t *testing.T) {
+	updateCh := make(chan interface{}, 2)
+	for i := 1; i <= 4; i++ {
+		publish(updateCh, i)
+	}
+
+	require.Len(t, updateCh, 2)
+	require.Equal(t, 3, <-updateCh)
+	require.Equal(t, 4, <-updateCh)
+}
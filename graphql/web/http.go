@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"math"
 	"mime"
 	"net/http"
 	"strconv"
@@ -38,7 +39,9 @@ import (
 	"github.com/dgraph-io/graphql-transport-ws/graphqlws"
 	"github.com/dgrijalva/jwt-go/v4"
 	"github.com/golang/glog"
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
+	ostats "go.opencensus.io/stats"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/metadata"
 )
@@ -47,6 +50,8 @@ type Headerkey string
 
 const (
 	touchedUidsHeader = "Graphql-TouchedUids"
+	// timeoutHeader is the header a request can give its timeout in, as a duration like "5s".
+	timeoutHeader = "X-Dgraph-Timeout"
 )
 
 // An IServeGraphQL can serve a GraphQL endpoint (currently only ons http)
@@ -66,6 +71,8 @@ type graphqlHandler struct {
 	resolver *resolve.RequestResolver
 	handler  http.Handler
 	poller   *subscription.Poller
+	// admin is true if this serves /admin, which isn't rate limited.
+	admin bool
 }
 
 // NewServer returns a new IServeGraphQL that can serve the given resolvers
@@ -73,6 +80,7 @@ func NewServer(schemaEpoch *uint64, resolver *resolve.RequestResolver, admin boo
 	gh := &graphqlHandler{
 		resolver: resolver,
 		poller:   subscription.NewPoller(schemaEpoch, resolver),
+		admin:    admin,
 	}
 	gh.handler = recoveryHandler(commonHeaders(admin, gh.Handler()))
 	return gh
@@ -130,11 +138,39 @@ func (gs *graphqlSubscription) Subscribe(
 
 	// library (graphql-transport-ws) passes the headers which are part of the INIT payload to us in the context.
 	// And we are extracting the Auth JWT from those and passing them along.
+	header, _ := ctx.Value("Header").(json.RawMessage)
+	customClaims, err := subscriptionClaims(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	req := &schema.Request{
+		OperationName: operationName,
+		Query:         document,
+		Variables:     variableValues,
+	}
+
+	res, err := gs.graphqlHandler.poller.AddSubscriber(req, customClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		// Context is cancelled when a client disconnects, so delete subscription after client
+		// disconnects.
+		<-ctx.Done()
+		gs.graphqlHandler.poller.TerminateSubscription(res.BucketID, res.SubscriptionID)
+	}()
+	return res.UpdateCh, ctx.Err()
+}
+
+// subscriptionClaims returns the claims of the JWT in header, the payload of the connection_init
+// message of a subscription. It returns empty claims if there's no JWT, and an error if it isn't
+// valid.
+func subscriptionClaims(ctx context.Context,
+	header json.RawMessage) (*authorization.CustomClaims, error) {
 	customClaims := &authorization.CustomClaims{
 		StandardClaims: jwt.StandardClaims{},
 	}
-	header, _ := ctx.Value("Header").(json.RawMessage)
-
 	if len(header) > 0 {
 		payload := make(map[string]interface{})
 		if err := json.Unmarshal(header, &payload); err != nil {
@@ -147,47 +183,47 @@ func (gs *graphqlSubscription) Subscribe(
 				continue
 			}
 
+			token, ok := val.(string)
+			if !ok {
+				return nil, errors.Errorf("%s in the connection payload must be a string", name)
+			}
 			md := metadata.New(map[string]string{
-				"authorizationJwt": val.(string),
+				"authorizationJwt": token,
 			})
-			ctx = metadata.NewIncomingContext(ctx, md)
-			customClaims, err = authorization.ExtractCustomClaims(ctx)
+			var err error
+			customClaims, err = authorization.ExtractCustomClaims(
+				metadata.NewIncomingContext(ctx, md))
 			if err != nil {
 				return nil, err
 			}
 			break
 		}
-
 	}
 	// for the cases when no expiry is given in jwt or subscription doesn't have any authorization,
 	// we set their expiry to zero time
 	if customClaims.StandardClaims.ExpiresAt == nil {
 		customClaims.StandardClaims.ExpiresAt = jwt.At(time.Time{})
 	}
-	req := &schema.Request{
-		OperationName: operationName,
-		Query:         document,
-		Variables:     variableValues,
-	}
-
-	res, err := gs.graphqlHandler.poller.AddSubscriber(req, customClaims)
-	if err != nil {
-		return nil, err
-	}
-
-	go func() {
-		// Context is cancelled when a client disconnects, so delete subscription after client
-		// disconnects.
-		<-ctx.Done()
-		gs.graphqlHandler.poller.TerminateSubscription(res.BucketID, res.SubscriptionID)
-	}()
-	return res.UpdateCh, ctx.Err()
+	return customClaims, nil
 }
 
+// Handler serves subscriptions over websockets, and everything else over HTTP. Websocket clients
+// that offer the graphql-transport-ws protocol are served by it, and the rest by the older
+// graphql-ws protocol.
 func (gh *graphqlHandler) Handler() http.Handler {
-	return graphqlws.NewHandlerFunc(&graphqlSubscription{
-		graphqlHandler: gh,
-	}, gh)
+	subscriptions := &graphqlSubscription{graphqlHandler: gh}
+	legacy := graphqlws.NewHandlerFunc(subscriptions, gh)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			for _, protocol := range websocket.Subprotocols(r) {
+				if protocol == protocolTransportWS {
+					serveTransportWS(w, r, subscriptions)
+					return
+				}
+			}
+		}
+		legacy.ServeHTTP(w, r)
+	})
 }
 
 // ServeHTTP handles GraphQL queries and mutations that get resolved
@@ -212,6 +248,32 @@ func (gh *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx = x.AttachRemoteIP(ctx, r)
 	ctx = x.AttachAuthToken(ctx, r)
 
+	if !gh.admin {
+		if ok, retryAfter := limiter.allow(rateLimitClient(ctx, r), time.Now()); !ok {
+			ostats.Record(ctx, x.NumGraphQLRateLimited.M(1))
+			w.Header().Set("Retry-After",
+				strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			w.WriteHeader(http.StatusTooManyRequests)
+			write(w, schema.ErrorResponse(errors.New("Too many requests. Please retry after "+
+				"the number of seconds in the Retry-After header.")), false)
+			return
+		}
+
+		timeout, err := requestTimeout(r)
+		if err != nil {
+			write(w, schema.ErrorResponse(err),
+				strings.Contains(r.Header.Get("Accept-Encoding"), "gzip"))
+			return
+		}
+		if timeout > 0 {
+			// The queries and mutations of the request run with this context, down to the
+			// workers, so they're cancelled when it times out.
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
 	var res *schema.Response
 	gqlReq, err := getRequest(ctx, r)
 
@@ -229,6 +291,23 @@ func (gh *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	write(w, res, strings.Contains(r.Header.Get("Accept-Encoding"), "gzip"))
 }
 
+// requestTimeout returns the timeout of the request: the one in its X-Dgraph-Timeout header, or
+// the --graphql_timeout, whichever is shorter. 0 means there's no timeout.
+func requestTimeout(r *http.Request) (time.Duration, error) {
+	timeout := x.Config.GraphqlTimeout
+	if val := r.Header.Get(timeoutHeader); val != "" {
+		reqTimeout, err := time.ParseDuration(val)
+		if err != nil || reqTimeout <= 0 {
+			return 0, errors.Errorf("Invalid %s header %q: it must be a positive duration, "+
+				"like \"5s\" or \"500ms\".", timeoutHeader, val)
+		}
+		if timeout <= 0 || reqTimeout < timeout {
+			timeout = reqTimeout
+		}
+	}
+	return timeout, nil
+}
+
 func (gh *graphqlHandler) isValid() bool {
 	return !(gh == nil || gh.resolver == nil)
 }
@@ -318,14 +397,17 @@ func getRequest(ctx context.Context, r *http.Request) (*schema.Request, error) {
 func commonHeaders(admin bool, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if admin {
-			x.AddCorsHeaders(w)
+			// /admin endpoint is protected by the origins given in --admin_cors_origins.
+			x.AddAdminCorsHeaders(w, r.Header.Get("Origin"))
 		} else {
 			// /graphql endpoint is protected by allow listed origins.
 			addDynamicHeaders(r.Header.Get("Origin"), w)
 		}
 		// Overwrite the allowed headers after also including headers which are part of
-		// forwardHeaders.
-		w.Header().Set("Access-Control-Allow-Headers", schema.AllowedHeaders())
+		// forwardHeaders, unless the origin isn't allowed at all.
+		if w.Header().Get("Access-Control-Allow-Origin") != "" {
+			w.Header().Set("Access-Control-Allow-Headers", schema.AllowedHeaders())
+		}
 
 		w.Header().Set("Content-Type", "application/json")
 
@@ -364,7 +446,7 @@ func addDynamicHeaders(origin string, w http.ResponseWriter) {
 		// to access.
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 	}
-	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", x.AccessControlAllowedHeaders)
+	w.Header().Set("Access-Control-Allow-Methods", x.CorsAllowedMethods())
+	w.Header().Set("Access-Control-Allow-Headers", x.CorsAllowedHeaders())
 	w.Header().Set("Access-Control-Allow-Credentials", "true")
 }
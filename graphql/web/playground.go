@@ -0,0 +1,117 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// playgroundCDN is where the page loads GraphiQL and React from.
+const playgroundCDN = "https://cdn.jsdelivr.net"
+
+// playgroundPage is a GraphiQL IDE that sends its requests to the endpoint it's given. The page
+// itself holds nothing about the schema: GraphiQL loads it by introspecting the endpoint, so
+// it always shows the current schema, and it can only see as much of it as introspection of
+// the endpoint allows. Requests are sent with the headers entered in the IDE, so they're
+// authorized like any other request to the endpoint.
+var playgroundPage = template.Must(template.New("playground").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>Dgraph GraphQL Playground</title>
+  <style>
+    body { height: 100vh; margin: 0; overflow: hidden; }
+    #graphiql { height: 100vh; }
+  </style>
+  <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/graphiql@1.4.7/graphiql.min.css">
+  <script crossorigin src="https://cdn.jsdelivr.net/npm/react@17.0.2/umd/react.production.min.js"></script>
+  <script crossorigin src="https://cdn.jsdelivr.net/npm/react-dom@17.0.2/umd/react-dom.production.min.js"></script>
+  <script crossorigin src="https://cdn.jsdelivr.net/npm/graphiql@1.4.7/graphiql.min.js"></script>
+</head>
+<body>
+  <div id="graphiql">Loading...</div>
+  <script>
+    var endpoint = {{.Endpoint}};
+    function fetcher(params, opts) {
+      var headers = { "Content-Type": "application/json" };
+      var extra = (opts && opts.headers) || {};
+      for (var k in extra) {
+        headers[k] = extra[k];
+      }
+      return fetch(endpoint, {
+        method: "POST",
+        headers: headers,
+        body: JSON.stringify(params),
+        credentials: "same-origin"
+      }).then(function(resp) { return resp.json(); });
+    }
+    ReactDOM.render(
+      React.createElement(GraphiQL, { fetcher: fetcher, headerEditorEnabled: true }),
+      document.getElementById("graphiql"));
+  </script>
+</body>
+</html>
+`))
+
+// PlaygroundHandler returns a handler that serves a GraphiQL IDE for the GraphQL endpoint at
+// the given path.
+//
+// The scripts of GraphiQL and React are loaded from playgroundCDN without integrity hashes, so
+// the page is only as trustworthy as the CDN is. It's served with a Content-Security-Policy that
+// only lets it run those scripts and its own inline one, and only send requests to this alpha.
+func PlaygroundHandler(endpoint string) http.Handler {
+	var page bytes.Buffer
+	x.Check(playgroundPage.Execute(&page, struct{ Endpoint string }{endpoint}))
+	csp := playgroundPolicy(page.Bytes())
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, x.ErrorInvalidMethod, http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		// The page sends requests with the headers entered in it, which could be JWTs, so it
+		// mustn't be framed by other sites.
+		w.Header().Set("X-Frame-Options", "DENY")
+		w.Header().Set("Content-Security-Policy", csp)
+		x.Check2(w.Write(page.Bytes()))
+	})
+}
+
+// playgroundPolicy returns the Content-Security-Policy of the rendered page, which allows its
+// inline script by its hash.
+func playgroundPolicy(page []byte) string {
+	const openTag, closeTag = "<script>", "</script>"
+	start := bytes.LastIndex(page, []byte(openTag))
+	x.AssertTrue(start >= 0)
+	start += len(openTag)
+	end := bytes.Index(page[start:], []byte(closeTag))
+	x.AssertTrue(end >= 0)
+	sum := sha256.Sum256(page[start : start+end])
+
+	return fmt.Sprintf("default-src 'none'; script-src %s 'sha256-%s'; "+
+		"style-src %s 'unsafe-inline'; img-src 'self' data:; font-src %s data:; "+
+		"connect-src 'self'; frame-ancestors 'none'", playgroundCDN,
+		base64.StdEncoding.EncodeToString(sum[:]), playgroundCDN, playgroundCDN)
+}
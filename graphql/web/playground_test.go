@@ -0,0 +1,63 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaygroundHandler(t *testing.T) {
+	handler := PlaygroundHandler("/graphql")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql/playground", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+
+	body := rec.Body.String()
+	require.Contains(t, body, `var endpoint = "/graphql";`)
+
+	// The policy only allows the inline script that the page has.
+	csp := rec.Header().Get("Content-Security-Policy")
+	start := strings.LastIndex(body, "<script>") + len("<script>")
+	end := start + strings.Index(body[start:], "</script>")
+	sum := sha256.Sum256([]byte(body[start:end]))
+	require.Contains(t, csp, "'sha256-"+base64.StdEncoding.EncodeToString(sum[:])+"'")
+	require.Contains(t, csp, "connect-src 'self'")
+	require.Contains(t, csp, "default-src 'none'")
+
+	for _, method := range []string{http.MethodPost, http.MethodPut} {
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, "/graphql/playground", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	}
+}
+
+func TestPlaygroundEndpointEscaped(t *testing.T) {
+	rec := httptest.NewRecorder()
+	PlaygroundHandler(`/graphql"</script><script>alert(1)`).ServeHTTP(rec,
+		httptest.NewRequest(http.MethodGet, "/graphql/playground", nil))
+	require.NotContains(t, rec.Body.String(), "<script>alert(1)")
+}
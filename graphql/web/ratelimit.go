@@ -0,0 +1,112 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/authorization"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgryski/go-farm"
+)
+
+// rateLimiter limits the rate of requests each client sends to /graphql, with a token bucket per
+// client. A client's bucket holds up to x.Config.GraphqlRateBurst tokens, and is refilled at
+// x.Config.GraphqlRateLimit tokens per second. Each request takes a token, and is rejected if
+// there's none left.
+type rateLimiter struct {
+	sync.Mutex
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+var limiter = &rateLimiter{buckets: make(map[string]*tokenBucket)}
+
+// allow takes a token from the bucket of the given client. If there's none, it returns false,
+// along with how long until there is one.
+func (rl *rateLimiter) allow(client string, now time.Time) (bool, time.Duration) {
+	rate, burst := x.Config.GraphqlRateLimit, float64(x.Config.GraphqlRateBurst)
+	if rate <= 0 {
+		return true, 0
+	}
+	if burst <= 0 {
+		burst = math.Ceil(rate)
+	}
+
+	rl.Lock()
+	defer rl.Unlock()
+
+	rl.cleanup(now, rate, burst)
+
+	b, ok := rl.buckets[client]
+	if !ok {
+		b = &tokenBucket{tokens: burst, last: now}
+		rl.buckets[client] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / rate * float64(time.Second))
+}
+
+// cleanup drops, once a minute, the buckets that have refilled, as they're the same as new ones.
+func (rl *rateLimiter) cleanup(now time.Time, rate, burst float64) {
+	if now.Sub(rl.lastCleanup) < time.Minute {
+		return
+	}
+	rl.lastCleanup = now
+	refill := time.Duration(burst / rate * float64(time.Second))
+	for client, b := range rl.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(rl.buckets, client)
+		}
+	}
+}
+
+// rateLimitClient returns who the request in ctx is from, for rate limiting. That's the subject
+// of its GraphQL JWT, or the JWT itself if it has no subject. Only valid JWTs are used, so that
+// clients can't get new buckets by making up tokens. Requests without a valid JWT are told
+// apart by their IP.
+func rateLimitClient(ctx context.Context, r *http.Request) string {
+	if token := authorization.GetJwtToken(ctx); token != "" {
+		if claims, err := authorization.ExtractCustomClaims(ctx); err == nil {
+			if claims.Subject != "" {
+				return "sub:" + claims.Subject
+			}
+			return "jwt:" + strconv.FormatUint(farm.Fingerprint64([]byte(token)), 16)
+		}
+	}
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	return "ip:" + ip
+}
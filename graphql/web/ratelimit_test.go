@@ -0,0 +1,147 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/authorization"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func setRateLimit(t *testing.T, rate float64, burst int) {
+	oldRate, oldBurst := x.Config.GraphqlRateLimit, x.Config.GraphqlRateBurst
+	t.Cleanup(func() { x.Config.GraphqlRateLimit, x.Config.GraphqlRateBurst = oldRate, oldBurst })
+	x.Config.GraphqlRateLimit, x.Config.GraphqlRateBurst = rate, burst
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	setRateLimit(t, 2, 3)
+	rl := &rateLimiter{buckets: make(map[string]*tokenBucket)}
+	now := time.Now()
+
+	// A new client can send a burst of requests at once.
+	for i := 0; i < 3; i++ {
+		ok, _ := rl.allow("a", now)
+		require.True(t, ok, "request %d", i)
+	}
+	ok, retryAfter := rl.allow("a", now)
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// Other clients have buckets of their own.
+	ok, _ = rl.allow("b", now)
+	require.True(t, ok)
+
+	// The bucket refills at the rate, and a rejected request doesn't take a token.
+	ok, retryAfter = rl.allow("a", now.Add(250*time.Millisecond))
+	require.False(t, ok)
+	require.Equal(t, 250*time.Millisecond, retryAfter)
+	ok, _ = rl.allow("a", now.Add(500*time.Millisecond))
+	require.True(t, ok)
+	ok, _ = rl.allow("a", now.Add(500*time.Millisecond))
+	require.False(t, ok)
+
+	// It doesn't refill past the burst.
+	later := now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		ok, _ = rl.allow("a", later)
+		require.True(t, ok, "request %d", i)
+	}
+	ok, _ = rl.allow("a", later)
+	require.False(t, ok)
+}
+
+func TestRateLimiterDefaults(t *testing.T) {
+	rl := &rateLimiter{buckets: make(map[string]*tokenBucket)}
+	now := time.Now()
+
+	// Without a rate, nothing is limited.
+	setRateLimit(t, 0, 1)
+	for i := 0; i < 100; i++ {
+		ok, _ := rl.allow("a", now)
+		require.True(t, ok)
+	}
+	require.Empty(t, rl.buckets)
+
+	// Without a burst, it's the rate rounded up.
+	setRateLimit(t, 1.5, 0)
+	for i := 0; i < 2; i++ {
+		ok, _ := rl.allow("a", now)
+		require.True(t, ok, "request %d", i)
+	}
+	ok, _ := rl.allow("a", now)
+	require.False(t, ok)
+}
+
+func TestRateLimiterCleanup(t *testing.T) {
+	setRateLimit(t, 1, 10)
+	rl := &rateLimiter{buckets: make(map[string]*tokenBucket)}
+	now := time.Now()
+
+	ok, _ := rl.allow("a", now)
+	require.True(t, ok)
+	ok, _ = rl.allow("b", now.Add(30*time.Second))
+	require.True(t, ok)
+	require.Len(t, rl.buckets, 2)
+
+	// The cleanup runs once a minute, and drops the buckets that had the 10 seconds they take
+	// to refill.
+	ok, _ = rl.allow("b", now.Add(2*time.Minute))
+	require.True(t, ok)
+	require.Len(t, rl.buckets, 1)
+	require.Contains(t, rl.buckets, "b")
+}
+
+func TestRateLimitClient(t *testing.T) {
+	authorization.SetAuthMeta(&authorization.AuthMeta{
+		VerificationKey: "secret",
+		Header:          "X-Test-Auth",
+		Algo:            jwt.SigningMethodHS256.Name,
+		SigningMethod:   jwt.SigningMethodHS256,
+	})
+	defer authorization.SetAuthMeta(&authorization.AuthMeta{})
+
+	sign := func(claims jwt.MapClaims) string {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(
+			[]byte("secret"))
+		require.NoError(t, err)
+		return token
+	}
+	client := func(token string) string {
+		r := httptest.NewRequest("POST", "/graphql", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		if token != "" {
+			r.Header.Set("X-Test-Auth", token)
+		}
+		return rateLimitClient(authorization.AttachAuthorizationJwt(context.Background(), r), r)
+	}
+
+	require.Equal(t, "ip:192.0.2.1", client(""))
+	require.Equal(t, "sub:alice", client(sign(jwt.MapClaims{"sub": "alice"})))
+	noSubject := client(sign(jwt.MapClaims{"name": "alice"}))
+	require.True(t, strings.HasPrefix(noSubject, "jwt:"), noSubject)
+	// Made up tokens don't get buckets of their own.
+	require.Equal(t, "ip:192.0.2.1", client("not a jwt"))
+}
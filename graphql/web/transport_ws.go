@@ -0,0 +1,360 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/websocket"
+)
+
+// protocolTransportWS is the graphql-transport-ws protocol of the graphql-ws library, which
+// replaces the graphql-ws protocol of subscriptions-transport-ws that graphqlws serves. See
+// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md.
+const protocolTransportWS = "graphql-transport-ws"
+
+// The types of the messages of the protocol.
+const (
+	wsConnectionInit = "connection_init"
+	wsConnectionAck  = "connection_ack"
+	wsPing           = "ping"
+	wsPong           = "pong"
+	wsSubscribe      = "subscribe"
+	wsNext           = "next"
+	wsError          = "error"
+	wsComplete       = "complete"
+)
+
+// The codes that the protocol closes the connection with.
+const (
+	wsCloseBadRequest     = 4400
+	wsCloseUnauthorized   = 4401
+	wsCloseForbidden      = 4403
+	wsCloseInitTimeout    = 4408
+	wsCloseSubscriberUsed = 4409
+	wsCloseTooManyInits   = 4429
+)
+
+var (
+	// wsInitTimeout is how long a client has to send connection_init after it connects.
+	wsInitTimeout = 10 * time.Second
+	// wsPingInterval is how often the connection is pinged. It's closed if nothing is read from
+	// the client for two of them, so a client that's gone doesn't hold its subscriptions.
+	wsPingInterval = 15 * time.Second
+	// wsWriteTimeout is how long a message can take to write to the client.
+	wsWriteTimeout = 10 * time.Second
+	// wsReadLimit is the largest message read from the client, in bytes.
+	wsReadLimit int64 = 64 << 10
+	// wsSendBuffer is how many messages can wait to be written to the client. Once it's full,
+	// the subscriptions of the connection wait, and the poller only keeps their latest update.
+	wsSendBuffer = 16
+)
+
+var transportUpgrader = websocket.Upgrader{
+	CheckOrigin:  func(r *http.Request) bool { return true },
+	Subprotocols: []string{protocolTransportWS},
+}
+
+// subscriptionService starts the subscriptions of websocket connections. It's given the payload
+// of connection_init in the context, under the "Header" key.
+type subscriptionService interface {
+	Subscribe(ctx context.Context, document, operationName string,
+		variableValues map[string]interface{}) (<-chan interface{}, error)
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type subscribePayload struct {
+	OperationName string                 `json:"operationName"`
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// wsOperation is a subscription running on a connection.
+type wsOperation struct {
+	cancel context.CancelFunc
+}
+
+// wsConnection is a websocket connection that speaks the graphql-transport-ws protocol.
+type wsConnection struct {
+	ws      *websocket.Conn
+	service subscriptionService
+	ctx     context.Context
+	cancel  context.CancelFunc
+	out     chan wsMessage
+
+	sync.Mutex
+	// header is the payload of connection_init, set once it's acknowledged.
+	header json.RawMessage
+	acked  bool
+	// ops has the running subscriptions of the connection, by their id.
+	ops map[string]*wsOperation
+
+	closeOnce sync.Once
+}
+
+// serveTransportWS upgrades the request to a websocket connection, and serves the subscriptions
+// sent over it by the graphql-transport-ws protocol.
+func serveTransportWS(w http.ResponseWriter, r *http.Request, service subscriptionService) {
+	ws, err := transportUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already replied with the error.
+		return
+	}
+	if ws.Subprotocol() != protocolTransportWS {
+		_ = ws.Close()
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &wsConnection{
+		ws:      ws,
+		service: service,
+		ctx:     ctx,
+		cancel:  cancel,
+		out:     make(chan wsMessage, wsSendBuffer),
+		ops:     make(map[string]*wsOperation),
+	}
+	go c.writeLoop()
+	go c.readLoop()
+}
+
+// closeWith closes the connection with the code and reason, which stops all its subscriptions.
+func (c *wsConnection) closeWith(code int, reason string) {
+	c.closeOnce.Do(func() {
+		msg := websocket.FormatCloseMessage(code, reason)
+		_ = c.ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteTimeout))
+		c.cancel()
+		_ = c.ws.Close()
+	})
+}
+
+// send queues msg to be written to the client. It waits while the queue is full, and returns
+// false if the connection was closed meanwhile.
+func (c *wsConnection) send(msg wsMessage) bool {
+	select {
+	case c.out <- msg:
+		return true
+	case <-c.ctx.Done():
+		return false
+	}
+}
+
+func (c *wsConnection) writeLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+
+	write := func(msg wsMessage) bool {
+		if err := c.ws.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+			return false
+		}
+		return c.ws.WriteJSON(msg) == nil
+	}
+	for {
+		var ok bool
+		select {
+		case <-c.ctx.Done():
+			return
+		case msg := <-c.out:
+			ok = write(msg)
+		case <-ticker.C:
+			ok = write(wsMessage{Type: wsPing})
+		}
+		if !ok {
+			c.closeWith(websocket.CloseGoingAway, "")
+			return
+		}
+	}
+}
+
+func (c *wsConnection) readLoop() {
+	defer c.closeWith(websocket.CloseNormalClosure, "")
+
+	c.ws.SetReadLimit(wsReadLimit)
+	initTimer := time.AfterFunc(wsInitTimeout, func() {
+		c.Lock()
+		acked := c.acked
+		c.Unlock()
+		if !acked {
+			c.closeWith(wsCloseInitTimeout, "Connection initialisation timeout")
+		}
+	})
+	defer initTimer.Stop()
+
+	for {
+		// Clients answer the pings, so one that's silent for longer is gone.
+		if err := c.ws.SetReadDeadline(time.Now().Add(2 * wsPingInterval)); err != nil {
+			return
+		}
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg wsMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			c.closeWith(wsCloseBadRequest, "Invalid message received")
+			return
+		}
+
+		switch msg.Type {
+		case wsConnectionInit:
+			if !c.init(msg) {
+				return
+			}
+		case wsPing:
+			if !c.send(wsMessage{Type: wsPong, Payload: msg.Payload}) {
+				return
+			}
+		case wsPong:
+		case wsSubscribe:
+			if !c.subscribe(msg) {
+				return
+			}
+		case wsComplete:
+			c.stop(msg.ID)
+		default:
+			c.closeWith(wsCloseBadRequest, fmt.Sprintf("Invalid message type %q", msg.Type))
+			return
+		}
+	}
+}
+
+// init acknowledges the connection_init message, once its payload has been checked to carry a
+// valid JWT, if any. It returns false if it closed the connection instead.
+func (c *wsConnection) init(msg wsMessage) bool {
+	c.Lock()
+	if c.acked {
+		c.Unlock()
+		c.closeWith(wsCloseTooManyInits, "Too many initialisation requests")
+		return false
+	}
+	c.Unlock()
+
+	if _, err := subscriptionClaims(c.ctx, msg.Payload); err != nil {
+		c.closeWith(wsCloseForbidden, "Forbidden: "+err.Error())
+		return false
+	}
+
+	c.Lock()
+	c.header, c.acked = msg.Payload, true
+	c.Unlock()
+	return c.send(wsMessage{Type: wsConnectionAck})
+}
+
+// subscribe starts the subscription of msg, and sends its updates to the client until it's
+// completed by either side. It returns false if it closed the connection instead.
+func (c *wsConnection) subscribe(msg wsMessage) bool {
+	c.Lock()
+	acked, header := c.acked, c.header
+	_, used := c.ops[msg.ID]
+	c.Unlock()
+
+	var payload subscribePayload
+	switch {
+	case !acked:
+		c.closeWith(wsCloseUnauthorized, "Unauthorized")
+		return false
+	case msg.ID == "":
+		c.closeWith(wsCloseBadRequest, "Missing id for subscribe")
+		return false
+	case used:
+		c.closeWith(wsCloseSubscriberUsed, fmt.Sprintf("Subscriber for %s already exists",
+			msg.ID))
+		return false
+	case json.Unmarshal(msg.Payload, &payload) != nil || payload.Query == "":
+		c.closeWith(wsCloseBadRequest, "Invalid payload for subscribe")
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	updates, err := c.service.Subscribe(context.WithValue(ctx, "Header", header),
+		payload.Query, payload.OperationName, payload.Variables)
+	if err != nil {
+		cancel()
+		return c.send(wsMessage{ID: msg.ID, Type: wsError, Payload: errorsPayload(err)})
+	}
+
+	op := &wsOperation{cancel: cancel}
+	c.Lock()
+	c.ops[msg.ID] = op
+	c.Unlock()
+
+	go func() {
+		defer c.remove(msg.ID, op)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update, ok := <-updates:
+				if !ok {
+					// The subscription ended on the server, like when its JWT expired.
+					c.send(wsMessage{ID: msg.ID, Type: wsComplete})
+					return
+				}
+				data, err := json.Marshal(update)
+				if err != nil {
+					glog.Errorf("While marshalling subscription update: %v", err)
+					c.send(wsMessage{ID: msg.ID, Type: wsError, Payload: errorsPayload(err)})
+					return
+				}
+				if !c.send(wsMessage{ID: msg.ID, Type: wsNext, Payload: data}) {
+					return
+				}
+			}
+		}
+	}()
+	return true
+}
+
+// stop stops the subscription with the id, if it's still running.
+func (c *wsConnection) stop(id string) {
+	c.Lock()
+	defer c.Unlock()
+	if op, ok := c.ops[id]; ok {
+		op.cancel()
+		delete(c.ops, id)
+	}
+}
+
+// remove stops op and removes it from the subscriptions of the connection. The id might have been
+// reused by a new subscription once op was completed by the client, which is left running.
+func (c *wsConnection) remove(id string, op *wsOperation) {
+	c.Lock()
+	defer c.Unlock()
+	op.cancel()
+	if c.ops[id] == op {
+		delete(c.ops, id)
+	}
+}
+
+// errorsPayload returns the payload of an error message, which is a list of GraphQL errors.
+func errorsPayload(err error) json.RawMessage {
+	b, _ := json.Marshal([]struct {
+		Message string `json:"message"`
+	}{{Message: err.Error()}})
+	return b
+}
@@ -0,0 +1,315 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/authorization"
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSubscriptions sends the updates it's given to the subscriptions that are still running, and
+// closes them once updates is closed.
+type fakeSubscriptions struct {
+	updates chan interface{}
+	// headers gets the connection payload of each subscription.
+	headers chan json.RawMessage
+	// stopped gets the query of each subscription once its context is cancelled.
+	stopped chan string
+}
+
+func newFakeSubscriptions() *fakeSubscriptions {
+	return &fakeSubscriptions{
+		updates: make(chan interface{}),
+		headers: make(chan json.RawMessage, 10),
+		stopped: make(chan string, 10),
+	}
+}
+
+func (f *fakeSubscriptions) Subscribe(ctx context.Context, document, operationName string,
+	variableValues map[string]interface{}) (<-chan interface{}, error) {
+	if strings.Contains(document, "invalid") {
+		return nil, errors.New("invalid subscription")
+	}
+	header, _ := ctx.Value("Header").(json.RawMessage)
+	f.headers <- header
+	updates := make(chan interface{})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				f.stopped <- document
+				return
+			case update, ok := <-f.updates:
+				if !ok {
+					close(updates)
+					<-ctx.Done()
+					f.stopped <- document
+					return
+				}
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					f.stopped <- document
+					return
+				}
+			}
+		}
+	}()
+	return updates, nil
+}
+
+func dialTransportWS(t *testing.T, service subscriptionService) *websocket.Conn {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveTransportWS(w, r, service)
+	}))
+	t.Cleanup(server.Close)
+
+	dialer := websocket.Dialer{Subprotocols: []string{protocolTransportWS}}
+	ws, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	require.Equal(t, protocolTransportWS, ws.Subprotocol())
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+func writeWS(t *testing.T, ws *websocket.Conn, msg string) {
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte(msg)))
+}
+
+func readWS(t *testing.T, ws *websocket.Conn) wsMessage {
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg wsMessage
+	require.NoError(t, ws.ReadJSON(&msg))
+	return msg
+}
+
+// requireClosed requires the server to close ws with the code.
+func requireClosed(t *testing.T, ws *websocket.Conn, code int) {
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	for {
+		_, _, err := ws.ReadMessage()
+		if err == nil {
+			continue
+		}
+		require.True(t, websocket.IsCloseError(err, code), "got %v, want close code %d", err, code)
+		return
+	}
+}
+
+func TestTransportWSSubscription(t *testing.T) {
+	service := newFakeSubscriptions()
+	ws := dialTransportWS(t, service)
+
+	writeWS(t, ws, `{"type":"connection_init","payload":{"X-Test":"value"}}`)
+	require.Equal(t, wsConnectionAck, readWS(t, ws).Type)
+
+	writeWS(t, ws, `{"type":"ping","payload":{"n":1}}`)
+	pong := readWS(t, ws)
+	require.Equal(t, wsPong, pong.Type)
+	require.JSONEq(t, `{"n":1}`, string(pong.Payload))
+
+	writeWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { q }"}}`)
+	require.JSONEq(t, `{"X-Test":"value"}`, string(<-service.headers))
+
+	service.updates <- map[string]interface{}{"data": map[string]interface{}{"q": 1}}
+	next := readWS(t, ws)
+	require.Equal(t, wsNext, next.Type)
+	require.Equal(t, "1", next.ID)
+	require.JSONEq(t, `{"data":{"q":1}}`, string(next.Payload))
+
+	// The subscription is completed once the server ends it.
+	close(service.updates)
+	complete := readWS(t, ws)
+	require.Equal(t, wsComplete, complete.Type)
+	require.Equal(t, "1", complete.ID)
+	require.Equal(t, "subscription { q }", <-service.stopped)
+}
+
+func TestTransportWSCompleteByClient(t *testing.T) {
+	service := newFakeSubscriptions()
+	ws := dialTransportWS(t, service)
+
+	writeWS(t, ws, `{"type":"connection_init"}`)
+	require.Equal(t, wsConnectionAck, readWS(t, ws).Type)
+	writeWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { q }"}}`)
+	<-service.headers
+	writeWS(t, ws, `{"id":"1","type":"complete"}`)
+	require.Equal(t, "subscription { q }", <-service.stopped)
+
+	// The id can be used again once it's completed, and the new subscription isn't stopped along
+	// with the old one.
+	writeWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { q }"}}`)
+	<-service.headers
+	for i := 0; i < 3; i++ {
+		service.updates <- map[string]interface{}{"data": map[string]interface{}{"q": i}}
+		next := readWS(t, ws)
+		require.Equal(t, wsNext, next.Type)
+		require.Equal(t, "1", next.ID)
+	}
+	select {
+	case <-service.stopped:
+		t.Fatal("the new subscription was stopped")
+	default:
+	}
+}
+
+func TestTransportWSResubscribeWhileStopping(t *testing.T) {
+	service := newFakeSubscriptions()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Nothing writes to the client, so the subscriptions wait in send until out is read.
+	c := &wsConnection{
+		service: service,
+		ctx:     ctx,
+		cancel:  cancel,
+		out:     make(chan wsMessage),
+		acked:   true,
+		ops:     make(map[string]*wsOperation),
+	}
+	subscribe := wsMessage{ID: "1", Type: wsSubscribe,
+		Payload: json.RawMessage(`{"query":"subscription { q }"}`)}
+
+	require.True(t, c.subscribe(subscribe))
+	<-service.headers
+	service.updates <- map[string]interface{}{"data": map[string]interface{}{"q": 1}}
+	c.stop("1")
+	<-service.stopped
+
+	// The first subscription only returns once its update is sent, after the id has been used
+	// again.
+	require.True(t, c.subscribe(subscribe))
+	<-service.headers
+	require.Equal(t, wsNext, (<-c.out).Type)
+	select {
+	case <-service.stopped:
+		t.Fatal("the new subscription was stopped along with the old one")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	service.updates <- map[string]interface{}{"data": map[string]interface{}{"q": 2}}
+	next := <-c.out
+	require.Equal(t, wsNext, next.Type)
+	require.JSONEq(t, `{"data":{"q":2}}`, string(next.Payload))
+}
+
+func TestTransportWSSubscribeError(t *testing.T) {
+	ws := dialTransportWS(t, newFakeSubscriptions())
+
+	writeWS(t, ws, `{"type":"connection_init"}`)
+	require.Equal(t, wsConnectionAck, readWS(t, ws).Type)
+	writeWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { invalid }"}}`)
+	msg := readWS(t, ws)
+	require.Equal(t, wsError, msg.Type)
+	require.Equal(t, "1", msg.ID)
+	require.JSONEq(t, `[{"message":"invalid subscription"}]`, string(msg.Payload))
+}
+
+func TestTransportWSClose(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		code     int
+	}{
+		{
+			name: "subscribe before init",
+			messages: []string{
+				`{"id":"1","type":"subscribe","payload":{"query":"subscription { q }"}}`,
+			},
+			code: wsCloseUnauthorized,
+		},
+		{
+			name:     "init twice",
+			messages: []string{`{"type":"connection_init"}`, `{"type":"connection_init"}`},
+			code:     wsCloseTooManyInits,
+		},
+		{
+			name: "id already used",
+			messages: []string{
+				`{"type":"connection_init"}`,
+				`{"id":"1","type":"subscribe","payload":{"query":"subscription { q }"}}`,
+				`{"id":"1","type":"subscribe","payload":{"query":"subscription { q }"}}`,
+			},
+			code: wsCloseSubscriberUsed,
+		},
+		{
+			name:     "unknown type",
+			messages: []string{`{"type":"start"}`},
+			code:     wsCloseBadRequest,
+		},
+		{
+			name:     "not JSON",
+			messages: []string{`{`},
+			code:     wsCloseBadRequest,
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			ws := dialTransportWS(t, newFakeSubscriptions())
+			for _, msg := range tcase.messages {
+				writeWS(t, ws, msg)
+			}
+			requireClosed(t, ws, tcase.code)
+		})
+	}
+}
+
+func TestTransportWSInvalidJWT(t *testing.T) {
+	authorization.SetAuthMeta(&authorization.AuthMeta{
+		VerificationKey: "secret",
+		Header:          "X-Test-Auth",
+		Algo:            jwt.SigningMethodHS256.Name,
+		SigningMethod:   jwt.SigningMethodHS256,
+	})
+	defer authorization.SetAuthMeta(&authorization.AuthMeta{})
+
+	ws := dialTransportWS(t, newFakeSubscriptions())
+	writeWS(t, ws, `{"type":"connection_init","payload":{"X-Test-Auth":"not a jwt"}}`)
+	requireClosed(t, ws, wsCloseForbidden)
+}
+
+func TestTransportWSInitTimeout(t *testing.T) {
+	defer func(d time.Duration) { wsInitTimeout = d }(wsInitTimeout)
+	wsInitTimeout = 50 * time.Millisecond
+
+	ws := dialTransportWS(t, newFakeSubscriptions())
+	requireClosed(t, ws, wsCloseInitTimeout)
+}
+
+func TestTransportWSKeepalive(t *testing.T) {
+	defer func(d time.Duration) { wsPingInterval = d }(wsPingInterval)
+	wsPingInterval = 50 * time.Millisecond
+
+	ws := dialTransportWS(t, newFakeSubscriptions())
+	writeWS(t, ws, `{"type":"connection_init"}`)
+	require.Equal(t, wsConnectionAck, readWS(t, ws).Type)
+	require.Equal(t, wsPing, readWS(t, ws).Type)
+
+	// A client that doesn't answer is disconnected.
+	requireClosed(t, ws, websocket.CloseNormalClosure)
+}
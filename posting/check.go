@@ -0,0 +1,235 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgryski/go-farm"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxCheckProblems is the most problems that CheckPredicate reports for a predicate. The ones
+// after that are only counted.
+const maxCheckProblems = 100
+
+type checkReport struct {
+	attr     string
+	problems []string
+	dropped  int
+}
+
+func (r *checkReport) addf(format string, args ...interface{}) {
+	if len(r.problems) == maxCheckProblems {
+		r.dropped++
+		return
+	}
+	r.problems = append(r.problems, r.attr+": "+fmt.Sprintf(format, args...))
+}
+
+func (r *checkReport) result() []string {
+	if r.dropped > 0 {
+		r.problems = append(r.problems, fmt.Sprintf("%s: and %d more problems", r.attr,
+			r.dropped))
+	}
+	return r.problems
+}
+
+// CheckPredicate reads all the data lists of attr at readTs, and checks that its index and
+// reverse keys hold the uids that its data needs, and no others. It returns a message for each
+// problem it finds.
+func CheckPredicate(ctx context.Context, attr string, readTs uint64) ([]string, error) {
+	su, ok := schema.State().Get(ctx, attr)
+	if !ok {
+		return nil, errors.Errorf("predicate %s isn't in the schema", attr)
+	}
+	tokenizers := schema.State().Tokenizer(ctx, attr)
+	isUid := su.ValueType == pb.Posting_UID
+	reverse := isUid && su.Directive == pb.SchemaUpdate_REVERSE
+
+	r := &checkReport{attr: attr}
+	// index holds the uids of each token, and rev the subjects of each object. The data lists
+	// are read in the order of their uids, so these lists are sorted.
+	index := make(map[string][]uint64)
+	rev := make(map[uint64][]uint64)
+	add := func(uids []uint64, uid uint64) []uint64 {
+		if len(uids) > 0 && uids[len(uids)-1] == uid {
+			return uids
+		}
+		return append(uids, uid)
+	}
+
+	pk := x.ParsedKey{Attr: attr}
+	err := iterateLists(ctx, pk.DataPrefix(), readTs, func(key []byte, pl *List) error {
+		dk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		subject := dk.Uid
+		err = pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+			switch {
+			case reverse:
+				rev[p.Uid] = add(rev[p.Uid], subject)
+			case !isUid && len(tokenizers) > 0:
+				edge := &pb.DirectedEdge{Attr: attr, Entity: subject, Lang: string(p.LangTag)}
+				toks, err := indexTokens(ctx, &indexMutationInfo{
+					tokenizers: tokenizers,
+					edge:       edge,
+					val:        types.Val{Tid: types.TypeID(p.ValType), Value: p.Value},
+				})
+				if err != nil {
+					r.addf("the value of %#x can't be indexed: %v", subject, err)
+					return nil
+				}
+				for _, t := range toks {
+					index[t] = add(index[t], subject)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			r.addf("the list of %#x can't be read: %v", subject, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !isUid && len(tokenizers) > 0 {
+		err = compareLists(ctx, r, pk.IndexPrefix(), readTs, func(pk x.ParsedKey) ([]uint64,
+			string) {
+			uids := index[pk.Term]
+			delete(index, pk.Term)
+			return uids, fmt.Sprintf("index key %q", pk.Term)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for t, uids := range index {
+			r.addf("index key %q is missing, with %d uids", t, len(uids))
+		}
+	}
+	if reverse {
+		err = compareLists(ctx, r, pk.ReversePrefix(), readTs, func(pk x.ParsedKey) ([]uint64,
+			string) {
+			uids := rev[pk.Uid]
+			delete(rev, pk.Uid)
+			return uids, fmt.Sprintf("reverse key of %#x", pk.Uid)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for obj, uids := range rev {
+			r.addf("reverse key of %#x is missing, with %d uids", obj, len(uids))
+		}
+	}
+	return r.result(), nil
+}
+
+// compareLists checks the uids of the lists whose keys start with prefix against the ones that
+// want returns for their keys, along with the name of the key to report.
+func compareLists(ctx context.Context, r *checkReport, prefix []byte, readTs uint64,
+	want func(pk x.ParsedKey) ([]uint64, string)) error {
+	return iterateLists(ctx, prefix, readTs, func(key []byte, pl *List) error {
+		pk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		expected, name := want(pk)
+		got, err := pl.Uids(ListOptions{ReadTs: readTs})
+		if err != nil {
+			r.addf("%s can't be read: %v", name, err)
+			return nil
+		}
+		if len(got.Uids) == 0 && len(expected) == 0 {
+			return nil
+		}
+		exp := &pb.List{Uids: expected}
+		if missing := algo.Difference(exp, got); len(missing.Uids) > 0 {
+			r.addf("%s is missing %d uids, like %#x", name, len(missing.Uids), missing.Uids[0])
+		}
+		if stale := algo.Difference(got, exp); len(stale.Uids) > 0 {
+			r.addf("%s has %d stale uids, like %#x", name, len(stale.Uids), stale.Uids[0])
+		}
+		return nil
+	})
+}
+
+// ChecksumPredicate returns a checksum of the data of attr at readTs. It's the same on all the
+// replicas of a group that have applied the same transactions.
+func ChecksumPredicate(ctx context.Context, attr string, readTs uint64) (uint64, error) {
+	var sum uint64
+	pk := x.ParsedKey{Attr: attr}
+	err := iterateLists(ctx, pk.DataPrefix(), readTs, func(key []byte, pl *List) error {
+		return pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+			buf := make([]byte, 0, len(key)+len(p.Value)+len(p.LangTag)+9)
+			buf = append(buf, key...)
+			buf = append(buf, p.Value...)
+			buf = append(buf, p.LangTag...)
+			buf = append(buf, byte(p.ValType))
+			var uid [8]byte
+			binary.BigEndian.PutUint64(uid[:], p.Uid)
+			buf = append(buf, uid[:]...)
+			for _, f := range p.Facets {
+				buf = append(buf, f.Key...)
+				buf = append(buf, f.Value...)
+			}
+			// The postings are combined with a xor, so the order they're read in doesn't
+			// matter.
+			sum ^= farm.Fingerprint64(buf)
+			return nil
+		})
+	})
+	return sum, err
+}
+
+// iterateLists calls fn with each list whose key starts with prefix, as of readTs.
+func iterateLists(ctx context.Context, prefix []byte, readTs uint64,
+	fn func(key []byte, pl *List) error) error {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = prefix
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	for it.Rewind(); it.Valid(); it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := it.Item().KeyCopy(nil)
+		pl, err := getNew(key, pstore, readTs)
+		if err != nil {
+			return err
+		}
+		if err := fn(key, pl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestCheckPredicate(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		chk_name: string @index(term) .
+		chk_friend: [uid] @reverse .
+	`), 1))
+	ctx := context.Background()
+
+	l, err := GetNoStore(x.DataKey("chk_name", 1), 200)
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{Attr: "chk_name", Entity: 1, Value: []byte("alice bob")},
+		Set, 200, 201, true)
+	l, err = GetNoStore(x.DataKey("chk_friend", 1), 202)
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{Attr: "chk_friend", Entity: 1, ValueId: 4},
+		Set, 202, 203, true)
+
+	problems, err := CheckPredicate(ctx, "chk_name", 210)
+	require.NoError(t, err)
+	require.Empty(t, problems)
+	problems, err = CheckPredicate(ctx, "chk_friend", 210)
+	require.NoError(t, err)
+	require.Empty(t, problems)
+	sum, err := ChecksumPredicate(ctx, "chk_name", 210)
+	require.NoError(t, err)
+
+	// These are written without their index and reverse keys.
+	addEdgeToValue(t, "chk_name", 2, "carol", 211, 212)
+	addEdgeToUID(t, "chk_friend", 2, 5, 213, 214)
+
+	problems, err = CheckPredicate(ctx, "chk_name", 220)
+	require.NoError(t, err)
+	require.Equal(t, []string{`chk_name: index key "\x01carol" is missing, with 1 uids`},
+		problems)
+	problems, err = CheckPredicate(ctx, "chk_friend", 220)
+	require.NoError(t, err)
+	require.Equal(t, []string{"chk_friend: reverse key of 0x5 is missing, with 1 uids"},
+		problems)
+
+	same, err := ChecksumPredicate(ctx, "chk_name", 210)
+	require.NoError(t, err)
+	require.Equal(t, sum, same)
+	changed, err := ChecksumPredicate(ctx, "chk_name", 220)
+	require.NoError(t, err)
+	require.NotEqual(t, sum, changed)
+
+	_, err = CheckPredicate(ctx, "chk_missing", 220)
+	require.Error(t, err)
+}
@@ -16,14 +16,29 @@
 
 package posting
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 // Options contains options for the postings package.
 type Options struct {
 	sync.Mutex
 
 	CommitFraction float64
+
+	// RollupBatchSize is the number of keys read since their last rollup that are batched
+	// before they're rolled up.
+	RollupBatchSize int
+	// RollupInterval is the least time between two rollups of the same key.
+	RollupInterval time.Duration
+	// RollupThrottle is the least time between two full batches of rollups.
+	RollupThrottle time.Duration
 }
 
 // Config stores the posting options of this instance.
-var Config Options
+var Config = Options{
+	RollupBatchSize: 16,
+	RollupInterval:  10 * time.Second,
+	RollupThrottle:  100 * time.Millisecond,
+}
@@ -562,16 +562,19 @@ func (r *rebuilder) Run(ctx context.Context) error {
 
 	// We write the index in a temporary badger first and then,
 	// merge entries before writing them to p directory.
-	// TODO(Aman): If users are not happy, we could add a flag to choose this dir.
-	tmpIndexDir, err := ioutil.TempDir("", "dgraph_index_")
-	if err != nil {
-		return errors.Wrap(err, "error creating temp dir for reindexing")
+	dbOpts := badger.DefaultOptions("").WithInMemory(true)
+	if !x.WorkerConfig.InMemory {
+		// TODO(Aman): If users are not happy, we could add a flag to choose this dir.
+		tmpIndexDir, err := ioutil.TempDir("", "dgraph_index_")
+		if err != nil {
+			return errors.Wrap(err, "error creating temp dir for reindexing")
+		}
+		defer os.RemoveAll(tmpIndexDir)
+		glog.V(1).Infof("Rebuilding indexes using the temp folder %s\n", tmpIndexDir)
+		dbOpts = badger.DefaultOptions(tmpIndexDir)
 	}
-	defer os.RemoveAll(tmpIndexDir)
-	glog.V(1).Infof("Rebuilding indexes using the temp folder %s\n", tmpIndexDir)
 
-	dbOpts := badger.DefaultOptions(tmpIndexDir).
-		WithSyncWrites(false).
+	dbOpts = dbOpts.WithSyncWrites(false).
 		WithNumVersionsToKeep(math.MaxInt32).
 		WithLogger(&x.ToGlog{}).
 		WithCompression(options.None).
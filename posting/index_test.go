@@ -159,7 +159,7 @@ func addMutation(t *testing.T, l *List, edge *pb.DirectedEdge, op uint32,
 
 	txn.Update()
 	writer := NewTxnWriter(pstore)
-	require.NoError(t, txn.CommitToDisk(writer, commitTs))
+	require.NoError(t, txn.CommitToDisk(writer, commitTs, 0))
 	require.NoError(t, writer.Flush())
 }
 
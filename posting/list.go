@@ -77,6 +77,9 @@ type List struct {
 	mutationMap map[uint64]*pb.PostingList
 	minTs       uint64 // commit timestamp of immutable layer, reject reads before this ts.
 	maxTs       uint64 // max commit timestamp seen for this list.
+	// expiresAt is the Unix time at which the last of the versions read for this list that were
+	// written under a @ttl expires, or 0 if there are none. Rollups expire at the same time.
+	expiresAt uint64
 }
 
 // NewList returns a new list with an immutable layer set to plist and the
@@ -816,6 +819,18 @@ func (l *List) Length(readTs, afterUid uint64) int {
 // to be deleted, at which point the entire list will be marked for deletion.
 // As the list grows, existing parts might be split if they become too big.
 func (l *List) Rollup(alloc *z.Allocator) ([]*bpb.KV, error) {
+	return l.rollupKVs(alloc, false)
+}
+
+// RollupDeltas is like Rollup, but of a multi-part list it only returns the main list and the
+// parts that were changed by the deltas since the last rollup. The parts that weren't are left
+// as they are on disk, so it must only be used to write the rollup over the list it was read
+// from, and not to copy the list somewhere else.
+func (l *List) RollupDeltas(alloc *z.Allocator) ([]*bpb.KV, error) {
+	return l.rollupKVs(alloc, true)
+}
+
+func (l *List) rollupKVs(alloc *z.Allocator, onlyChanged bool) ([]*bpb.KV, error) {
 	l.RLock()
 	defer l.RUnlock()
 	out, err := l.rollup(math.MaxUint64, true)
@@ -831,15 +846,22 @@ func (l *List) Rollup(alloc *z.Allocator) ([]*bpb.KV, error) {
 	kv := MarshalPostingList(out.plist, alloc)
 	kv.Version = out.newMinTs
 	kv.Key = alloc.Copy(l.key)
+	kv.ExpiresAt = l.expiresAt
 	kvs = append(kvs, kv)
 
+	// The parts expire with the main list, so the ones of lists that expire are all rewritten.
+	onlyChanged = onlyChanged && out.changed != nil && l.expiresAt == 0
 	for startUid, plist := range out.parts {
+		if onlyChanged && !out.changed[startUid] {
+			continue
+		}
 		// Any empty posting list would still have BitEmpty set. And the main posting list
 		// would NOT have that posting list startUid in the splits list.
 		kv, err := out.marshalPostingListPart(alloc, l.key, startUid, plist)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot marshaling posting list parts")
 		}
+		kv.ExpiresAt = l.expiresAt
 		kvs = append(kvs, kv)
 	}
 
@@ -849,7 +871,9 @@ func (l *List) Rollup(alloc *z.Allocator) ([]*bpb.KV, error) {
 		return bytes.Compare(kvs[i].Key, kvs[j].Key) <= 0
 	})
 
-	x.VerifyPostingSplits(kvs, out.plist, out.parts, l.key)
+	if !onlyChanged {
+		x.VerifyPostingSplits(kvs, out.plist, out.parts, l.key)
+	}
 	return kvs, nil
 }
 
@@ -947,6 +971,9 @@ type rollupOutput struct {
 	plist    *pb.PostingList
 	parts    map[uint64]*pb.PostingList
 	newMinTs uint64
+	// changed has the start UIDs of the parts that differ from the ones on disk. It's nil if
+	// the list wasn't split before, or if all of its parts have to be written anyway.
+	changed map[uint64]bool
 }
 
 func (out *rollupOutput) free() {
@@ -1089,6 +1116,9 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 		for _, mp := range mposts {
 			maxCommitTs = x.Max(maxCommitTs, mp.CommitTs)
 		}
+		if split && len(l.plist.Splits) > 0 && deleteBelowTs == 0 {
+			out.changed = changedParts(l.plist.Splits, mposts)
+		}
 	}
 
 	out.newMinTs = maxCommitTs
@@ -1104,6 +1134,21 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 	return out, nil
 }
 
+// changedParts returns the start UIDs of the parts of a list split at splits that have uids
+// of the postings in mposts.
+func changedParts(splits []uint64, mposts []*pb.Posting) map[uint64]bool {
+	changed := make(map[uint64]bool)
+	for _, mp := range mposts {
+		// The part of mp is the last one that starts at or before its uid.
+		idx := sort.Search(len(splits), func(i int) bool { return splits[i] > mp.Uid }) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		changed[splits[idx]] = true
+	}
+	return changed
+}
+
 // ApproxLen returns an approximate count of the UIDs in the posting list.
 func (l *List) ApproxLen() int {
 	l.RLock()
@@ -1515,6 +1560,9 @@ func (out *rollupOutput) splitUpList() {
 			startUids, pls := binSplit(startUid, list)
 			for i, startUid := range startUids {
 				out.parts[startUid] = pls[i]
+				if out.changed != nil {
+					out.changed[startUid] = true
+				}
 			}
 		}
 	}
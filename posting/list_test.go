@@ -1118,6 +1118,39 @@ func TestMultiPartListWriteToDisk(t *testing.T) {
 	}
 }
 
+func TestMultiPartListRollupDeltas(t *testing.T) {
+	size := int(1e5)
+	ol, commits := createMultiPartList(t, size, false)
+	require.True(t, len(ol.plist.Splits) > 1)
+
+	// Delete the uid 3, which is in the first part of the list.
+	edge := &pb.DirectedEdge{
+		ValueId: 3,
+	}
+	txn := Txn{StartTs: uint64(size) + 1}
+	addMutationHelper(t, ol, edge, Del, &txn)
+	require.NoError(t, ol.commitMutation(uint64(size)+1, uint64(size)+2))
+
+	kvs, err := ol.RollupDeltas(nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(kvs))
+	require.Equal(t, ol.key, kvs[0].Key)
+	firstPart, err := x.SplitKey(ol.key, 1)
+	require.NoError(t, err)
+	require.Equal(t, firstPart, kvs[1].Key)
+
+	require.NoError(t, writePostingListToDisk(kvs))
+	newList, err := getNew(ol.key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, ol.plist.Splits, newList.plist.Splits)
+
+	uids, err := newList.Uids(ListOptions{ReadTs: uint64(size) + 3})
+	require.NoError(t, err)
+	require.Equal(t, commits-1, len(uids.Uids))
+	require.Equal(t, []uint64{1, 2, 4}, uids.Uids[:3])
+	require.Equal(t, uint64(size), uids.Uids[len(uids.Uids)-1])
+}
+
 // Verify that adding and deleting all the entries returns an empty list.
 func TestMultiPartListDelete(t *testing.T) {
 	size := int(1e4)
@@ -29,6 +29,7 @@ import (
 	bpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/ristretto/z"
 	"github.com/golang/glog"
@@ -38,7 +39,8 @@ import (
 
 // incrRollupi is used to batch keys for rollup incrementally.
 type incrRollupi struct {
-	// keysCh is populated with batch of 64 keys that needs to be rolled up during reads
+	// keysCh is populated with batches of Config.RollupBatchSize keys that need to be rolled up
+	// during reads
 	keysCh chan *[][]byte
 	// keysPool is sync.Pool to share the batched keys to rollup.
 	keysPool *sync.Pool
@@ -70,7 +72,7 @@ func (ir *incrRollupi) rollUpKey(writer *TxnWriter, key []byte) error {
 		return err
 	}
 
-	kvs, err := l.Rollup(nil)
+	kvs, err := l.RollupDeltas(nil)
 	if err != nil {
 		return err
 	}
@@ -89,7 +91,7 @@ func (ir *incrRollupi) rollUpKey(writer *TxnWriter, key []byte) error {
 func (ir *incrRollupi) addKeyToBatch(key []byte) {
 	batch := ir.keysPool.Get().(*[][]byte)
 	*batch = append(*batch, key)
-	if len(*batch) < 16 {
+	if len(*batch) < Config.RollupBatchSize {
 		ir.keysPool.Put(batch)
 		return
 	}
@@ -103,7 +105,9 @@ func (ir *incrRollupi) addKeyToBatch(key []byte) {
 	}
 }
 
-// Process will rollup batches of 64 keys in a go routine.
+// Process will rollup batches of Config.RollupBatchSize keys in a go routine. Each key is rolled
+// up at most once every Config.RollupInterval, and full batches at most once every
+// Config.RollupThrottle.
 func (ir *incrRollupi) Process(closer *z.Closer) {
 	defer closer.Done()
 
@@ -111,7 +115,7 @@ func (ir *incrRollupi) Process(closer *z.Closer) {
 	defer writer.Flush()
 
 	m := make(map[uint64]int64) // map hash(key) to ts. hash(key) to limit the size of the map.
-	limiter := time.NewTicker(100 * time.Millisecond)
+	limiter := time.NewTicker(Config.RollupThrottle)
 	defer limiter.Stop()
 	cleanupTick := time.NewTicker(5 * time.Minute)
 	defer cleanupTick.Stop()
@@ -121,12 +125,12 @@ func (ir *incrRollupi) Process(closer *z.Closer) {
 	var batch *[][]byte
 
 	doRollup := func() {
-		currTs := time.Now().Unix()
+		currTs := time.Now().UnixNano()
 		for _, key := range *batch {
 			hash := z.MemHash(key)
-			if elem := m[hash]; currTs-elem >= 10 {
-				// Key not present or Key present but last roll up was more than 10 sec ago.
-				// Add/Update map and rollup.
+			if elem := m[hash]; currTs-elem >= int64(Config.RollupInterval) {
+				// Key not present or Key present but last roll up was more than
+				// Config.RollupInterval ago. Add/Update map and rollup.
 				m[hash] = currTs
 				if err := ir.rollUpKey(writer, key); err != nil {
 					glog.Warningf("Error %v rolling up key %v\n", err, key)
@@ -145,8 +149,9 @@ func (ir *incrRollupi) Process(closer *z.Closer) {
 		case <-cleanupTick.C:
 			currTs := time.Now().UnixNano()
 			for hash, ts := range m {
-				// Remove entries from map which have been there for there more than 10 seconds.
-				if currTs-ts >= int64(10*time.Second) {
+				// Remove entries from map which have been there for more than
+				// Config.RollupInterval.
+				if currTs-ts >= int64(Config.RollupInterval) {
 					delete(m, hash)
 				}
 			}
@@ -159,7 +164,7 @@ func (ir *incrRollupi) Process(closer *z.Closer) {
 			}
 		case batch = <-ir.keysCh:
 			doRollup()
-			// throttle to 1 batch = 64 rollups per 100 ms.
+			// throttle to 1 batch of rollups per Config.RollupThrottle.
 			<-limiter.C
 		}
 	}
@@ -207,7 +212,9 @@ func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32) {
 // This function only stores deltas to the commit timestamps. It does not try to generate a state.
 // State generation is done via rollups, which happen when a snapshot is created.
 // Don't call this for schema mutations. Directly commit them.
-func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64) error {
+// The deltas of predicates with a @ttl expire that long after committedAt, the Unix time at which
+// the commit was proposed, so that every replica writes them with the same expiry.
+func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64, committedAt int64) error {
 	if commitTs == 0 {
 		return nil
 	}
@@ -240,9 +247,10 @@ func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64) error {
 					continue
 				}
 				err := btxn.SetEntry(&badger.Entry{
-					Key:      []byte(key),
-					Value:    data,
-					UserMeta: BitDeltaPosting,
+					Key:       []byte(key),
+					Value:     data,
+					UserMeta:  BitDeltaPosting,
+					ExpiresAt: expiresAt([]byte(key), committedAt),
 				})
 				if err != nil {
 					return err
@@ -257,6 +265,24 @@ func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64) error {
 	return nil
 }
 
+// expiresAt returns the Unix time at which a delta for key committed at committedAt expires, or 0
+// if it doesn't, as set by the @ttl directive of its predicate. Commits from before committedAt was
+// recorded are given the current time.
+func expiresAt(key []byte, committedAt int64) uint64 {
+	pk, err := x.Parse(key)
+	if err != nil || !pk.IsData() {
+		return 0
+	}
+	ttl := schema.State().TTL(pk.Attr)
+	if ttl == 0 {
+		return 0
+	}
+	if committedAt == 0 {
+		committedAt = time.Now().Unix()
+	}
+	return uint64(time.Unix(committedAt, 0).Add(ttl).Unix())
+}
+
 // ResetCache will clear all the cached list.
 func ResetCache() {
 	lCache.Clear()
@@ -343,6 +369,10 @@ func ReadPostingList(key []byte, it *badger.Iterator) (*List, error) {
 			// Don't consider any more versions.
 			break
 		}
+		// The list expires with the last of the versions written under a @ttl, and the versions
+		// that don't expire go with it. Before a rollup, that's also what happens when the newest
+		// version is the one that expires, as the versions below an expired one aren't read.
+		l.expiresAt = x.Max(l.expiresAt, item.ExpiresAt())
 
 		switch item.UserMeta() {
 		case BitEmptyPosting:
@@ -401,10 +431,11 @@ func getNew(key []byte, pstore *badger.DB, readTs uint64) (*List, error) {
 		if ok && l != nil {
 			// No need to clone the immutable layer or the key since mutations will not modify it.
 			lCopy := &List{
-				minTs: l.minTs,
-				maxTs: l.maxTs,
-				key:   key,
-				plist: l.plist,
+				minTs:     l.minTs,
+				maxTs:     l.maxTs,
+				key:       key,
+				plist:     l.plist,
+				expiresAt: l.expiresAt,
 			}
 			if l.mutationMap != nil {
 				lCopy.mutationMap = make(map[uint64]*pb.PostingList, len(l.mutationMap))
@@ -434,6 +465,9 @@ func getNew(key []byte, pstore *badger.DB, readTs uint64) (*List, error) {
 	if err != nil {
 		return l, err
 	}
-	lCache.Set(key, l, 0)
+	// Lists that expire aren't cached, so they aren't read from the cache once they have.
+	if l.expiresAt == 0 {
+		lCache.Set(key, l, 0)
+	}
 	return l, nil
 }
@@ -19,8 +19,11 @@ package posting
 import (
 	"math"
 	"testing"
+	"time"
 
+	bpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
@@ -97,3 +100,54 @@ func TestPostingListRead(t *testing.T) {
 	addEdgeToUID(t, "emptypl", 1, 7, 15, 16)
 	assertLength(17, 3)
 }
+
+func TestExpiresAtCommittedAt(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("session: string @ttl(1h) ."), 1))
+	key := x.DataKey("session", 1)
+
+	// The expiry only depends on the time of the commit, not on when it's applied.
+	committedAt := time.Now().Add(-time.Minute).Unix()
+	require.Equal(t, uint64(committedAt+3600), expiresAt(key, committedAt))
+	require.Equal(t, expiresAt(key, committedAt), expiresAt(key, committedAt))
+
+	require.Zero(t, expiresAt(x.DataKey("rollup", 1), committedAt))
+	require.Zero(t, expiresAt(x.IndexKey("session", "a"), committedAt))
+}
+
+func TestRollupExpiresWithTTLWrites(t *testing.T) {
+	key := x.DataKey("ttlrollup", 1)
+	require.NoError(t, schema.ParseBytes([]byte("ttlrollup: [uid] ."), 1))
+	addEdgeToUID(t, "ttlrollup", 1, 2, 1, 2)
+
+	// A write made once @ttl is set expires the values written before it too, once they're
+	// rolled up together.
+	require.NoError(t, schema.ParseBytes([]byte("ttlrollup: [uid] @ttl(1h) ."), 1))
+	before := time.Now().Add(time.Hour).Unix()
+	addEdgeToUID(t, "ttlrollup", 1, 3, 3, 4)
+	after := time.Now().Add(time.Hour).Unix()
+
+	ResetCache()
+	l, err := getNew(key, pstore, math.MaxUint64)
+	require.NoError(t, err)
+	kvs, err := l.Rollup(nil)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, kvs[0].ExpiresAt, uint64(before))
+	require.LessOrEqual(t, kvs[0].ExpiresAt, uint64(after))
+
+	// The rollup keeps expiring when it's rolled up again with writes that don't expire.
+	writer := NewTxnWriter(pstore)
+	require.NoError(t, writer.Write(&bpb.KVList{Kv: kvs}))
+	require.NoError(t, writer.Flush())
+	require.NoError(t, schema.ParseBytes([]byte("ttlrollup: [uid] ."), 1))
+	addEdgeToUID(t, "ttlrollup", 1, 4, 5, 6)
+
+	ResetCache()
+	l, err = getNew(key, pstore, math.MaxUint64)
+	require.NoError(t, err)
+	uids, err := l.Uids(ListOptions{ReadTs: 7})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3, 4}, uids.Uids)
+	kvs2, err := l.Rollup(nil)
+	require.NoError(t, err)
+	require.Equal(t, kvs[0].ExpiresAt, kvs2[0].ExpiresAt)
+}
@@ -60,7 +60,7 @@ func (w *TxnWriter) Write(kvs *pb.KVList) error {
 		if len(kv.UserMeta) > 0 {
 			meta = kv.UserMeta[0]
 		}
-		if err := w.SetAt(kv.Key, kv.Value, meta, kv.Version); err != nil {
+		if err := w.setAt(kv.Key, kv.Value, meta, kv.Version, kv.ExpiresAt); err != nil {
 			return err
 		}
 	}
@@ -86,22 +86,30 @@ func (w *TxnWriter) update(commitTs uint64, f func(txn *badger.Txn) error) error
 
 // SetAt writes a key-value pair at the given timestamp.
 func (w *TxnWriter) SetAt(key, val []byte, meta byte, ts uint64) error {
+	return w.setAt(key, val, meta, ts, 0)
+}
+
+// setAt writes a key-value pair at the given timestamp, which expires at the given Unix time
+// unless it's 0.
+func (w *TxnWriter) setAt(key, val []byte, meta byte, ts, expiresAt uint64) error {
 	return w.update(ts, func(txn *badger.Txn) error {
 		switch meta {
 		case BitCompletePosting, BitEmptyPosting:
 			err := txn.SetEntry((&badger.Entry{
-				Key:      key,
-				Value:    val,
-				UserMeta: meta,
+				Key:       key,
+				Value:     val,
+				UserMeta:  meta,
+				ExpiresAt: expiresAt,
 			}).WithDiscard())
 			if err != nil {
 				return err
 			}
 		default:
 			err := txn.SetEntry(&badger.Entry{
-				Key:      key,
-				Value:    val,
-				UserMeta: meta,
+				Key:       key,
+				Value:     val,
+				UserMeta:  meta,
+				ExpiresAt: expiresAt,
 			})
 			if err != nil {
 				return err
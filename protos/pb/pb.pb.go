@@ -482,6 +482,7 @@ type Query struct {
 	ReadTs               uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
 	Cache                int32        `protobuf:"varint,14,opt,name=cache,proto3" json:"cache,omitempty"`
 	First                int32        `protobuf:"varint,15,opt,name=first,proto3" json:"first,omitempty"`
+	Quorum               bool         `protobuf:"varint,16,opt,name=quorum,proto3" json:"quorum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -611,6 +612,13 @@ func (m *Query) GetFirst() int32 {
 	return 0
 }
 
+func (m *Query) GetQuorum() bool {
+	if m != nil {
+		return m.Quorum
+	}
+	return false
+}
+
 type ValueList struct {
 	Values               []*TaskValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -869,6 +877,7 @@ type SortMessage struct {
 	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	ReadTs               uint64   `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	Quorum               bool     `protobuf:"varint,14,opt,name=quorum,proto3" json:"quorum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -942,6 +951,13 @@ func (m *SortMessage) GetReadTs() uint64 {
 	return 0
 }
 
+func (m *SortMessage) GetQuorum() bool {
+	if m != nil {
+		return m.Quorum
+	}
+	return false
+}
+
 type SortResult struct {
 	UidMatrix            []*List  `protobuf:"bytes,1,rep,name=uid_matrix,json=uidMatrix,proto3" json:"uid_matrix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1070,6 +1086,7 @@ type Member struct {
 	Leader               bool     `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
 	AmDead               bool     `protobuf:"varint,5,opt,name=am_dead,json=amDead,proto3" json:"amDead,omitempty"`
 	LastUpdate           uint64   `protobuf:"varint,6,opt,name=last_update,json=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	Zone                 string   `protobuf:"bytes,7,opt,name=zone,proto3" json:"zone,omitempty"`
 	ClusterInfoOnly      bool     `protobuf:"varint,13,opt,name=cluster_info_only,json=clusterInfoOnly,proto3" json:"clusterInfoOnly,omitempty"`
 	ForceGroupId         bool     `protobuf:"varint,14,opt,name=force_group_id,json=forceGroupId,proto3" json:"forceGroupId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1152,6 +1169,13 @@ func (m *Member) GetLastUpdate() uint64 {
 	return 0
 }
 
+func (m *Member) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
 func (m *Member) GetClusterInfoOnly() bool {
 	if m != nil {
 		return m.ClusterInfoOnly
@@ -1327,6 +1351,8 @@ type ZeroProposal struct {
 	Cid                  string            `protobuf:"bytes,9,opt,name=cid,proto3" json:"cid,omitempty"`
 	License              *License          `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
 	Snapshot             *ZeroSnapshot     `protobuf:"bytes,11,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Pin                  *Tablet           `protobuf:"bytes,12,opt,name=pin,proto3" json:"pin,omitempty"`
+	SnapshotHolds        map[uint64]int64  `protobuf:"bytes,13,rep,name=snapshot_holds,json=snapshotHolds,proto3" json:"snapshot_holds,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1435,6 +1461,20 @@ func (m *ZeroProposal) GetSnapshot() *ZeroSnapshot {
 	return nil
 }
 
+func (m *ZeroProposal) GetPin() *Tablet {
+	if m != nil {
+		return m.Pin
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetSnapshotHolds() map[uint64]int64 {
+	if m != nil {
+		return m.SnapshotHolds
+	}
+	return nil
+}
+
 // MembershipState is used to pack together the current membership state of all the nodes
 // in the caller server; and the membership updates recorded by the callee server since
 // the provided lastUpdate.
@@ -1448,6 +1488,8 @@ type MembershipState struct {
 	Removed              []*Member          `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
 	Cid                  string             `protobuf:"bytes,8,opt,name=cid,proto3" json:"cid,omitempty"`
 	License              *License           `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
+	Pinned               []*Tablet          `protobuf:"bytes,10,rep,name=pinned,proto3" json:"pinned,omitempty"`
+	SnapshotHolds        map[uint64]int64   `protobuf:"bytes,11,rep,name=snapshot_holds,json=snapshotHolds,proto3" json:"snapshot_holds,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -1549,6 +1591,20 @@ func (m *MembershipState) GetLicense() *License {
 	return nil
 }
 
+func (m *MembershipState) GetPinned() []*Tablet {
+	if m != nil {
+		return m.Pinned
+	}
+	return nil
+}
+
+func (m *MembershipState) GetSnapshotHolds() map[uint64]int64 {
+	if m != nil {
+		return m.SnapshotHolds
+	}
+	return nil
+}
+
 type ConnectionState struct {
 	Member               *Member          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	State                *MembershipState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
@@ -1624,6 +1680,11 @@ type HealthInfo struct {
 	Indexing             []string `protobuf:"bytes,9,rep,name=indexing,proto3" json:"indexing,omitempty"`
 	EeFeatures           []string `protobuf:"bytes,10,rep,name=ee_features,json=eeFeatures,proto3" json:"ee_features,omitempty"`
 	MaxAssigned          uint64   `protobuf:"varint,11,opt,name=max_assigned,json=maxAssigned,proto3" json:"max_assigned,omitempty"`
+	LastAppliedIndex     uint64   `protobuf:"varint,12,opt,name=last_applied_index,json=lastAppliedIndex,proto3" json:"last_applied_index,omitempty"`
+	ProtocolVersion      uint32   `protobuf:"varint,13,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Clock                int64    `protobuf:"varint,14,opt,name=clock,proto3" json:"clock,omitempty"`
+	RaftTerm             uint64   `protobuf:"varint,15,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`
+	RaftLeader           bool     `protobuf:"varint,16,opt,name=raft_leader,json=raftLeader,proto3" json:"raft_leader,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1739,15 +1800,54 @@ func (m *HealthInfo) GetMaxAssigned() uint64 {
 	return 0
 }
 
+func (m *HealthInfo) GetLastAppliedIndex() uint64 {
+	if m != nil {
+		return m.LastAppliedIndex
+	}
+	return 0
+}
+
+func (m *HealthInfo) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *HealthInfo) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *HealthInfo) GetRaftTerm() uint64 {
+	if m != nil {
+		return m.RaftTerm
+	}
+	return 0
+}
+
+func (m *HealthInfo) GetRaftLeader() bool {
+	if m != nil {
+		return m.RaftLeader
+	}
+	return false
+}
+
 type Tablet struct {
 	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
 	Predicate            string   `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
 	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
-	OnDiskBytes          int64    `protobuf:"varint,7,opt,name=on_disk_bytes,json=onDiskBytes,proto3" json:"on_disk_bytes,omitempty"`
+	OnDiskBytes          int64    `protobuf:"varint,7,opt,name=on_disk_bytes,json=onDiskBytes,proto3" json:"onDiskBytes,omitempty"`
 	Remove               bool     `protobuf:"varint,8,opt,name=remove,proto3" json:"remove,omitempty"`
 	ReadOnly             bool     `protobuf:"varint,9,opt,name=read_only,json=readOnly,proto3" json:"readOnly,omitempty"`
 	MoveTs               uint64   `protobuf:"varint,10,opt,name=move_ts,json=moveTs,proto3" json:"moveTs,omitempty"`
-	UncompressedBytes    int64    `protobuf:"varint,11,opt,name=uncompressed_bytes,json=uncompressedBytes,proto3" json:"uncompressed_bytes,omitempty"`
+	UncompressedBytes    int64    `protobuf:"varint,11,opt,name=uncompressed_bytes,json=uncompressedBytes,proto3" json:"uncompressedBytes,omitempty"`
+	KeyCount             int64    `protobuf:"varint,12,opt,name=key_count,json=keyCount,proto3" json:"keyCount,omitempty"`
+	SplitCount           int64    `protobuf:"varint,13,opt,name=split_count,json=splitCount,proto3" json:"splitCount,omitempty"`
+	ReadRate             float64  `protobuf:"fixed64,14,opt,name=read_rate,json=readRate,proto3" json:"readRate,omitempty"`
+	WriteRate            float64  `protobuf:"fixed64,15,opt,name=write_rate,json=writeRate,proto3" json:"writeRate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1842,6 +1942,34 @@ func (m *Tablet) GetUncompressedBytes() int64 {
 	return 0
 }
 
+func (m *Tablet) GetKeyCount() int64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+func (m *Tablet) GetSplitCount() int64 {
+	if m != nil {
+		return m.SplitCount
+	}
+	return 0
+}
+
+func (m *Tablet) GetReadRate() float64 {
+	if m != nil {
+		return m.ReadRate
+	}
+	return 0
+}
+
+func (m *Tablet) GetWriteRate() float64 {
+	if m != nil {
+		return m.WriteRate
+	}
+	return 0
+}
+
 type DirectedEdge struct {
 	Entity               uint64          `protobuf:"fixed64,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	Attr                 string          `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
@@ -2119,7 +2247,10 @@ type Snapshot struct {
 	// done is used to indicate that snapshot stream was a success.
 	Done bool `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
 	// since_ts stores the ts of the last snapshot to support diff snap updates.
-	SinceTs              uint64   `protobuf:"varint,5,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	SinceTs uint64 `protobuf:"varint,5,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	// resume_ranges are the key ranges that the follower already has, as pairs of start and
+	// end keys, so that the leader doesn't send them again when a stream is resumed.
+	ResumeRanges         [][]byte `protobuf:"bytes,6,rep,name=resume_ranges,json=resumeRanges,proto3" json:"resume_ranges,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2193,6 +2324,13 @@ func (m *Snapshot) GetSinceTs() uint64 {
 	return 0
 }
 
+func (m *Snapshot) GetResumeRanges() [][]byte {
+	if m != nil {
+		return m.ResumeRanges
+	}
+	return nil
+}
+
 type ZeroSnapshot struct {
 	Index                uint64           `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	CheckpointTs         uint64           `protobuf:"varint,2,opt,name=checkpoint_ts,json=checkpointTs,proto3" json:"checkpoint_ts,omitempty"`
@@ -2427,18 +2565,21 @@ func (m *RestoreRequest) GetBackupNum() uint64 {
 }
 
 type Proposal struct {
-	Mutations            *Mutations       `protobuf:"bytes,2,opt,name=mutations,proto3" json:"mutations,omitempty"`
-	Kv                   []*pb.KV         `protobuf:"bytes,4,rep,name=kv,proto3" json:"kv,omitempty"`
-	State                *MembershipState `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
-	CleanPredicate       string           `protobuf:"bytes,6,opt,name=clean_predicate,json=cleanPredicate,proto3" json:"clean_predicate,omitempty"`
-	Delta                *OracleDelta     `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
-	Snapshot             *Snapshot        `protobuf:"bytes,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	Index                uint64           `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`
-	ExpectedChecksum     uint64           `protobuf:"varint,11,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
-	Restore              *RestoreRequest  `protobuf:"bytes,12,opt,name=restore,proto3" json:"restore,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Mutations        *Mutations       `protobuf:"bytes,2,opt,name=mutations,proto3" json:"mutations,omitempty"`
+	Kv               []*pb.KV         `protobuf:"bytes,4,rep,name=kv,proto3" json:"kv,omitempty"`
+	State            *MembershipState `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	CleanPredicate   string           `protobuf:"bytes,6,opt,name=clean_predicate,json=cleanPredicate,proto3" json:"clean_predicate,omitempty"`
+	Delta            *OracleDelta     `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
+	Snapshot         *Snapshot        `protobuf:"bytes,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Index            uint64           `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`
+	ExpectedChecksum uint64           `protobuf:"varint,11,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
+	Restore          *RestoreRequest  `protobuf:"bytes,12,opt,name=restore,proto3" json:"restore,omitempty"`
+	Batch            [][]byte         `protobuf:"bytes,13,rep,name=batch,proto3" json:"batch,omitempty"`
+	// Unix time at which the proposal was made, so that all the replicas apply it the same way.
+	ProposedAt           int64    `protobuf:"varint,14,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -2537,6 +2678,20 @@ func (m *Proposal) GetRestore() *RestoreRequest {
 	return nil
 }
 
+func (m *Proposal) GetBatch() [][]byte {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *Proposal) GetProposedAt() int64 {
+	if m != nil {
+		return m.ProposedAt
+	}
+	return 0
+}
+
 type KVS struct {
 	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 	// done used to indicate if the stream of KVS is over.
@@ -3353,6 +3508,7 @@ type SchemaNode struct {
 	Upsert               bool     `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	Lang                 bool     `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
 	NoConflict           bool     `protobuf:"varint,10,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Ttl                  uint64   `protobuf:"varint,11,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3461,6 +3617,13 @@ func (m *SchemaNode) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaNode) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type SchemaResult struct {
 	Schema               []*SchemaNode `protobuf:"bytes,1,rep,name=schema,proto3" json:"schema,omitempty"` // Deprecated: Do not use.
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -3523,8 +3686,11 @@ type SchemaUpdate struct {
 	NonNullableList bool `protobuf:"varint,11,opt,name=non_nullable_list,json=nonNullableList,proto3" json:"non_nullable_list,omitempty"`
 	// If value_type is OBJECT, then this represents an object type with a
 	// custom name. This field stores said name.
-	ObjectTypeName       string   `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
-	NoConflict           bool     `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	ObjectTypeName string `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
+	NoConflict     bool   `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	// ttl is how many seconds the values of the predicate live after they were last written, or 0
+	// if they don't expire.
+	Ttl                  uint64   `protobuf:"varint,14,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3647,6 +3813,13 @@ func (m *SchemaUpdate) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaUpdate) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type TypeUpdate struct {
 	TypeName             string          `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	Fields               []*SchemaUpdate `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
@@ -3755,6 +3928,7 @@ type MovePredicatePayload struct {
 	DestGid              uint32   `protobuf:"varint,3,opt,name=dest_gid,json=destGid,proto3" json:"dest_gid,omitempty"`
 	TxnTs                uint64   `protobuf:"varint,4,opt,name=txn_ts,json=txnTs,proto3" json:"txn_ts,omitempty"`
 	ExpectedChecksum     uint64   `protobuf:"varint,5,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
+	Bandwidth            uint64   `protobuf:"varint,6,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3828,6 +4002,13 @@ func (m *MovePredicatePayload) GetExpectedChecksum() uint64 {
 	return 0
 }
 
+func (m *MovePredicatePayload) GetBandwidth() uint64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
 type TxnStatus struct {
 	StartTs              uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 	CommitTs             uint64   `protobuf:"varint,2,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
@@ -5143,6 +5324,7 @@ func init() {
 	proto.RegisterType((*License)(nil), "pb.License")
 	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
 	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "pb.ZeroProposal.SnapshotHoldsEntry")
 	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
 	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
 	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
@@ -5201,311 +5383,333 @@ func init() {
 func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
 
 var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 4853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0xcd, 0x6f, 0x1c, 0x57,
-	0x72, 0xb8, 0xa6, 0xe7, 0xb3, 0x6b, 0x66, 0xa8, 0xd1, 0x93, 0x56, 0x9e, 0x1d, 0xd9, 0x22, 0xdd,
-	0xb2, 0xd6, 0x5c, 0xcb, 0xa2, 0x24, 0x7a, 0x7f, 0xf8, 0xad, 0xbd, 0x08, 0x10, 0x52, 0x1c, 0xca,
-	0xb4, 0x28, 0x92, 0xdb, 0x1c, 0x6a, 0x3f, 0x0e, 0x19, 0x34, 0xbb, 0x1f, 0xc9, 0x5e, 0xf6, 0x74,
-	0xf7, 0x76, 0xf7, 0x70, 0x49, 0xdf, 0x82, 0x00, 0x41, 0x0e, 0xc9, 0x29, 0x87, 0xec, 0x29, 0x01,
-	0xf2, 0x0f, 0x04, 0xc9, 0x29, 0x08, 0x90, 0x4b, 0x10, 0x04, 0x41, 0x0e, 0x41, 0xfe, 0x81, 0x28,
-	0x81, 0x93, 0x93, 0x80, 0x5c, 0x12, 0x20, 0x40, 0x6e, 0x41, 0x55, 0xbd, 0xfe, 0x1a, 0x0e, 0x25,
-	0x7b, 0x81, 0x3d, 0xe4, 0x34, 0xaf, 0xaa, 0xde, 0x67, 0x55, 0xbd, 0xfa, 0x7a, 0x3d, 0xd0, 0x0a,
-	0x0f, 0x57, 0xc2, 0x28, 0x48, 0x02, 0xa1, 0x85, 0x87, 0x03, 0xdd, 0x0a, 0x5d, 0x06, 0x07, 0x1f,
-	0x1d, 0xbb, 0xc9, 0xc9, 0xf4, 0x70, 0xc5, 0x0e, 0x26, 0x8f, 0x9c, 0xe3, 0xc8, 0x0a, 0x4f, 0x1e,
-	0xba, 0xc1, 0xa3, 0x43, 0xcb, 0x39, 0x96, 0xd1, 0xa3, 0xb3, 0xd5, 0x47, 0xe1, 0xe1, 0xa3, 0x74,
-	0xe8, 0xe0, 0x61, 0xa1, 0xef, 0x71, 0x70, 0x1c, 0x3c, 0x22, 0xf4, 0xe1, 0xf4, 0x88, 0x20, 0x02,
-	0xa8, 0xc5, 0xdd, 0x8d, 0x01, 0xd4, 0xb6, 0xdd, 0x38, 0x11, 0x02, 0x6a, 0x53, 0xd7, 0x89, 0xfb,
-	0x95, 0xa5, 0xea, 0x72, 0xc3, 0xa4, 0xb6, 0xf1, 0x02, 0xf4, 0x91, 0x15, 0x9f, 0xbe, 0xb4, 0xbc,
-	0xa9, 0x14, 0x3d, 0xa8, 0x9e, 0x59, 0x5e, 0xbf, 0xb2, 0x54, 0x59, 0xee, 0x98, 0xd8, 0x14, 0x2b,
-	0xd0, 0x3a, 0xb3, 0xbc, 0x71, 0x72, 0x11, 0xca, 0xbe, 0xb6, 0x54, 0x59, 0x5e, 0x58, 0xbd, 0xb9,
-	0x12, 0x1e, 0xae, 0xec, 0x05, 0x71, 0xe2, 0xfa, 0xc7, 0x2b, 0x2f, 0x2d, 0x6f, 0x74, 0x11, 0x4a,
-	0xb3, 0x79, 0xc6, 0x0d, 0x63, 0x17, 0xda, 0xfb, 0x91, 0xbd, 0x39, 0xf5, 0xed, 0xc4, 0x0d, 0x7c,
-	0x5c, 0xd1, 0xb7, 0x26, 0x92, 0x66, 0xd4, 0x4d, 0x6a, 0x23, 0xce, 0x8a, 0x8e, 0xe3, 0x7e, 0x75,
-	0xa9, 0x8a, 0x38, 0x6c, 0x8b, 0x3e, 0x34, 0xdd, 0xf8, 0x69, 0x30, 0xf5, 0x93, 0x7e, 0x6d, 0xa9,
-	0xb2, 0xdc, 0x32, 0x53, 0xd0, 0xf8, 0x93, 0x2a, 0xd4, 0x7f, 0x38, 0x95, 0xd1, 0x05, 0x8d, 0x4b,
-	0x92, 0x28, 0x9d, 0x0b, 0xdb, 0xe2, 0x16, 0xd4, 0x3d, 0xcb, 0x3f, 0x8e, 0xfb, 0x1a, 0x4d, 0xc6,
-	0x80, 0xb8, 0x03, 0xba, 0x75, 0x94, 0xc8, 0x68, 0x3c, 0x75, 0x9d, 0x7e, 0x75, 0xa9, 0xb2, 0xdc,
-	0x30, 0x5b, 0x84, 0x38, 0x70, 0x1d, 0xf1, 0x6d, 0x68, 0x39, 0xc1, 0xd8, 0x2e, 0xae, 0xe5, 0x04,
-	0xb4, 0x96, 0xb8, 0x07, 0xad, 0xa9, 0xeb, 0x8c, 0x3d, 0x37, 0x4e, 0xfa, 0xf5, 0xa5, 0xca, 0x72,
-	0x7b, 0xb5, 0x85, 0x87, 0x45, 0xde, 0x99, 0xcd, 0xa9, 0xeb, 0x10, 0x13, 0x3f, 0x82, 0x56, 0x1c,
-	0xd9, 0xe3, 0xa3, 0xa9, 0x6f, 0xf7, 0x1b, 0xd4, 0xe9, 0x3a, 0x76, 0x2a, 0x9c, 0xda, 0x6c, 0xc6,
-	0x0c, 0xe0, 0xb1, 0x22, 0x79, 0x26, 0xa3, 0x58, 0xf6, 0x9b, 0xbc, 0x94, 0x02, 0xc5, 0x63, 0x68,
-	0x1f, 0x59, 0xb6, 0x4c, 0xc6, 0xa1, 0x15, 0x59, 0x93, 0x7e, 0x2b, 0x9f, 0x68, 0x13, 0xd1, 0x7b,
-	0x88, 0x8d, 0x4d, 0x38, 0xca, 0x00, 0xf1, 0x09, 0x74, 0x09, 0x8a, 0xc7, 0x47, 0xae, 0x97, 0xc8,
-	0xa8, 0xaf, 0xd3, 0x98, 0x05, 0x1a, 0x43, 0x98, 0x51, 0x24, 0xa5, 0xd9, 0xe1, 0x4e, 0x8c, 0x11,
-	0xef, 0x01, 0xc8, 0xf3, 0xd0, 0xf2, 0x9d, 0xb1, 0xe5, 0x79, 0x7d, 0xa0, 0x3d, 0xe8, 0x8c, 0x59,
-	0xf3, 0x3c, 0xf1, 0x0e, 0xee, 0xcf, 0x72, 0xc6, 0x49, 0xdc, 0xef, 0x2e, 0x55, 0x96, 0x6b, 0x66,
-	0x03, 0xc1, 0x51, 0x8c, 0x7c, 0xb5, 0x2d, 0xfb, 0x44, 0xf6, 0x17, 0x96, 0x2a, 0xcb, 0x75, 0x93,
-	0x01, 0xc4, 0x1e, 0xb9, 0x51, 0x9c, 0xf4, 0xaf, 0x33, 0x96, 0x00, 0x63, 0x15, 0x74, 0xd2, 0x1e,
-	0xe2, 0xce, 0x7d, 0x68, 0x9c, 0x21, 0xc0, 0x4a, 0xd6, 0x5e, 0xed, 0xe2, 0xf6, 0x32, 0x05, 0x33,
-	0x15, 0xd1, 0xb8, 0x0b, 0xad, 0x6d, 0xcb, 0x3f, 0x4e, 0xb5, 0x12, 0xc5, 0x46, 0x03, 0x74, 0x93,
-	0xda, 0xc6, 0x2f, 0x35, 0x68, 0x98, 0x32, 0x9e, 0x7a, 0x89, 0xf8, 0x10, 0x00, 0x85, 0x32, 0xb1,
-	0x92, 0xc8, 0x3d, 0x57, 0xb3, 0xe6, 0x62, 0xd1, 0xa7, 0xae, 0xf3, 0x82, 0x48, 0xe2, 0x31, 0x74,
-	0x68, 0xf6, 0xb4, 0xab, 0x96, 0x6f, 0x20, 0xdb, 0x9f, 0xd9, 0xa6, 0x2e, 0x6a, 0xc4, 0x6d, 0x68,
-	0x90, 0x1e, 0xb0, 0x2e, 0x76, 0x4d, 0x05, 0x89, 0xfb, 0xb0, 0xe0, 0xfa, 0x09, 0xca, 0xc9, 0x4e,
-	0xc6, 0x8e, 0x8c, 0x53, 0x45, 0xe9, 0x66, 0xd8, 0x0d, 0x19, 0x27, 0xe2, 0x09, 0x30, 0xb3, 0xd3,
-	0x05, 0xeb, 0xb4, 0xe0, 0x42, 0x26, 0xc4, 0x98, 0x57, 0xa4, 0x3e, 0x6a, 0xc5, 0x87, 0xd0, 0xc6,
-	0xf3, 0xa5, 0x23, 0x1a, 0x34, 0xa2, 0x43, 0xa7, 0x51, 0xec, 0x30, 0x01, 0x3b, 0xa8, 0xee, 0xc8,
-	0x1a, 0x54, 0x46, 0x56, 0x1e, 0x6a, 0x1b, 0x43, 0xa8, 0xef, 0x46, 0x8e, 0x8c, 0xe6, 0xde, 0x07,
-	0x01, 0x35, 0x47, 0xc6, 0x36, 0x5d, 0xd5, 0x96, 0x49, 0xed, 0xfc, 0x8e, 0x54, 0x0b, 0x77, 0xc4,
-	0xf8, 0xe3, 0x0a, 0xb4, 0xf7, 0x83, 0x28, 0x79, 0x21, 0xe3, 0xd8, 0x3a, 0x96, 0x62, 0x11, 0xea,
-	0x01, 0x4e, 0xab, 0x38, 0xac, 0xe3, 0x9e, 0x68, 0x1d, 0x93, 0xf1, 0x33, 0x72, 0xd0, 0xae, 0x96,
-	0x03, 0xea, 0x0e, 0xdd, 0xae, 0xaa, 0xd2, 0x1d, 0xba, 0x5b, 0xb7, 0xa1, 0x11, 0x1c, 0x1d, 0xc5,
-	0x92, 0x79, 0x59, 0x37, 0x15, 0x74, 0xa5, 0x0a, 0x1a, 0xff, 0x0f, 0x00, 0xf7, 0xf7, 0x0d, 0xb5,
-	0xc0, 0x38, 0x81, 0xb6, 0x69, 0x1d, 0x25, 0x4f, 0x03, 0x3f, 0x91, 0xe7, 0x89, 0x58, 0x00, 0xcd,
-	0x75, 0x88, 0x45, 0x0d, 0x53, 0x73, 0x1d, 0xdc, 0xdc, 0x71, 0x14, 0x4c, 0x43, 0xe2, 0x50, 0xd7,
-	0x64, 0x80, 0x58, 0xe9, 0x38, 0x11, 0xed, 0x18, 0x59, 0xe9, 0x38, 0x91, 0x58, 0x84, 0x76, 0xec,
-	0x5b, 0x61, 0x7c, 0x12, 0x24, 0xb8, 0xb9, 0x1a, 0x6d, 0x0e, 0x52, 0xd4, 0x28, 0x36, 0xfe, 0x43,
-	0x83, 0xc6, 0x0b, 0x39, 0x39, 0x94, 0xd1, 0xa5, 0x55, 0x1e, 0x43, 0x8b, 0x26, 0x1e, 0xbb, 0x0e,
-	0x2f, 0xb4, 0xfe, 0xad, 0xd7, 0xaf, 0x16, 0x6f, 0x10, 0x6e, 0xcb, 0xf9, 0x38, 0x98, 0xb8, 0x89,
-	0x9c, 0x84, 0xc9, 0x85, 0xd9, 0x54, 0xa8, 0xb9, 0x3b, 0xb8, 0x0d, 0x0d, 0x4f, 0x5a, 0x28, 0x13,
-	0x56, 0x3f, 0x05, 0x89, 0x87, 0xd0, 0xb4, 0x26, 0x63, 0x47, 0x5a, 0x0e, 0x59, 0xa9, 0xd6, 0xfa,
-	0xad, 0xd7, 0xaf, 0x16, 0x7b, 0xd6, 0x64, 0x43, 0x5a, 0xc5, 0xb9, 0x1b, 0x8c, 0x11, 0x9f, 0xa2,
-	0xce, 0xc5, 0xc9, 0x78, 0x1a, 0x3a, 0x56, 0x22, 0xc9, 0x66, 0xd5, 0xd6, 0xfb, 0xaf, 0x5f, 0x2d,
-	0xde, 0x42, 0xf4, 0x01, 0x61, 0x0b, 0xc3, 0x20, 0xc7, 0x8a, 0x2d, 0xb8, 0x61, 0x7b, 0xd3, 0x18,
-	0x4d, 0xa9, 0xeb, 0x1f, 0x05, 0xe3, 0xc0, 0xf7, 0x2e, 0x48, 0x4c, 0xad, 0xf5, 0xf7, 0x5e, 0xbf,
-	0x5a, 0xfc, 0xb6, 0x22, 0x6e, 0xf9, 0x47, 0xc1, 0xae, 0xef, 0x5d, 0x14, 0x66, 0xb9, 0x3e, 0x43,
-	0x12, 0xbf, 0x09, 0x0b, 0x47, 0x41, 0x64, 0xcb, 0x71, 0xc6, 0x98, 0x05, 0x9a, 0x67, 0xf0, 0xfa,
-	0xd5, 0xe2, 0x6d, 0xa2, 0x3c, 0xbb, 0xc4, 0x9d, 0x4e, 0x11, 0x6f, 0xfc, 0xb3, 0x06, 0x75, 0x6a,
-	0x8b, 0xc7, 0xd0, 0x9c, 0x10, 0xe3, 0x53, 0x2b, 0x73, 0x1b, 0x35, 0x81, 0x68, 0x2b, 0x2c, 0x91,
-	0x78, 0xe8, 0x27, 0xd1, 0x85, 0x99, 0x76, 0xc3, 0x11, 0x89, 0x75, 0xe8, 0xc9, 0x24, 0x56, 0x9a,
-	0x5b, 0x18, 0x31, 0x62, 0x82, 0x1a, 0xa1, 0xba, 0xcd, 0x8a, 0xbf, 0x3a, 0x2b, 0x7e, 0x31, 0x80,
-	0x96, 0x7d, 0x22, 0xed, 0xd3, 0x78, 0x3a, 0x51, 0xca, 0x91, 0xc1, 0xe2, 0x1e, 0x74, 0xa9, 0x1d,
-	0x06, 0xae, 0x4f, 0xc3, 0xeb, 0xd4, 0xa1, 0x93, 0x23, 0x47, 0xf1, 0x60, 0x13, 0x3a, 0xc5, 0xcd,
-	0xa2, 0xf3, 0x3d, 0x95, 0x17, 0xa4, 0x45, 0x35, 0x13, 0x9b, 0x62, 0x09, 0xea, 0x64, 0xae, 0x48,
-	0x87, 0xda, 0xab, 0x80, 0x7b, 0xe6, 0x21, 0x26, 0x13, 0x3e, 0xd3, 0xbe, 0x5f, 0xc1, 0x79, 0x8a,
-	0x47, 0x28, 0xce, 0xa3, 0x5f, 0x3d, 0x0f, 0x0f, 0x29, 0xcc, 0x63, 0x04, 0xd0, 0xdc, 0x76, 0x6d,
-	0xe9, 0xc7, 0xe4, 0xa2, 0xa7, 0xb1, 0xcc, 0x4c, 0x0b, 0xb6, 0xf1, 0xbc, 0x13, 0xeb, 0x7c, 0x27,
-	0x70, 0x64, 0x4c, 0xf3, 0xd4, 0xcc, 0x0c, 0x46, 0x9a, 0x3c, 0x0f, 0xdd, 0xe8, 0x62, 0xc4, 0x9c,
-	0xaa, 0x9a, 0x19, 0x8c, 0x3e, 0x50, 0xfa, 0xb8, 0x98, 0x93, 0xba, 0x5b, 0x05, 0x1a, 0x7f, 0x53,
-	0x85, 0xce, 0x4f, 0x65, 0x14, 0xec, 0x45, 0x41, 0x18, 0xc4, 0x96, 0x27, 0xd6, 0xca, 0x3c, 0x67,
-	0xd9, 0x2e, 0xe1, 0x6e, 0x8b, 0xdd, 0x56, 0xf6, 0x33, 0x21, 0xb0, 0xcc, 0x8a, 0x52, 0x31, 0xa0,
-	0xc1, 0x32, 0x9f, 0xc3, 0x33, 0x45, 0xc1, 0x3e, 0x2c, 0x65, 0xda, 0x6b, 0x99, 0x1f, 0x8a, 0x22,
-	0xee, 0x02, 0x4c, 0xac, 0xf3, 0x6d, 0x69, 0xc5, 0x72, 0xcb, 0x49, 0x2f, 0x7f, 0x8e, 0x51, 0xdc,
-	0x18, 0x9d, 0xfb, 0xa3, 0x54, 0xb8, 0x19, 0x2c, 0xde, 0x05, 0x7d, 0x62, 0x9d, 0xa3, 0x15, 0xda,
-	0x72, 0xf8, 0xba, 0x99, 0x39, 0x42, 0xbc, 0x0f, 0xd5, 0xe4, 0xdc, 0x27, 0x93, 0x8e, 0x1e, 0x1f,
-	0x03, 0xc0, 0xd1, 0xb9, 0xaf, 0xec, 0x95, 0x89, 0x34, 0x94, 0xa0, 0xed, 0x3a, 0xe4, 0xe0, 0x75,
-	0x13, 0x9b, 0xe2, 0x3e, 0x34, 0x3d, 0x96, 0x0d, 0x39, 0xf1, 0xf6, 0x6a, 0x9b, 0x6d, 0x1f, 0xa1,
-	0xcc, 0x94, 0x26, 0x3e, 0x86, 0x56, 0xca, 0x8b, 0x7e, 0x9b, 0xfa, 0xf5, 0x52, 0xee, 0xa5, 0x4c,
-	0x33, 0xb3, 0x1e, 0x83, 0xdf, 0x80, 0xeb, 0x33, 0xac, 0x2c, 0xea, 0x4e, 0x97, 0x75, 0xe7, 0x56,
-	0x51, 0x77, 0x6a, 0x05, 0x7d, 0xf9, 0xa2, 0xd6, 0x6a, 0xf5, 0x74, 0xe3, 0x5f, 0xaa, 0x70, 0x5d,
-	0xa9, 0xf1, 0x89, 0x1b, 0xee, 0x27, 0x68, 0x36, 0xfa, 0xd0, 0x24, 0xa3, 0xaf, 0x34, 0xa8, 0x66,
-	0xa6, 0xa0, 0xf8, 0xff, 0xd0, 0xa0, 0xfb, 0x9f, 0x5e, 0xc3, 0xc5, 0x5c, 0x3c, 0xd9, 0x70, 0xbe,
-	0x96, 0x4a, 0xb6, 0xaa, 0xbb, 0xf8, 0x1e, 0xd4, 0xbf, 0x94, 0x51, 0xc0, 0x4e, 0xac, 0xbd, 0x7a,
-	0x77, 0xde, 0x38, 0x3c, 0xa6, 0x1a, 0xc6, 0x9d, 0x7f, 0x8d, 0x52, 0xfc, 0x00, 0xdd, 0xd6, 0x24,
-	0x38, 0x93, 0x4e, 0xbf, 0x49, 0x3b, 0x2a, 0x2a, 0x5a, 0x4a, 0x4a, 0x05, 0xd9, 0x9a, 0x2b, 0x48,
-	0xfd, 0x6a, 0x41, 0x0e, 0x36, 0xa0, 0x5d, 0xe0, 0xc2, 0x1c, 0xb1, 0x2c, 0x96, 0xaf, 0xb4, 0x9e,
-	0x99, 0xb3, 0xa2, 0x65, 0xd8, 0x00, 0xc8, 0x79, 0xf2, 0xab, 0xda, 0x17, 0xe3, 0xb7, 0x2b, 0x70,
-	0xfd, 0x69, 0xe0, 0xfb, 0x92, 0x82, 0x5b, 0x96, 0x70, 0x7e, 0xcd, 0x2a, 0x57, 0x5e, 0xb3, 0xef,
-	0x42, 0x3d, 0xc6, 0xce, 0x6a, 0xf6, 0x9b, 0x73, 0x44, 0x66, 0x72, 0x0f, 0x34, 0xb6, 0x13, 0xeb,
-	0x7c, 0x1c, 0x4a, 0xdf, 0x71, 0xfd, 0xe3, 0xd4, 0xd8, 0x4e, 0xac, 0xf3, 0x3d, 0xc6, 0x18, 0x7f,
-	0xa9, 0x01, 0x7c, 0x2e, 0x2d, 0x2f, 0x39, 0x41, 0x87, 0x82, 0x72, 0x73, 0xfd, 0x38, 0xb1, 0x7c,
-	0x3b, 0x4d, 0x2d, 0x32, 0x18, 0x95, 0x0f, 0xbd, 0xa7, 0x8c, 0xd9, 0x4c, 0xe9, 0x66, 0x0a, 0xa2,
-	0x3f, 0xc5, 0xe5, 0xa6, 0xb1, 0xf2, 0xb2, 0x0a, 0xca, 0x63, 0x82, 0x1a, 0xa1, 0x55, 0x4c, 0xd0,
-	0x87, 0x26, 0x86, 0xea, 0x6e, 0xe0, 0x93, 0x6a, 0xe8, 0x66, 0x0a, 0xe2, 0x3c, 0xd3, 0x30, 0x71,
-	0x27, 0xec, 0x4b, 0xab, 0xa6, 0x82, 0x70, 0x57, 0xe8, 0x3b, 0x87, 0xf6, 0x49, 0x40, 0xd7, 0xbb,
-	0x6a, 0x66, 0x30, 0xce, 0x16, 0xf8, 0xc7, 0x01, 0x9e, 0xae, 0x45, 0x61, 0x58, 0x0a, 0xf2, 0x59,
-	0x1c, 0x79, 0x8e, 0x24, 0x9d, 0x48, 0x19, 0x8c, 0x7c, 0x91, 0x72, 0x7c, 0x24, 0xad, 0x64, 0x1a,
-	0xc9, 0xb8, 0x0f, 0x44, 0x06, 0x29, 0x37, 0x15, 0x46, 0xbc, 0x0f, 0x1d, 0x64, 0x9c, 0x15, 0xc7,
-	0xee, 0xb1, 0x2f, 0x1d, 0xba, 0xf4, 0x35, 0x13, 0x99, 0xb9, 0xa6, 0x50, 0xc6, 0x5f, 0x6b, 0xd0,
-	0x60, 0xe3, 0x56, 0x0a, 0x4b, 0x2a, 0x5f, 0x2b, 0x2c, 0x79, 0x17, 0xf4, 0x30, 0x92, 0x8e, 0x6b,
-	0xa7, 0x72, 0xd4, 0xcd, 0x1c, 0x41, 0xf9, 0x00, 0x7a, 0x68, 0xe2, 0x67, 0xcb, 0x64, 0x40, 0x18,
-	0xd0, 0x0d, 0xfc, 0xb1, 0xe3, 0xc6, 0xa7, 0xe3, 0xc3, 0x8b, 0x44, 0xc6, 0x8a, 0x17, 0xed, 0xc0,
-	0xdf, 0x70, 0xe3, 0xd3, 0x75, 0x44, 0x21, 0x0b, 0xf9, 0x8e, 0xd0, 0xdd, 0x68, 0x99, 0x0a, 0x12,
-	0x9f, 0x80, 0x4e, 0xd1, 0x20, 0x05, 0x1a, 0x3a, 0x05, 0x08, 0xb7, 0x5f, 0xbf, 0x5a, 0x14, 0x88,
-	0x9c, 0x89, 0x30, 0x5a, 0x29, 0x0e, 0xe3, 0x21, 0x1c, 0x8c, 0x2e, 0x03, 0x28, 0xb8, 0xa1, 0x78,
-	0x08, 0x51, 0xa3, 0xb8, 0x18, 0x0f, 0x31, 0x46, 0x3c, 0x04, 0x31, 0xf5, 0xed, 0x60, 0x12, 0xa2,
-	0x52, 0x48, 0x47, 0x6d, 0xb2, 0x4d, 0x9b, 0xbc, 0x51, 0xa4, 0xd0, 0x56, 0x8d, 0x7f, 0xd4, 0xa0,
-	0xb3, 0xe1, 0x46, 0xd2, 0x4e, 0xa4, 0x33, 0x74, 0x8e, 0x25, 0xee, 0x5d, 0xfa, 0x89, 0x9b, 0x5c,
-	0xa8, 0x80, 0x4f, 0x41, 0x59, 0x3c, 0xae, 0x95, 0xf3, 0x53, 0xbe, 0x61, 0x55, 0x4a, 0xa9, 0x19,
-	0x10, 0xab, 0x00, 0x9c, 0xa9, 0x50, 0x5a, 0x5d, 0xbb, 0x3a, 0xad, 0xd6, 0xa9, 0x1b, 0x36, 0x31,
-	0x6d, 0xe5, 0x31, 0x2e, 0x47, 0x7d, 0x0d, 0xca, 0xb9, 0xa7, 0x68, 0xc5, 0x28, 0xc0, 0x3f, 0x94,
-	0x1e, 0xa9, 0x23, 0x05, 0xf8, 0x87, 0xd2, 0xcb, 0xd2, 0xaa, 0x26, 0x6f, 0x07, 0xdb, 0xe2, 0x1e,
-	0x68, 0x41, 0x48, 0x2c, 0x57, 0x0b, 0x16, 0x0f, 0xb6, 0xb2, 0x1b, 0x9a, 0x5a, 0x10, 0xe2, 0xdd,
-	0xe6, 0x1c, 0x92, 0xd4, 0x11, 0xef, 0x36, 0xfa, 0x28, 0xca, 0x68, 0x4c, 0x45, 0x11, 0x06, 0x74,
-	0x2c, 0xcf, 0x0b, 0x7e, 0x21, 0x9d, 0xbd, 0x48, 0x3a, 0xa9, 0x66, 0x96, 0x70, 0xc6, 0x6d, 0xd0,
-	0x76, 0x43, 0xd1, 0x84, 0xea, 0xfe, 0x70, 0xd4, 0xbb, 0x86, 0x8d, 0x8d, 0xe1, 0x76, 0xaf, 0x62,
-	0x7c, 0xa5, 0x81, 0xfe, 0x62, 0x9a, 0x58, 0x68, 0x4d, 0x62, 0x3c, 0x57, 0x59, 0x27, 0x73, 0xe5,
-	0xfb, 0x36, 0xb4, 0xe2, 0xc4, 0x8a, 0x28, 0x16, 0x60, 0xef, 0xd3, 0x24, 0x78, 0x14, 0x8b, 0xef,
-	0x40, 0x5d, 0x3a, 0xc7, 0x32, 0x75, 0x07, 0xbd, 0xd9, 0xb3, 0x98, 0x4c, 0x16, 0xcb, 0xd0, 0x88,
-	0xed, 0x13, 0x39, 0xb1, 0xfa, 0xb5, 0xbc, 0xe3, 0x3e, 0x61, 0x38, 0xc4, 0x35, 0x15, 0x5d, 0x7c,
-	0x00, 0x75, 0x94, 0x46, 0xac, 0x72, 0x32, 0xca, 0xe2, 0x90, 0xf1, 0xaa, 0x1b, 0x13, 0x51, 0xd5,
-	0x9c, 0x28, 0x08, 0xc7, 0x41, 0x48, 0x7c, 0x5d, 0x58, 0xbd, 0x45, 0x56, 0x2d, 0x3d, 0xcd, 0xca,
-	0x46, 0x14, 0x84, 0xbb, 0xa1, 0xd9, 0x70, 0xe8, 0x17, 0xd3, 0x6f, 0xea, 0xce, 0x3a, 0xc0, 0x6e,
-	0x40, 0x47, 0x0c, 0x97, 0x5b, 0x96, 0xa1, 0x35, 0x91, 0x89, 0xe5, 0x58, 0x89, 0xa5, 0xbc, 0x41,
-	0x87, 0x8d, 0x24, 0xe3, 0xcc, 0x8c, 0x6a, 0x3c, 0x82, 0x06, 0x4f, 0x2d, 0x5a, 0x50, 0xdb, 0xd9,
-	0xdd, 0x19, 0x32, 0x43, 0xd7, 0xb6, 0xb7, 0x7b, 0x15, 0x44, 0x6d, 0xac, 0x8d, 0xd6, 0x7a, 0x1a,
-	0xb6, 0x46, 0x3f, 0xd9, 0x1b, 0xf6, 0xaa, 0xc6, 0x3f, 0x54, 0xa0, 0x95, 0xce, 0x23, 0x3e, 0x03,
-	0xc0, 0x4b, 0x3b, 0x3e, 0x71, 0xfd, 0x2c, 0xac, 0xba, 0x53, 0x5c, 0x69, 0x05, 0x25, 0xf6, 0x39,
-	0x52, 0xd9, 0x7d, 0xd2, 0x1d, 0x27, 0x78, 0xb0, 0x0f, 0x0b, 0x65, 0xe2, 0x9c, 0xf8, 0xf2, 0x41,
-	0xd1, 0x8f, 0x2c, 0xac, 0x7e, 0xab, 0x34, 0x35, 0x8e, 0x24, 0x65, 0x2e, 0xb8, 0x94, 0x87, 0xd0,
-	0x4a, 0xd1, 0xa2, 0x0d, 0xcd, 0x8d, 0xe1, 0xe6, 0xda, 0xc1, 0x36, 0x2a, 0x09, 0x40, 0x63, 0x7f,
-	0x6b, 0xe7, 0xd9, 0xf6, 0x90, 0x8f, 0xb5, 0xbd, 0xb5, 0x3f, 0xea, 0x69, 0xc6, 0x1f, 0x56, 0xa0,
-	0x95, 0x46, 0x2a, 0xe2, 0xbb, 0x18, 0x5c, 0x50, 0xb0, 0xa4, 0x7c, 0x0f, 0x55, 0x4d, 0x0a, 0x39,
-	0x9f, 0x99, 0xd2, 0xf1, 0x62, 0x90, 0x29, 0x4d, 0x63, 0x17, 0x02, 0x8a, 0x19, 0x67, 0xb5, 0x54,
-	0xf4, 0xc0, 0xe4, 0x39, 0xf0, 0xa5, 0x0a, 0x53, 0xa9, 0x4d, 0x3a, 0xe8, 0xfa, 0xb6, 0xcc, 0x83,
-	0xf8, 0x26, 0xc1, 0xa3, 0xd8, 0x48, 0x38, 0x7a, 0xcd, 0x36, 0x96, 0xad, 0x56, 0x29, 0xae, 0x76,
-	0x29, 0x15, 0xd0, 0x2e, 0xa7, 0x02, 0xb9, 0xab, 0xac, 0xbf, 0xcd, 0x55, 0x1a, 0x7f, 0x5e, 0x83,
-	0x05, 0x53, 0xc6, 0x49, 0x10, 0x49, 0x53, 0xfe, 0x7c, 0x2a, 0xe3, 0xe4, 0x4d, 0x57, 0xe8, 0x3d,
-	0x80, 0x88, 0x3b, 0xe7, 0x4b, 0xeb, 0x0a, 0xc3, 0x39, 0x8c, 0x17, 0xd8, 0xa4, 0xbb, 0xca, 0x27,
-	0x66, 0xb0, 0xb8, 0x03, 0xfa, 0xa1, 0x65, 0x9f, 0xf2, 0xb4, 0xec, 0x19, 0x5b, 0x8c, 0xe0, 0x79,
-	0x2d, 0xdb, 0x96, 0x71, 0x3c, 0x46, 0x55, 0x60, 0xff, 0xa8, 0x33, 0xe6, 0xb9, 0xbc, 0x40, 0x72,
-	0x2c, 0xed, 0x48, 0x26, 0x44, 0x66, 0xb3, 0xa4, 0x33, 0x06, 0xc9, 0xf7, 0xa0, 0x1b, 0xcb, 0x18,
-	0x7d, 0xe9, 0x38, 0x09, 0x4e, 0xa5, 0xaf, 0x6c, 0x54, 0x47, 0x21, 0x47, 0x88, 0x43, 0xd7, 0x63,
-	0xf9, 0x81, 0x7f, 0x31, 0x09, 0xa6, 0xb1, 0xf2, 0x12, 0x39, 0x42, 0xac, 0xc0, 0x4d, 0xe9, 0xdb,
-	0xd1, 0x45, 0x88, 0x7b, 0xc5, 0x55, 0xc6, 0x47, 0xae, 0x27, 0x55, 0xc8, 0x7c, 0x23, 0x27, 0x3d,
-	0x97, 0x17, 0x9b, 0xae, 0x27, 0x71, 0x47, 0x67, 0xd6, 0xd4, 0x4b, 0xc6, 0x94, 0x65, 0x03, 0xef,
-	0x88, 0x30, 0x6b, 0x98, 0x6a, 0x7f, 0x04, 0x37, 0x98, 0x1c, 0x05, 0x9e, 0x74, 0x1d, 0x9e, 0xac,
-	0x4d, 0xbd, 0xae, 0x13, 0xc1, 0x24, 0x3c, 0x4d, 0xb5, 0x02, 0x37, 0xb9, 0x2f, 0x1f, 0x28, 0xed,
-	0xdd, 0xe1, 0xa5, 0x89, 0xb4, 0xaf, 0x28, 0xe5, 0xa5, 0x43, 0x2b, 0x39, 0xa1, 0xec, 0x39, 0x5d,
-	0x7a, 0xcf, 0x4a, 0x4e, 0xd0, 0xc7, 0x33, 0xf9, 0xc8, 0x95, 0x1e, 0x67, 0xc5, 0xba, 0xc9, 0x23,
-	0x36, 0x11, 0x83, 0x3e, 0x5e, 0x75, 0x08, 0xa2, 0x89, 0xc5, 0xc5, 0x37, 0xdd, 0xe4, 0x41, 0x9b,
-	0x84, 0xc2, 0x25, 0x94, 0xac, 0xfc, 0xe9, 0xa4, 0xdf, 0x63, 0x31, 0x33, 0x66, 0x67, 0x3a, 0x31,
-	0xfe, 0x53, 0x83, 0x56, 0x96, 0x64, 0x3d, 0x00, 0x7d, 0x92, 0xda, 0x2b, 0x15, 0x9a, 0x75, 0x4b,
-	0x46, 0xcc, 0xcc, 0xe9, 0xe2, 0x3d, 0xd0, 0x4e, 0xcf, 0x94, 0xed, 0xec, 0xae, 0x70, 0x31, 0x3a,
-	0x3c, 0x5c, 0x5d, 0x79, 0xfe, 0xd2, 0xd4, 0x4e, 0xcf, 0xbe, 0x81, 0xde, 0x8a, 0x0f, 0xe1, 0xba,
-	0xed, 0x49, 0xcb, 0x1f, 0xe7, 0xf1, 0x04, 0xeb, 0xc5, 0x02, 0xa1, 0xf7, 0xb2, 0xa0, 0xe2, 0x3e,
-	0xd4, 0x1d, 0xe9, 0x25, 0x56, 0xb1, 0x26, 0xba, 0x1b, 0x59, 0xb6, 0x27, 0x37, 0x10, 0x6d, 0x32,
-	0x15, 0x6d, 0x67, 0x96, 0xea, 0x14, 0x6c, 0xe7, 0xe5, 0x34, 0x27, 0xbf, 0x97, 0x50, 0xbc, 0x97,
-	0x0f, 0xe0, 0x86, 0x3c, 0x0f, 0xc9, 0x61, 0x8c, 0xb3, 0x3c, 0x9e, 0xc3, 0xa7, 0x5e, 0x4a, 0x78,
-	0x9a, 0xe6, 0xf3, 0x1f, 0xa3, 0xc9, 0xa0, 0x4b, 0x43, 0x62, 0x6e, 0xaf, 0x0a, 0xb2, 0x39, 0xa5,
-	0x6b, 0x68, 0xa6, 0x5d, 0xbe, 0xa8, 0xb5, 0x9a, 0xbd, 0x96, 0x61, 0x43, 0xf5, 0xf9, 0xcb, 0x7d,
-	0x32, 0x2a, 0x68, 0xdf, 0xeb, 0x14, 0x00, 0x50, 0x3b, 0x33, 0x34, 0x5a, 0xc1, 0xd0, 0xdc, 0x65,
-	0x1b, 0x4d, 0x3c, 0x48, 0x4b, 0x75, 0x05, 0x0c, 0x9e, 0x82, 0xfd, 0x53, 0x8d, 0xab, 0x78, 0x04,
-	0x18, 0xff, 0x5d, 0x85, 0xa6, 0x0a, 0x1a, 0xd0, 0x2e, 0x4f, 0xb3, 0x2a, 0x14, 0x36, 0xcb, 0xb9,
-	0x5b, 0x16, 0x7d, 0x14, 0x4b, 0xfa, 0xd5, 0xb7, 0x97, 0xf4, 0xc5, 0x67, 0xd0, 0x09, 0x99, 0x56,
-	0x8c, 0x57, 0xde, 0x29, 0x8e, 0x51, 0xbf, 0x34, 0xae, 0x1d, 0xe6, 0x00, 0x9a, 0x26, 0xaa, 0x77,
-	0x26, 0xd6, 0xb1, 0xe2, 0x40, 0x13, 0xe1, 0x91, 0x75, 0x7c, 0x45, 0xd4, 0xf2, 0x75, 0x82, 0x8f,
-	0x05, 0x8a, 0x62, 0x3a, 0x64, 0xe9, 0x30, 0x60, 0x29, 0xc6, 0x09, 0xdd, 0x72, 0x9c, 0x70, 0x07,
-	0x74, 0x3b, 0x98, 0x4c, 0x5c, 0xa2, 0x2d, 0xa8, 0x2a, 0x0d, 0x21, 0x46, 0xb1, 0xf1, 0xbb, 0x15,
-	0x68, 0xaa, 0xd3, 0x5e, 0xf2, 0x42, 0xeb, 0x5b, 0x3b, 0x6b, 0xe6, 0x4f, 0x7a, 0x15, 0xf4, 0xb2,
-	0x5b, 0x3b, 0xa3, 0x9e, 0x26, 0x74, 0xa8, 0x6f, 0x6e, 0xef, 0xae, 0x8d, 0x7a, 0x55, 0xf4, 0x4c,
-	0xeb, 0xbb, 0xbb, 0xdb, 0xbd, 0x9a, 0xe8, 0x40, 0x6b, 0x63, 0x6d, 0x34, 0x1c, 0x6d, 0xbd, 0x18,
-	0xf6, 0xea, 0xd8, 0xf7, 0xd9, 0x70, 0xb7, 0xd7, 0xc0, 0xc6, 0xc1, 0xd6, 0x46, 0xaf, 0x89, 0xf4,
-	0xbd, 0xb5, 0xfd, 0xfd, 0x1f, 0xed, 0x9a, 0x1b, 0xbd, 0x16, 0x79, 0xb7, 0x91, 0xb9, 0xb5, 0xf3,
-	0xac, 0xa7, 0x63, 0x7b, 0x77, 0xfd, 0x8b, 0xe1, 0xd3, 0x51, 0x0f, 0x8c, 0x27, 0xd0, 0x2e, 0x70,
-	0x10, 0x47, 0x9b, 0xc3, 0xcd, 0xde, 0x35, 0x5c, 0xf2, 0xe5, 0xda, 0xf6, 0x01, 0x3a, 0xc3, 0x05,
-	0x00, 0x6a, 0x8e, 0xb7, 0xd7, 0x76, 0x9e, 0xf5, 0x34, 0xe3, 0x87, 0xd0, 0x3a, 0x70, 0x9d, 0x75,
-	0x2f, 0xb0, 0x4f, 0x51, 0x9d, 0x0e, 0xad, 0x58, 0x2a, 0xbf, 0x43, 0x6d, 0x0c, 0x52, 0xe9, 0x9e,
-	0xc4, 0x4a, 0xf6, 0x0a, 0x42, 0x5e, 0xf9, 0xd3, 0xc9, 0x98, 0x9e, 0x81, 0xaa, 0xec, 0x2b, 0xfc,
-	0xe9, 0xe4, 0xc0, 0x75, 0x62, 0xe3, 0x14, 0x9a, 0x07, 0xae, 0xb3, 0x67, 0xd9, 0xa7, 0x64, 0x4f,
-	0x70, 0xea, 0x71, 0xec, 0x7e, 0x29, 0x95, 0x4f, 0xd1, 0x09, 0xb3, 0xef, 0x7e, 0x29, 0xc5, 0x07,
-	0xd0, 0x20, 0x20, 0xcd, 0xe2, 0xe9, 0xe6, 0xa5, 0xdb, 0x31, 0x15, 0x8d, 0x5e, 0x61, 0x3c, 0x2f,
-	0xb0, 0xc7, 0x91, 0x3c, 0xea, 0xbf, 0xc3, 0xbc, 0x27, 0x84, 0x29, 0x8f, 0x8c, 0xdf, 0xaf, 0x64,
-	0x67, 0xa6, 0x47, 0x80, 0x45, 0xa8, 0x85, 0x96, 0x7d, 0xaa, 0x5c, 0x7a, 0x5b, 0x4d, 0x88, 0x9b,
-	0x31, 0x89, 0x20, 0x3e, 0x84, 0x96, 0x52, 0xac, 0x74, 0xd5, 0x76, 0x41, 0x03, 0xcd, 0x8c, 0x58,
-	0x16, 0x79, 0xb5, 0x2c, 0x72, 0x4a, 0x01, 0x43, 0xcf, 0x4d, 0xf8, 0x1a, 0xd5, 0x4c, 0x05, 0x19,
-	0xdf, 0x03, 0xc8, 0xdf, 0x5d, 0xe6, 0x44, 0x38, 0xb7, 0xa0, 0x6e, 0x79, 0xae, 0x95, 0xa6, 0x94,
-	0x0c, 0x18, 0x3b, 0xd0, 0x2e, 0xbc, 0xd6, 0x20, 0x6f, 0x2d, 0xcf, 0x43, 0x67, 0x14, 0xd3, 0xd8,
-	0x96, 0xd9, 0xb4, 0x3c, 0xef, 0xb9, 0xbc, 0x88, 0x31, 0xba, 0xe4, 0x87, 0x1e, 0x6d, 0xe6, 0x8d,
-	0x80, 0x86, 0x9a, 0x4c, 0x34, 0x3e, 0x86, 0xc6, 0x66, 0x1a, 0x5f, 0xa7, 0xd7, 0xa0, 0x72, 0xd5,
-	0x35, 0x30, 0x3e, 0x55, 0x7b, 0xa6, 0x67, 0x06, 0xf1, 0x40, 0x3d, 0x28, 0xc5, 0xfc, 0x7c, 0x55,
-	0xc9, 0x8b, 0x12, 0xdc, 0x49, 0xbd, 0x25, 0x51, 0x67, 0x63, 0x03, 0x5a, 0x6f, 0x7c, 0xa2, 0x53,
-	0x0c, 0xd0, 0x72, 0x06, 0xcc, 0x79, 0xb4, 0x33, 0x7e, 0x06, 0x90, 0x3f, 0x3c, 0xa9, 0x5b, 0xc9,
-	0xb3, 0xe0, 0xad, 0xfc, 0x08, 0x5a, 0xf6, 0x89, 0xeb, 0x39, 0x91, 0xf4, 0x4b, 0xa7, 0xce, 0x9f,
-	0xaa, 0x32, 0xba, 0x58, 0x82, 0x1a, 0xbd, 0xa7, 0x55, 0x73, 0x43, 0x9e, 0x3d, 0xa6, 0x11, 0xc5,
-	0x38, 0x87, 0x2e, 0x87, 0xed, 0x5f, 0x23, 0xe8, 0x29, 0x9b, 0x52, 0xed, 0x92, 0x29, 0xbd, 0x0d,
-	0x0d, 0xf2, 0xb5, 0xe9, 0x69, 0x14, 0x74, 0x85, 0x89, 0xfd, 0x1d, 0x0d, 0x80, 0x97, 0xde, 0x09,
-	0x1c, 0x59, 0xce, 0x88, 0x2b, 0xb3, 0x19, 0xb1, 0x80, 0x5a, 0xf6, 0x54, 0xaa, 0x9b, 0xd4, 0xce,
-	0xfd, 0x8f, 0xca, 0x92, 0xd9, 0xff, 0xbc, 0x0b, 0x3a, 0xc5, 0x3e, 0xee, 0x97, 0x54, 0xdf, 0xc7,
-	0x05, 0x73, 0x44, 0xf1, 0xe1, 0xb0, 0x5e, 0x7e, 0x38, 0xcc, 0x5e, 0x57, 0x1a, 0x3c, 0x1b, 0xbf,
-	0xae, 0xcc, 0x79, 0x28, 0xe2, 0x32, 0x45, 0x2c, 0xa3, 0x24, 0xcd, 0xb1, 0x19, 0xca, 0x12, 0x43,
-	0x5d, 0xf5, 0xb5, 0xb8, 0xd0, 0xe0, 0x07, 0x63, 0x3b, 0xf0, 0x8f, 0x3c, 0xd7, 0x4e, 0xd4, 0x43,
-	0x21, 0xf8, 0xc1, 0x53, 0x85, 0x31, 0x3e, 0x83, 0x4e, 0xca, 0x7f, 0x7a, 0x8f, 0xf9, 0x28, 0x4b,
-	0xac, 0x2a, 0xb9, 0x6c, 0x73, 0x36, 0xad, 0x6b, 0xfd, 0x4a, 0x9a, 0x5a, 0x19, 0xff, 0x55, 0x4d,
-	0x07, 0xab, 0x67, 0x85, 0x37, 0xf3, 0xb0, 0x9c, 0x1d, 0x6b, 0x5f, 0x2b, 0x3b, 0xfe, 0x3e, 0xe8,
-	0x0e, 0xa5, 0x7f, 0xee, 0x59, 0xea, 0xd4, 0x06, 0xb3, 0xa9, 0x9e, 0x4a, 0x10, 0xdd, 0x33, 0x69,
-	0xe6, 0x9d, 0xdf, 0x22, 0x87, 0x8c, 0xdb, 0xf5, 0x79, 0xdc, 0x6e, 0xfc, 0x8a, 0xdc, 0x7e, 0x1f,
-	0x3a, 0x7e, 0xe0, 0x8f, 0xfd, 0xa9, 0xe7, 0x59, 0x87, 0x9e, 0x54, 0xec, 0x6e, 0xfb, 0x81, 0xbf,
-	0xa3, 0x50, 0x18, 0x90, 0x16, 0xbb, 0xf0, 0xa5, 0x6e, 0x53, 0xbf, 0xeb, 0x85, 0x7e, 0x74, 0xf5,
-	0x97, 0xa1, 0x17, 0x1c, 0xfe, 0x4c, 0xda, 0x09, 0x71, 0x6c, 0x4c, 0xb7, 0x99, 0xa3, 0xd1, 0x05,
-	0xc6, 0x23, 0x8b, 0x76, 0xf0, 0x5e, 0xcf, 0x88, 0xb9, 0x7b, 0x49, 0xcc, 0x9f, 0x82, 0x9e, 0x71,
-	0xa9, 0x90, 0x6a, 0xea, 0x50, 0xdf, 0xda, 0xd9, 0x18, 0xfe, 0xb8, 0x57, 0x41, 0x47, 0x69, 0x0e,
-	0x5f, 0x0e, 0xcd, 0xfd, 0x61, 0x4f, 0x43, 0x27, 0xb6, 0x31, 0xdc, 0x1e, 0x8e, 0x86, 0xbd, 0x2a,
-	0x47, 0x3d, 0x54, 0xf7, 0xf7, 0x5c, 0xdb, 0x4d, 0x8c, 0x7d, 0x80, 0x3c, 0x7f, 0x46, 0xab, 0x9c,
-	0x6f, 0x4e, 0x95, 0xec, 0x92, 0x74, 0x5b, 0xcb, 0xd9, 0x85, 0xd4, 0xae, 0xca, 0xd2, 0x99, 0x6e,
-	0xac, 0x82, 0xfe, 0xc2, 0x0a, 0x3f, 0xe7, 0x77, 0xb0, 0xfb, 0xb0, 0x10, 0x5a, 0x51, 0xe2, 0xa6,
-	0x29, 0x00, 0x1b, 0xcb, 0x8e, 0xd9, 0xcd, 0xb0, 0x68, 0x7b, 0x8d, 0xbf, 0xa8, 0xc0, 0xad, 0x17,
-	0xc1, 0x99, 0xcc, 0x42, 0xcc, 0x3d, 0xeb, 0xc2, 0x0b, 0x2c, 0xe7, 0x2d, 0x6a, 0x88, 0x39, 0x4c,
-	0x30, 0xa5, 0x17, 0xab, 0xf4, 0x15, 0xcf, 0xd4, 0x19, 0xf3, 0x4c, 0x7d, 0x46, 0x20, 0xe3, 0x84,
-	0x88, 0xca, 0x91, 0x22, 0x8c, 0xa4, 0x6f, 0x41, 0x23, 0x39, 0xf7, 0xf3, 0x47, 0xc3, 0x7a, 0x42,
-	0x05, 0xe5, 0xb9, 0x11, 0x67, 0x7d, 0x7e, 0xc4, 0x69, 0x3c, 0x05, 0x7d, 0x74, 0x4e, 0xc5, 0xd6,
-	0x69, 0x5c, 0x0a, 0x70, 0x2a, 0x6f, 0x08, 0x70, 0xb4, 0x99, 0x00, 0xe7, 0xdf, 0x2b, 0xd0, 0x2e,
-	0x84, 0xce, 0xe2, 0x7d, 0xa8, 0x25, 0xe7, 0x7e, 0xf9, 0x69, 0x3e, 0x5d, 0xc4, 0x24, 0xd2, 0xa5,
-	0x82, 0xa2, 0x76, 0xa9, 0xa0, 0x28, 0xb6, 0xe1, 0x3a, 0x5b, 0xde, 0xf4, 0x10, 0x69, 0x15, 0xe6,
-	0xde, 0x4c, 0xa8, 0xce, 0x05, 0xe9, 0xf4, 0x48, 0xaa, 0xb4, 0xb0, 0x70, 0x5c, 0x42, 0x0e, 0xd6,
-	0xe0, 0xe6, 0x9c, 0x6e, 0xdf, 0xe4, 0x21, 0xc2, 0x58, 0x84, 0xee, 0xe8, 0xdc, 0x1f, 0xb9, 0x13,
-	0x19, 0x27, 0xd6, 0x24, 0xa4, 0x00, 0x51, 0x79, 0xce, 0x9a, 0xa9, 0x25, 0xb1, 0xf1, 0x1d, 0xe8,
-	0xec, 0x49, 0x19, 0x99, 0x32, 0x0e, 0x03, 0x9f, 0x83, 0x23, 0x55, 0x08, 0x66, 0x37, 0xad, 0x20,
-	0xe3, 0xb7, 0x40, 0x37, 0xad, 0xa3, 0x64, 0xdd, 0x4a, 0xec, 0x93, 0x6f, 0x52, 0x67, 0xf8, 0x0e,
-	0x34, 0x43, 0xd6, 0x29, 0x95, 0x50, 0x75, 0xc8, 0x5d, 0x2b, 0x3d, 0x33, 0x53, 0xa2, 0xf1, 0x04,
-	0x6e, 0xee, 0x4f, 0x0f, 0x63, 0x3b, 0x72, 0x29, 0x37, 0x4d, 0x5d, 0xd9, 0x00, 0x5a, 0x61, 0x24,
-	0x8f, 0xdc, 0x73, 0x99, 0x6a, 0x70, 0x06, 0x1b, 0x3f, 0x80, 0x5b, 0xe5, 0x21, 0xea, 0x08, 0xf7,
-	0xa0, 0x7a, 0x7a, 0x16, 0xab, 0x9d, 0xdd, 0x28, 0x65, 0x66, 0xf4, 0x22, 0x8e, 0x54, 0xc3, 0x84,
-	0xea, 0xce, 0x74, 0x52, 0xfc, 0xaa, 0xa7, 0xc6, 0x5f, 0xf5, 0xdc, 0x29, 0x96, 0x59, 0x39, 0x0b,
-	0xc9, 0xcb, 0xa9, 0xef, 0x82, 0x7e, 0x14, 0x44, 0xbf, 0xb0, 0x22, 0x47, 0x3a, 0xca, 0x67, 0xe5,
-	0x08, 0xe3, 0xa7, 0xd0, 0x4e, 0x35, 0x61, 0xcb, 0xa1, 0xd7, 0x3d, 0x52, 0xc5, 0x2d, 0xa7, 0xa4,
-	0x99, 0x5c, 0x95, 0x94, 0xbe, 0xb3, 0x95, 0xaa, 0x10, 0x03, 0xe5, 0x95, 0xd5, 0x93, 0x4b, 0xba,
-	0xb2, 0xb1, 0x09, 0x9d, 0x34, 0x7f, 0x7b, 0x21, 0x13, 0x8b, 0x94, 0xdb, 0x73, 0xa5, 0x5f, 0x50,
-	0xfc, 0x16, 0x23, 0x46, 0xe5, 0xc2, 0xa1, 0x56, 0x0a, 0x00, 0x8c, 0x15, 0x68, 0xa8, 0x9b, 0x23,
-	0xa0, 0x66, 0x07, 0x0e, 0xdf, 0xee, 0xba, 0x49, 0x6d, 0x64, 0xc7, 0x24, 0x3e, 0x4e, 0x83, 0x9b,
-	0x49, 0x7c, 0x6c, 0xfc, 0x95, 0x06, 0xdd, 0x75, 0xca, 0x96, 0x53, 0x91, 0x14, 0x6a, 0x44, 0x95,
-	0x52, 0x8d, 0xa8, 0x58, 0x0f, 0xd2, 0x4a, 0xf5, 0xa0, 0xd2, 0x86, 0xaa, 0xe5, 0x88, 0xe4, 0x1d,
-	0x68, 0x4e, 0x7d, 0xf7, 0x3c, 0x35, 0x09, 0xba, 0xd9, 0x40, 0x70, 0x14, 0x8b, 0x25, 0x68, 0xa3,
-	0xd5, 0x70, 0x7d, 0xae, 0xc1, 0x70, 0x21, 0xa5, 0x88, 0x9a, 0xa9, 0xb4, 0x34, 0xde, 0x5c, 0x69,
-	0x69, 0xbe, 0xb5, 0xd2, 0xd2, 0x7a, 0x5b, 0xa5, 0x45, 0x9f, 0xad, 0xb4, 0x94, 0xa3, 0x29, 0x98,
-	0x8d, 0xa6, 0x8c, 0x6d, 0x58, 0x48, 0x79, 0xa7, 0x74, 0xf3, 0x33, 0xb8, 0xae, 0x8a, 0xa4, 0x32,
-	0x52, 0x75, 0x06, 0xb6, 0x38, 0x37, 0xa8, 0x4c, 0x4b, 0x75, 0x4c, 0x45, 0x31, 0x17, 0x9c, 0x22,
-	0x18, 0x1b, 0xbf, 0x57, 0x81, 0x6e, 0xa9, 0x87, 0x78, 0x92, 0x97, 0x5c, 0x2b, 0xe4, 0xd8, 0xfb,
-	0x97, 0x66, 0x79, 0x73, 0xd9, 0x55, 0x9b, 0x29, 0xbb, 0x1a, 0xf7, 0xb3, 0x62, 0xaa, 0x2a, 0xa1,
-	0x5e, 0xcb, 0x4a, 0xa8, 0x54, 0x75, 0x5c, 0x1b, 0x8d, 0xcc, 0x9e, 0x66, 0xfc, 0x91, 0x06, 0xdd,
-	0xe1, 0x79, 0x48, 0xdf, 0xa0, 0xbc, 0x35, 0xe6, 0x2c, 0x28, 0x8c, 0x56, 0x52, 0x98, 0x82, 0xe8,
-	0xab, 0xea, 0xb5, 0x88, 0x45, 0x8f, 0x51, 0x28, 0x17, 0x74, 0x94, 0x4a, 0x30, 0xf4, 0x7f, 0x40,
-	0x25, 0x50, 0xe4, 0x29, 0x63, 0x94, 0xc8, 0xbf, 0xd6, 0x3d, 0xe3, 0xef, 0xc7, 0xbc, 0xac, 0xbc,
-	0xc1, 0x80, 0xf1, 0x07, 0x1a, 0xe8, 0xac, 0x41, 0xb8, 0xbd, 0xef, 0xaa, 0x08, 0xba, 0x92, 0x97,
-	0x92, 0x33, 0xe2, 0xca, 0x73, 0x79, 0x41, 0x91, 0x1f, 0x07, 0xd6, 0xf3, 0x1e, 0x5c, 0x54, 0x11,
-	0x84, 0xf3, 0x3e, 0x2a, 0x82, 0xdc, 0x01, 0x9d, 0x9d, 0xe7, 0xd4, 0x4d, 0x9f, 0x80, 0xd9, 0x9b,
-	0x1e, 0xb8, 0xf4, 0xd9, 0x4d, 0x22, 0xa3, 0x89, 0xe2, 0x32, 0xb5, 0xcb, 0x11, 0x76, 0x57, 0xc5,
-	0x7c, 0xc6, 0x09, 0x34, 0xd5, 0xea, 0x18, 0x02, 0x1d, 0xec, 0x3c, 0xdf, 0xd9, 0xfd, 0xd1, 0x4e,
-	0x49, 0x73, 0xb2, 0x20, 0x49, 0x2b, 0x06, 0x49, 0x55, 0xc4, 0x3f, 0xdd, 0x3d, 0xd8, 0x19, 0xf5,
-	0x6a, 0xa2, 0x0b, 0x3a, 0x35, 0xc7, 0xe6, 0xf0, 0x65, 0xaf, 0x4e, 0xf5, 0x80, 0xa7, 0x9f, 0x0f,
-	0x5f, 0xac, 0xf5, 0x1a, 0x59, 0xe9, 0xbe, 0x69, 0xfc, 0x69, 0x05, 0x6e, 0xf0, 0x91, 0x8b, 0x09,
-	0x72, 0xf1, 0xdb, 0xcd, 0x1a, 0x7f, 0xbb, 0xf9, 0xeb, 0xcd, 0x89, 0x71, 0xd0, 0xd4, 0x4d, 0x9f,
-	0xc7, 0xb8, 0x78, 0xd3, 0x9a, 0xba, 0xea, 0x55, 0xec, 0xef, 0x2a, 0x30, 0xe0, 0xd8, 0xec, 0x59,
-	0x64, 0x85, 0x27, 0x3f, 0xdc, 0xbe, 0x94, 0x9d, 0x5d, 0x15, 0xb1, 0xdc, 0x87, 0x05, 0xfa, 0xba,
-	0xf5, 0xe7, 0xde, 0x58, 0x65, 0x10, 0x2c, 0xbf, 0xae, 0xc2, 0xf2, 0x44, 0xe2, 0x13, 0xe8, 0xf0,
-	0x57, 0xb0, 0x54, 0x30, 0x2c, 0x3d, 0xf4, 0x94, 0x22, 0xc3, 0x36, 0xf7, 0xa2, 0x27, 0x27, 0xf1,
-	0x24, 0x1b, 0x94, 0x27, 0x72, 0x97, 0xdf, 0x72, 0xd4, 0x90, 0x11, 0xa5, 0x77, 0x8f, 0xe0, 0xce,
-	0xdc, 0x73, 0x28, 0xc5, 0x2e, 0x14, 0xd5, 0x58, 0x9f, 0x56, 0xff, 0xb6, 0x02, 0x35, 0x8c, 0x02,
-	0xc4, 0x43, 0xd0, 0x3f, 0x97, 0x56, 0x94, 0x1c, 0x4a, 0x2b, 0x11, 0x25, 0x8f, 0x3f, 0xa0, 0x15,
-	0xf3, 0xf7, 0x6a, 0xe3, 0xda, 0xe3, 0x8a, 0x58, 0xe1, 0x0f, 0xd3, 0xd2, 0xef, 0xed, 0xba, 0x69,
-	0x34, 0x41, 0xd1, 0xc6, 0xa0, 0x34, 0xde, 0xb8, 0xb6, 0x4c, 0xfd, 0xbf, 0x08, 0x5c, 0xff, 0x29,
-	0x7f, 0x47, 0x25, 0x66, 0xa3, 0x8f, 0xd9, 0x11, 0xe2, 0x21, 0x34, 0xb6, 0x62, 0x0c, 0x73, 0x2e,
-	0x77, 0x25, 0xae, 0x15, 0x23, 0x20, 0xe3, 0xda, 0xea, 0x9f, 0x55, 0xa1, 0xf6, 0x53, 0x19, 0x05,
-	0xe2, 0x63, 0x68, 0xaa, 0xd7, 0x7d, 0x51, 0x78, 0xc5, 0x1f, 0x50, 0xc6, 0x35, 0xf3, 0xec, 0x4f,
-	0xab, 0xf4, 0x98, 0x5d, 0x79, 0x99, 0x57, 0xe4, 0x1f, 0x1f, 0x5c, 0xda, 0xd4, 0xa7, 0xd0, 0xdb,
-	0x4f, 0x22, 0x69, 0x4d, 0x0a, 0xdd, 0xcb, 0xac, 0x9a, 0x57, 0x33, 0x26, 0x7e, 0x3d, 0x80, 0x06,
-	0xc7, 0x92, 0x33, 0x03, 0x66, 0x0b, 0xc2, 0xd4, 0xf9, 0x43, 0x68, 0xef, 0x9f, 0x04, 0x53, 0xcf,
-	0xd9, 0x97, 0xd1, 0x99, 0x14, 0x85, 0x2f, 0x7a, 0x06, 0x85, 0xb6, 0x71, 0x4d, 0x2c, 0x03, 0x70,
-	0xf8, 0x72, 0x80, 0x17, 0xa8, 0x89, 0xb4, 0x9d, 0xe9, 0x84, 0x27, 0x2d, 0xc4, 0x35, 0xdc, 0xb3,
-	0x10, 0x52, 0xbe, 0xa9, 0xe7, 0x27, 0xd0, 0x7d, 0x4a, 0x97, 0x69, 0x37, 0x5a, 0x3b, 0x0c, 0xa2,
-	0x44, 0xcc, 0x7e, 0xd5, 0x33, 0x98, 0x45, 0x18, 0xd7, 0xc4, 0x63, 0x68, 0x8d, 0xa2, 0x0b, 0xee,
-	0x7f, 0x43, 0x45, 0xe2, 0xf9, 0x7a, 0x73, 0x4e, 0xb9, 0xfa, 0x3f, 0x35, 0x68, 0xfc, 0x28, 0x88,
-	0x4e, 0x65, 0x84, 0xd9, 0x37, 0x95, 0xeb, 0x95, 0x1a, 0x65, 0xa5, 0xfb, 0x79, 0x0b, 0x7d, 0x00,
-	0x3a, 0x31, 0x65, 0x64, 0xc5, 0xa7, 0x2c, 0x2a, 0xfa, 0x9c, 0x9a, 0xf9, 0xc2, 0xd9, 0x3c, 0xc9,
-	0x75, 0x81, 0x05, 0x95, 0x3d, 0x67, 0x95, 0xca, 0xe9, 0x03, 0x3a, 0xff, 0xf3, 0x97, 0xfb, 0xa8,
-	0x9a, 0x8f, 0x2b, 0x68, 0xa5, 0xf7, 0xf9, 0xa4, 0xd8, 0x29, 0xff, 0x8c, 0x94, 0x35, 0x3f, 0xff,
-	0x6e, 0xd3, 0xb8, 0x26, 0x1e, 0x41, 0x43, 0x5d, 0xe9, 0x1b, 0xf9, 0xe5, 0x55, 0x76, 0x62, 0xd0,
-	0x2b, 0xa2, 0xd4, 0x80, 0x27, 0xd0, 0x60, 0xf3, 0xc7, 0x03, 0x4a, 0x81, 0xd9, 0x40, 0x14, 0x51,
-	0xa9, 0x32, 0x8b, 0x07, 0xd0, 0x54, 0xc5, 0x78, 0x31, 0xa7, 0x32, 0xcf, 0x47, 0xe5, 0x88, 0x90,
-	0xe7, 0x67, 0xef, 0xc5, 0xf3, 0x97, 0x5c, 0x3c, 0xcf, 0x5f, 0x76, 0x6e, 0xac, 0xf5, 0xa6, 0xb4,
-	0xa5, 0x5b, 0x48, 0x22, 0x45, 0xca, 0x91, 0x39, 0x57, 0xf7, 0x53, 0xe8, 0x96, 0x12, 0x4e, 0x41,
-	0x21, 0xcb, 0xbc, 0x1c, 0xf4, 0xd2, 0x85, 0xf9, 0x01, 0xe8, 0x2a, 0xde, 0x3f, 0x94, 0x82, 0x6a,
-	0xec, 0x73, 0x32, 0x86, 0xc1, 0xe5, 0x80, 0x9f, 0x6e, 0xc1, 0x8f, 0xe1, 0xe6, 0x1c, 0x5b, 0x26,
-	0xe8, 0x63, 0xa9, 0xab, 0x8d, 0xf5, 0x60, 0xf1, 0x4a, 0x7a, 0xca, 0x80, 0xf5, 0xde, 0xdf, 0x7f,
-	0x75, 0xb7, 0xf2, 0x4f, 0x5f, 0xdd, 0xad, 0xfc, 0xeb, 0x57, 0x77, 0x2b, 0xbf, 0xfc, 0xb7, 0xbb,
-	0xd7, 0x0e, 0x1b, 0xf4, 0xd7, 0x82, 0x4f, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x22, 0xa8, 0x8d,
-	0xae, 0xd0, 0x30, 0x00, 0x00,
+	// 5215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0x3d, 0x70, 0x1c, 0x57,
+	0x72, 0x30, 0xf7, 0x7f, 0xa7, 0xf7, 0x07, 0xcb, 0x47, 0x8a, 0x5a, 0x41, 0x12, 0x01, 0x0d, 0x45,
+	0x09, 0xfa, 0x21, 0x48, 0x41, 0xf7, 0x7d, 0x3e, 0xe9, 0xea, 0xaa, 0x0e, 0x20, 0x96, 0x14, 0x44,
+	0x10, 0xc0, 0x3d, 0x2c, 0x79, 0x3f, 0x81, 0xb7, 0x06, 0x3b, 0x0f, 0xc0, 0x1c, 0x66, 0x67, 0x46,
+	0x33, 0xb3, 0x10, 0xa0, 0xec, 0x02, 0xbb, 0x1c, 0xd8, 0x99, 0x5d, 0xbe, 0xd8, 0x99, 0x23, 0x97,
+	0x13, 0xdb, 0xe5, 0xd8, 0xe5, 0x72, 0x39, 0x70, 0x5d, 0xe4, 0x2a, 0x07, 0x66, 0xb9, 0x64, 0x3b,
+	0x61, 0xe0, 0xd0, 0xb1, 0xab, 0xbb, 0xdf, 0xfc, 0x2d, 0x16, 0x24, 0x75, 0xae, 0x0b, 0x1c, 0xed,
+	0xeb, 0xee, 0xf7, 0x37, 0xdd, 0xfd, 0xfa, 0xf5, 0xcf, 0x5b, 0x68, 0x06, 0x07, 0xab, 0x41, 0xe8,
+	0xc7, 0xbe, 0x28, 0x07, 0x07, 0x8b, 0x86, 0x15, 0x38, 0x0c, 0x2e, 0x7e, 0x78, 0xe4, 0xc4, 0xc7,
+	0xd3, 0x83, 0xd5, 0xb1, 0x3f, 0xb9, 0x6b, 0x1f, 0x85, 0x56, 0x70, 0x7c, 0xc7, 0xf1, 0xef, 0x1e,
+	0x58, 0xf6, 0x91, 0x0a, 0xef, 0x9e, 0xae, 0xdd, 0x0d, 0x0e, 0xee, 0x26, 0x43, 0x17, 0xef, 0xe4,
+	0xfa, 0x1e, 0xf9, 0x47, 0xfe, 0x5d, 0x42, 0x1f, 0x4c, 0x0f, 0x09, 0x22, 0x80, 0x5a, 0xdc, 0xdd,
+	0x5c, 0x84, 0xea, 0xb6, 0x13, 0xc5, 0x42, 0x40, 0x75, 0xea, 0xd8, 0x51, 0xbf, 0xb4, 0x5c, 0x59,
+	0xa9, 0x4b, 0x6a, 0x9b, 0x8f, 0xc1, 0x18, 0x5a, 0xd1, 0xc9, 0x53, 0xcb, 0x9d, 0x2a, 0xd1, 0x83,
+	0xca, 0xa9, 0xe5, 0xf6, 0x4b, 0xcb, 0xa5, 0x95, 0xb6, 0xc4, 0xa6, 0x58, 0x85, 0xe6, 0xa9, 0xe5,
+	0x8e, 0xe2, 0xf3, 0x40, 0xf5, 0xcb, 0xcb, 0xa5, 0x95, 0xee, 0xda, 0xb5, 0xd5, 0xe0, 0x60, 0x75,
+	0xcf, 0x8f, 0x62, 0xc7, 0x3b, 0x5a, 0x7d, 0x6a, 0xb9, 0xc3, 0xf3, 0x40, 0xc9, 0xc6, 0x29, 0x37,
+	0xcc, 0x5d, 0x68, 0xed, 0x87, 0xe3, 0x07, 0x53, 0x6f, 0x1c, 0x3b, 0xbe, 0x87, 0x2b, 0x7a, 0xd6,
+	0x44, 0xd1, 0x8c, 0x86, 0xa4, 0x36, 0xe2, 0xac, 0xf0, 0x28, 0xea, 0x57, 0x96, 0x2b, 0x88, 0xc3,
+	0xb6, 0xe8, 0x43, 0xc3, 0x89, 0xee, 0xfb, 0x53, 0x2f, 0xee, 0x57, 0x97, 0x4b, 0x2b, 0x4d, 0x99,
+	0x80, 0xe6, 0x5f, 0x57, 0xa0, 0xf6, 0xe3, 0xa9, 0x0a, 0xcf, 0x69, 0x5c, 0x1c, 0x87, 0xc9, 0x5c,
+	0xd8, 0x16, 0xd7, 0xa1, 0xe6, 0x5a, 0xde, 0x51, 0xd4, 0x2f, 0xd3, 0x64, 0x0c, 0x88, 0x37, 0xc1,
+	0xb0, 0x0e, 0x63, 0x15, 0x8e, 0xa6, 0x8e, 0xdd, 0xaf, 0x2c, 0x97, 0x56, 0xea, 0xb2, 0x49, 0x88,
+	0x27, 0x8e, 0x2d, 0xde, 0x80, 0xa6, 0xed, 0x8f, 0xc6, 0xf9, 0xb5, 0x6c, 0x9f, 0xd6, 0x12, 0xb7,
+	0xa0, 0x39, 0x75, 0xec, 0x91, 0xeb, 0x44, 0x71, 0xbf, 0xb6, 0x5c, 0x5a, 0x69, 0xad, 0x35, 0xf1,
+	0x63, 0x91, 0x77, 0xb2, 0x31, 0x75, 0x6c, 0x6c, 0x88, 0x0f, 0xa1, 0x19, 0x85, 0xe3, 0xd1, 0xe1,
+	0xd4, 0x1b, 0xf7, 0xeb, 0xd4, 0x69, 0x01, 0x3b, 0xe5, 0xbe, 0x5a, 0x36, 0x22, 0x06, 0xf0, 0xb3,
+	0x42, 0x75, 0xaa, 0xc2, 0x48, 0xf5, 0x1b, 0xbc, 0x94, 0x06, 0xc5, 0x3d, 0x68, 0x1d, 0x5a, 0x63,
+	0x15, 0x8f, 0x02, 0x2b, 0xb4, 0x26, 0xfd, 0x66, 0x36, 0xd1, 0x03, 0x44, 0xef, 0x21, 0x36, 0x92,
+	0x70, 0x98, 0x02, 0xe2, 0x53, 0xe8, 0x10, 0x14, 0x8d, 0x0e, 0x1d, 0x37, 0x56, 0x61, 0xdf, 0xa0,
+	0x31, 0x5d, 0x1a, 0x43, 0x98, 0x61, 0xa8, 0x94, 0x6c, 0x73, 0x27, 0xc6, 0x88, 0xb7, 0x01, 0xd4,
+	0x59, 0x60, 0x79, 0xf6, 0xc8, 0x72, 0xdd, 0x3e, 0xd0, 0x1e, 0x0c, 0xc6, 0xac, 0xbb, 0xae, 0x78,
+	0x1d, 0xf7, 0x67, 0xd9, 0xa3, 0x38, 0xea, 0x77, 0x96, 0x4b, 0x2b, 0x55, 0x59, 0x47, 0x70, 0x18,
+	0x21, 0x5f, 0xc7, 0xd6, 0xf8, 0x58, 0xf5, 0xbb, 0xcb, 0xa5, 0x95, 0x9a, 0x64, 0x00, 0xb1, 0x87,
+	0x4e, 0x18, 0xc5, 0xfd, 0x05, 0xc6, 0x12, 0x20, 0x6e, 0x40, 0xfd, 0xab, 0xa9, 0x1f, 0x4e, 0x27,
+	0xfd, 0x1e, 0xcd, 0xaf, 0x21, 0x73, 0x0d, 0x0c, 0xd2, 0x2a, 0xe2, 0xda, 0x6d, 0xa8, 0x9f, 0x22,
+	0xc0, 0xca, 0xd7, 0x5a, 0xeb, 0xe0, 0xb6, 0x53, 0xc5, 0x93, 0x9a, 0x68, 0xde, 0x84, 0xe6, 0xb6,
+	0xe5, 0x1d, 0x25, 0xda, 0x8a, 0xe2, 0xa4, 0x01, 0x86, 0xa4, 0xb6, 0xf9, 0xab, 0x32, 0xd4, 0xa5,
+	0x8a, 0xa6, 0x6e, 0x2c, 0xde, 0x07, 0x40, 0x61, 0x4d, 0xac, 0x38, 0x74, 0xce, 0xf4, 0xac, 0x99,
+	0xb8, 0x8c, 0xa9, 0x63, 0x3f, 0x26, 0x92, 0xb8, 0x07, 0x6d, 0x9a, 0x3d, 0xe9, 0x5a, 0xce, 0x36,
+	0x90, 0xee, 0x4f, 0xb6, 0xa8, 0x8b, 0x1e, 0x71, 0x03, 0xea, 0xa4, 0x1f, 0xac, 0xa3, 0x1d, 0xa9,
+	0x21, 0x71, 0x1b, 0xba, 0x8e, 0x17, 0xa3, 0xfc, 0xc6, 0xf1, 0xc8, 0x56, 0x51, 0xa2, 0x40, 0x9d,
+	0x14, 0xbb, 0xa9, 0xa2, 0x58, 0x7c, 0x02, 0x2c, 0x84, 0x64, 0xc1, 0xda, 0x72, 0x25, 0x15, 0x14,
+	0x09, 0x87, 0x57, 0xa4, 0x3e, 0x7a, 0xc5, 0x3b, 0xd0, 0xc2, 0xef, 0x4b, 0x46, 0xd4, 0x69, 0x44,
+	0x9b, 0xbe, 0x46, 0xb3, 0x43, 0x02, 0x76, 0xd0, 0xdd, 0x91, 0x35, 0xa8, 0xa4, 0xac, 0x54, 0xd4,
+	0x36, 0x07, 0x50, 0xdb, 0x0d, 0x6d, 0x15, 0xce, 0x3d, 0x27, 0x02, 0xaa, 0xb6, 0x8a, 0xc6, 0x74,
+	0x84, 0x9b, 0x92, 0xda, 0xd9, 0xd9, 0xa9, 0xe4, 0xce, 0x8e, 0xf9, 0x57, 0x25, 0x68, 0xed, 0xfb,
+	0x61, 0xfc, 0x58, 0x45, 0x91, 0x75, 0xa4, 0xc4, 0x12, 0xd4, 0x7c, 0x9c, 0x56, 0x73, 0xd8, 0xc0,
+	0x3d, 0xd1, 0x3a, 0x92, 0xf1, 0x33, 0x72, 0x28, 0x5f, 0x2e, 0x07, 0xd4, 0x29, 0x3a, 0x75, 0x15,
+	0xad, 0x53, 0x08, 0x20, 0xaf, 0xfd, 0xc3, 0xc3, 0x48, 0x31, 0x2f, 0x6b, 0x52, 0x43, 0x97, 0xab,
+	0x66, 0xa6, 0x6e, 0xdd, 0x82, 0xba, 0xfd, 0x3f, 0x00, 0xdc, 0xf7, 0x77, 0xd4, 0x0e, 0xf3, 0x18,
+	0x5a, 0xd2, 0x3a, 0x8c, 0xef, 0xfb, 0x5e, 0xac, 0xce, 0x62, 0xd1, 0x85, 0xb2, 0x63, 0x13, 0xeb,
+	0xea, 0xb2, 0xec, 0xd8, 0xb8, 0xe9, 0xa3, 0xd0, 0x9f, 0x06, 0xc4, 0xb9, 0x8e, 0x64, 0x80, 0x58,
+	0x6c, 0xdb, 0x61, 0xbf, 0xa2, 0x59, 0x6c, 0xdb, 0xa1, 0x58, 0x82, 0x56, 0xe4, 0x59, 0x41, 0x74,
+	0xec, 0xc7, 0xb8, 0xe9, 0x2a, 0x6d, 0x1a, 0x12, 0xd4, 0x30, 0x32, 0x7f, 0x59, 0x81, 0xfa, 0x63,
+	0x35, 0x39, 0x50, 0xe1, 0x85, 0x55, 0xee, 0x41, 0x93, 0x26, 0x1e, 0x39, 0x36, 0x2f, 0xb4, 0xf1,
+	0xda, 0xf3, 0x67, 0x4b, 0x57, 0x09, 0xb7, 0x65, 0x7f, 0xec, 0x4f, 0x9c, 0x58, 0x4d, 0x82, 0xf8,
+	0x5c, 0x36, 0x34, 0x6a, 0xee, 0x0e, 0x6e, 0x40, 0xdd, 0x55, 0x16, 0xca, 0x8a, 0xd5, 0x52, 0x43,
+	0xe2, 0x0e, 0x34, 0xac, 0xc9, 0xc8, 0x56, 0x96, 0x4d, 0x56, 0xad, 0xb9, 0x71, 0xfd, 0xf9, 0xb3,
+	0xa5, 0x9e, 0x35, 0xd9, 0x54, 0x56, 0x7e, 0xee, 0x3a, 0x63, 0xc4, 0x67, 0xa8, 0x8b, 0x51, 0x3c,
+	0x9a, 0x06, 0xb6, 0x15, 0x2b, 0xb2, 0x71, 0xd5, 0x8d, 0xfe, 0xf3, 0x67, 0x4b, 0xd7, 0x11, 0xfd,
+	0x84, 0xb0, 0xb9, 0x61, 0x90, 0x61, 0x71, 0x57, 0xdf, 0xf8, 0x1e, 0x1b, 0x3b, 0x43, 0x52, 0x5b,
+	0x6c, 0xc1, 0xd5, 0xb1, 0x3b, 0x8d, 0xd0, 0x1c, 0x3b, 0xde, 0xa1, 0x3f, 0xf2, 0x3d, 0xf7, 0x9c,
+	0x44, 0xda, 0xdc, 0x78, 0xfb, 0xf9, 0xb3, 0xa5, 0x37, 0x34, 0x71, 0xcb, 0x3b, 0xf4, 0x77, 0x3d,
+	0xf7, 0x3c, 0x37, 0xf3, 0xc2, 0x0c, 0x49, 0xfc, 0x08, 0xba, 0x87, 0x7e, 0x38, 0x56, 0xa3, 0x94,
+	0x59, 0xa4, 0x02, 0x1b, 0x8b, 0xcf, 0x9f, 0x2d, 0xdd, 0x20, 0xca, 0xc3, 0x0b, 0x1c, 0x6b, 0xe7,
+	0xf1, 0xe6, 0xbf, 0x96, 0xa1, 0x46, 0x6d, 0x71, 0x0f, 0x1a, 0x13, 0x12, 0x46, 0x62, 0x91, 0x6e,
+	0xa0, 0x76, 0x10, 0x6d, 0x95, 0xa5, 0x14, 0x0d, 0xbc, 0x38, 0x3c, 0x97, 0x49, 0x37, 0x1c, 0x11,
+	0x5b, 0x07, 0xae, 0x8a, 0xa3, 0x7e, 0x79, 0x76, 0xc4, 0x90, 0x09, 0x7a, 0x84, 0xee, 0x36, 0xab,
+	0x12, 0x95, 0x59, 0x95, 0x10, 0x8b, 0xd0, 0x1c, 0x1f, 0xab, 0xf1, 0x49, 0x34, 0x9d, 0x68, 0x85,
+	0x49, 0x61, 0x71, 0x0b, 0x3a, 0xd4, 0x0e, 0x7c, 0xc7, 0xa3, 0xe1, 0x35, 0xea, 0xd0, 0xce, 0x90,
+	0xc3, 0x68, 0xf1, 0x01, 0xb4, 0xf3, 0x9b, 0xc5, 0x0b, 0xfc, 0x44, 0x9d, 0x93, 0x66, 0x55, 0x25,
+	0x36, 0xc5, 0x32, 0xd4, 0xc8, 0xb4, 0x91, 0x5e, 0xb5, 0xd6, 0x00, 0xf7, 0xcc, 0x43, 0x24, 0x13,
+	0x3e, 0x2f, 0x7f, 0xbf, 0x84, 0xf3, 0xe4, 0x3f, 0x21, 0x3f, 0x8f, 0x71, 0xf9, 0x3c, 0x3c, 0x24,
+	0x37, 0x8f, 0xe9, 0x43, 0x63, 0xdb, 0x19, 0x2b, 0x2f, 0x22, 0x5d, 0x98, 0x46, 0x2a, 0x35, 0x43,
+	0xd8, 0xc6, 0xef, 0x9d, 0x58, 0x67, 0x3b, 0xbe, 0xad, 0x22, 0x9a, 0xa7, 0x2a, 0x53, 0x18, 0x69,
+	0xea, 0x2c, 0x70, 0xc2, 0xf3, 0x21, 0x73, 0xaa, 0x22, 0x53, 0x18, 0xef, 0x51, 0xe5, 0xe1, 0x62,
+	0x76, 0x72, 0x65, 0x6b, 0xd0, 0xfc, 0xaf, 0x2a, 0xb4, 0x7f, 0xae, 0x42, 0x7f, 0x2f, 0xf4, 0x03,
+	0x3f, 0xb2, 0x5c, 0xb1, 0x5e, 0xe4, 0x39, 0xcb, 0x76, 0x19, 0x77, 0x9b, 0xef, 0xb6, 0xba, 0x9f,
+	0x0a, 0x81, 0x65, 0x96, 0x97, 0x8a, 0x09, 0x75, 0x96, 0xf9, 0x1c, 0x9e, 0x69, 0x0a, 0xf6, 0x61,
+	0x29, 0xf7, 0x2b, 0x59, 0x1f, 0xcd, 0x0f, 0x4d, 0x11, 0x37, 0x01, 0x26, 0xd6, 0xd9, 0xb6, 0xb2,
+	0x22, 0xb5, 0x65, 0x27, 0x06, 0x21, 0xc3, 0x68, 0x6e, 0x0c, 0xcf, 0xbc, 0x61, 0x22, 0xdc, 0x14,
+	0x16, 0x6f, 0x81, 0x31, 0xb1, 0xce, 0xd0, 0x32, 0x6d, 0xd9, 0x7c, 0x04, 0x65, 0x86, 0x10, 0xef,
+	0x40, 0x25, 0x3e, 0xf3, 0xfa, 0x0d, 0xed, 0x35, 0xa0, 0x13, 0x39, 0x3c, 0xf3, 0xb4, 0x0d, 0x93,
+	0x48, 0x43, 0x09, 0x8e, 0x1d, 0x9b, 0x9c, 0x04, 0x43, 0x62, 0x53, 0xdc, 0x86, 0x86, 0xcb, 0xb2,
+	0x21, 0x47, 0xa0, 0xb5, 0xd6, 0x62, 0x7b, 0x48, 0x28, 0x99, 0xd0, 0xc4, 0xc7, 0xd0, 0x4c, 0x78,
+	0xd1, 0x6f, 0x51, 0xbf, 0x5e, 0xc2, 0xbd, 0x84, 0x69, 0x32, 0xed, 0x21, 0xde, 0x82, 0x4a, 0xe0,
+	0x78, 0xfd, 0xf6, 0x05, 0x26, 0x20, 0x5a, 0x7c, 0x09, 0xdd, 0x54, 0x18, 0xc7, 0xbe, 0x6b, 0xa3,
+	0x2d, 0x47, 0x79, 0xdc, 0xba, 0x54, 0x1e, 0x5f, 0x60, 0x2f, 0x16, 0x49, 0x27, 0xca, 0xe3, 0x16,
+	0x7f, 0x08, 0x0b, 0x33, 0x42, 0xcb, 0x6b, 0x69, 0x87, 0xb5, 0xf4, 0x7a, 0x5e, 0x4b, 0xab, 0x79,
+	0x0d, 0xff, 0x11, 0x88, 0x8b, 0x6b, 0xcc, 0x39, 0x2f, 0x85, 0x19, 0x2a, 0xb9, 0x19, 0xbe, 0xac,
+	0x36, 0x9b, 0x3d, 0xc3, 0xfc, 0xe3, 0x1a, 0x2c, 0xe8, 0x23, 0x77, 0xec, 0x04, 0xfb, 0x31, 0x9a,
+	0xbd, 0x3e, 0x34, 0xe8, 0x32, 0xd3, 0xda, 0x5e, 0x95, 0x09, 0x28, 0x7e, 0x07, 0xea, 0x64, 0xab,
+	0x12, 0x93, 0xb1, 0x94, 0xa9, 0x52, 0x3a, 0x9c, 0x4d, 0x88, 0xfe, 0x68, 0xdd, 0x5d, 0x7c, 0x0f,
+	0x6a, 0xdf, 0xa8, 0xd0, 0xe7, 0xcb, 0xb9, 0xb5, 0x76, 0x73, 0xde, 0x38, 0x64, 0xa0, 0x1e, 0xc6,
+	0x9d, 0x7f, 0x8b, 0x1a, 0xf7, 0x2e, 0x5e, 0xc7, 0x13, 0xff, 0x54, 0xd9, 0xfd, 0xc6, 0x72, 0x25,
+	0x91, 0xb5, 0x3e, 0x14, 0x09, 0x29, 0x51, 0xba, 0xe6, 0x5c, 0xa5, 0x33, 0x5e, 0xa0, 0x74, 0x26,
+	0xd4, 0x03, 0xc7, 0xf3, 0x94, 0xdd, 0x87, 0xe5, 0xca, 0x8c, 0x26, 0x69, 0x8a, 0x78, 0x7c, 0x41,
+	0x99, 0x5a, 0xd4, 0xf7, 0xbd, 0x79, 0xbc, 0x79, 0xb9, 0x3e, 0x6d, 0x42, 0x2b, 0xc7, 0xf8, 0x39,
+	0xba, 0xb4, 0x54, 0xb4, 0x78, 0x46, 0x6a, 0xed, 0xf3, 0x6a, 0xb5, 0x09, 0x90, 0x89, 0xe1, 0x37,
+	0x36, 0xbf, 0xff, 0x6b, 0xe5, 0x34, 0x7f, 0x59, 0x82, 0x85, 0xfb, 0xbe, 0xe7, 0x29, 0x8a, 0x40,
+	0x58, 0x2d, 0x33, 0x3b, 0x56, 0xba, 0xd4, 0x8e, 0x7d, 0x00, 0xb5, 0x08, 0x3b, 0xeb, 0xfd, 0x5d,
+	0x9b, 0xc3, 0x4b, 0xc9, 0x3d, 0xf0, 0x36, 0x9b, 0x58, 0x67, 0xa3, 0x40, 0x79, 0xb6, 0xe3, 0x1d,
+	0x25, 0xb7, 0xd9, 0xc4, 0x3a, 0xdb, 0x63, 0x8c, 0xf9, 0x9f, 0x15, 0x80, 0x2f, 0x94, 0xe5, 0xc6,
+	0xc7, 0x78, 0x63, 0xa3, 0xb2, 0x39, 0x5e, 0x14, 0x5b, 0xde, 0x38, 0x89, 0xff, 0x52, 0x18, 0x4f,
+	0x0c, 0xba, 0x2c, 0x2a, 0xe2, 0x7b, 0xc0, 0x90, 0x09, 0x88, 0x4e, 0x0c, 0x2e, 0x37, 0x8d, 0xb4,
+	0x6b, 0xa3, 0xa1, 0xcc, 0x11, 0xab, 0x12, 0x9a, 0x01, 0x9c, 0x07, 0xe3, 0x29, 0xc7, 0xf7, 0x48,
+	0x9f, 0x0d, 0x99, 0x80, 0x38, 0xcf, 0x34, 0x88, 0x9d, 0x09, 0x3b, 0x30, 0x15, 0xa9, 0x21, 0xdc,
+	0x15, 0x3a, 0x2c, 0x83, 0xf1, 0xb1, 0x4f, 0xf6, 0xb3, 0x22, 0x53, 0x18, 0x67, 0xf3, 0xbd, 0x23,
+	0x1f, 0xbf, 0xae, 0x49, 0x3e, 0x71, 0x02, 0xf2, 0xb7, 0xd8, 0xea, 0x0c, 0x49, 0x06, 0x91, 0x52,
+	0x18, 0xf9, 0xa2, 0xd4, 0xe8, 0x50, 0x59, 0xf1, 0x34, 0x54, 0x11, 0x29, 0xb0, 0x21, 0x41, 0xa9,
+	0x07, 0x1a, 0x23, 0xde, 0x81, 0x36, 0x32, 0xce, 0x8a, 0x22, 0xe7, 0x08, 0x55, 0xbc, 0x45, 0x9c,
+	0x43, 0x66, 0xae, 0x6b, 0x94, 0xf8, 0x18, 0x04, 0xf9, 0x5c, 0x56, 0x10, 0xb8, 0x8e, 0xb2, 0x47,
+	0x34, 0x39, 0x59, 0xd5, 0xaa, 0xec, 0x21, 0x65, 0x9d, 0x09, 0x5b, 0x88, 0x17, 0x1f, 0x40, 0x8f,
+	0x02, 0xfb, 0xb1, 0xef, 0x8e, 0x92, 0xcf, 0xef, 0x90, 0xe2, 0x2e, 0x24, 0xf8, 0xa7, 0x9a, 0x0d,
+	0xe8, 0x74, 0xbb, 0xfe, 0xf8, 0x84, 0x3c, 0xa5, 0x8a, 0x64, 0x00, 0x03, 0xe4, 0xd0, 0x3a, 0x8c,
+	0x47, 0xb1, 0x0a, 0x27, 0x14, 0xcc, 0x55, 0x65, 0x13, 0x11, 0x43, 0x15, 0x4e, 0xf0, 0x7b, 0x88,
+	0xa8, 0x7d, 0x49, 0x0e, 0xea, 0x00, 0x51, 0xdb, 0x84, 0x31, 0xff, 0xb9, 0x0a, 0x75, 0x3e, 0x9b,
+	0x05, 0xc7, 0xb5, 0xf4, 0x4a, 0x8e, 0xeb, 0x5b, 0x60, 0x04, 0xa1, 0xb2, 0x9d, 0x71, 0xa2, 0x74,
+	0x86, 0xcc, 0x10, 0x14, 0x61, 0xa2, 0xbf, 0x46, 0xc2, 0x6f, 0x4a, 0x06, 0xc4, 0x0f, 0xa1, 0xe3,
+	0x7b, 0x23, 0xdb, 0x89, 0x4e, 0x46, 0x07, 0xe7, 0xb1, 0x8a, 0x58, 0x70, 0x1b, 0x6f, 0x3c, 0x7f,
+	0xb6, 0xf4, 0x9a, 0xef, 0x6d, 0x3a, 0xd1, 0xc9, 0x06, 0xa2, 0x73, 0xcb, 0xb5, 0x72, 0x68, 0x54,
+	0x05, 0x36, 0x50, 0x64, 0x98, 0x9a, 0x52, 0x43, 0xe2, 0x53, 0x30, 0x28, 0xc4, 0x20, 0x8f, 0xd4,
+	0x20, 0x4f, 0xf2, 0xc6, 0xf3, 0x67, 0x4b, 0x02, 0x91, 0x33, 0xae, 0x68, 0x33, 0xc1, 0xa1, 0x33,
+	0x8d, 0x83, 0xd1, 0xb7, 0x00, 0xf2, 0x8c, 0xc9, 0x99, 0x46, 0xd4, 0x30, 0xbf, 0x81, 0x3a, 0x63,
+	0xc4, 0x0e, 0x88, 0xa9, 0x37, 0xf6, 0x27, 0x01, 0x2a, 0xb7, 0xb2, 0xf5, 0xfe, 0x5b, 0xb4, 0xff,
+	0xa5, 0xe7, 0xcf, 0x96, 0xde, 0xcc, 0x53, 0x67, 0xbf, 0xe2, 0xea, 0x05, 0x22, 0xee, 0xf9, 0x44,
+	0x9d, 0xeb, 0xf4, 0x45, 0x9b, 0xa6, 0xa1, 0x3d, 0x9f, 0xa8, 0x73, 0xca, 0x61, 0xe4, 0xf7, 0x9c,
+	0xe0, 0xd0, 0xa3, 0x8f, 0x02, 0xd7, 0x89, 0xf5, 0xb0, 0x0e, 0x0d, 0x23, 0x8f, 0x9e, 0xd0, 0xb3,
+	0x03, 0x21, 0xc3, 0xa6, 0x3c, 0x0a, 0x51, 0x5c, 0xa8, 0x43, 0xa5, 0x8c, 0x47, 0xb2, 0x18, 0x08,
+	0x34, 0x13, 0x9c, 0xf8, 0xff, 0x00, 0x5f, 0x87, 0x4e, 0xac, 0x78, 0xd4, 0x02, 0x8d, 0x7a, 0xfd,
+	0xf9, 0xb3, 0xa5, 0x6b, 0x84, 0x9d, 0x19, 0x66, 0xa4, 0x48, 0xf3, 0x9f, 0xca, 0xd0, 0xde, 0x74,
+	0x42, 0x35, 0x8e, 0x95, 0x3d, 0xb0, 0x8f, 0x14, 0x4a, 0x4e, 0x79, 0xb1, 0x13, 0x9f, 0xeb, 0x58,
+	0x49, 0x43, 0x69, 0x88, 0x5b, 0x2e, 0xa6, 0x82, 0xd8, 0x36, 0x56, 0x28, 0x7b, 0xc5, 0x80, 0x58,
+	0x03, 0xa0, 0x06, 0x67, 0xb0, 0xaa, 0x97, 0x67, 0xb0, 0x0c, 0xea, 0x86, 0x4d, 0xcc, 0x10, 0xf1,
+	0x18, 0x87, 0x03, 0xa6, 0x3a, 0xa5, 0xb7, 0xa6, 0x78, 0x81, 0x52, 0xcc, 0x7c, 0xa0, 0x5c, 0x32,
+	0x2a, 0x14, 0x33, 0x1f, 0x28, 0x37, 0xcd, 0x54, 0xe8, 0xb0, 0x07, 0xdb, 0xe2, 0x16, 0x94, 0xfd,
+	0xa0, 0xdf, 0xcc, 0x16, 0xcc, 0x7f, 0xd8, 0xea, 0x6e, 0x20, 0xcb, 0x7e, 0x80, 0x16, 0x9a, 0xd3,
+	0x35, 0x64, 0x54, 0xd0, 0x42, 0xa3, 0x2b, 0x47, 0x49, 0x02, 0xa9, 0x29, 0xc2, 0x84, 0xb6, 0xe5,
+	0xba, 0xfe, 0xd7, 0xca, 0xde, 0x0b, 0x95, 0x9d, 0xd8, 0x97, 0x02, 0xce, 0xbc, 0x01, 0xe5, 0xdd,
+	0x40, 0x34, 0xa0, 0xb2, 0x3f, 0x18, 0xf6, 0xae, 0x60, 0x63, 0x73, 0xb0, 0xdd, 0x2b, 0x99, 0xdf,
+	0x96, 0xc1, 0x78, 0x3c, 0x8d, 0x2d, 0xbc, 0x13, 0x22, 0xfc, 0xae, 0xe2, 0x61, 0xcd, 0x4e, 0xe5,
+	0x1b, 0xd0, 0x8c, 0x62, 0x2b, 0x24, 0x97, 0x99, 0x5d, 0xa7, 0x06, 0xc1, 0xc3, 0x48, 0xbc, 0x07,
+	0x35, 0x65, 0x1f, 0xa9, 0xc4, 0x13, 0xe9, 0xcd, 0x7e, 0x8b, 0x64, 0xb2, 0x58, 0x81, 0x7a, 0x34,
+	0x3e, 0x56, 0x13, 0xab, 0x5f, 0xcd, 0x3a, 0xee, 0x13, 0x86, 0xa3, 0x43, 0xa9, 0xe9, 0xe2, 0x5d,
+	0xa8, 0xa1, 0x34, 0xa2, 0x7e, 0x3d, 0x4b, 0x8c, 0x20, 0xe3, 0x75, 0x37, 0x26, 0xe2, 0x41, 0xb3,
+	0x43, 0x3f, 0x18, 0xf9, 0x01, 0xf1, 0xb5, 0xbb, 0x76, 0x9d, 0xee, 0xa6, 0xe4, 0x6b, 0x56, 0x37,
+	0x43, 0x3f, 0xd8, 0x0d, 0x64, 0xdd, 0xa6, 0x5f, 0xcc, 0x74, 0x51, 0x77, 0xd6, 0x01, 0xf6, 0x40,
+	0x0c, 0xc4, 0x70, 0x66, 0x73, 0x05, 0x9a, 0x13, 0x15, 0x5b, 0xb6, 0x15, 0x5b, 0xda, 0x11, 0xa1,
+	0xec, 0xca, 0x63, 0x8d, 0x93, 0x29, 0xd5, 0xbc, 0x0b, 0x75, 0x9e, 0x5a, 0x34, 0xa1, 0xba, 0xb3,
+	0xbb, 0x33, 0x60, 0x86, 0xae, 0x6f, 0x6f, 0xf7, 0x4a, 0x88, 0xda, 0x5c, 0x1f, 0xae, 0xf7, 0xca,
+	0xd8, 0x1a, 0xfe, 0x6c, 0x6f, 0xd0, 0xab, 0x98, 0xff, 0x58, 0x82, 0x66, 0x32, 0x8f, 0xf8, 0x1c,
+	0x00, 0xad, 0xd9, 0xe8, 0xd8, 0xf1, 0xd2, 0xe8, 0xe3, 0xcd, 0xfc, 0x4a, 0xab, 0x28, 0xb1, 0x2f,
+	0x90, 0xca, 0x5e, 0x89, 0x11, 0x24, 0xf0, 0xe2, 0x3e, 0x74, 0x8b, 0xc4, 0x39, 0x61, 0xd8, 0x47,
+	0x79, 0x0f, 0xa0, 0xbb, 0xf6, 0x5a, 0x61, 0x6a, 0x1c, 0x49, 0xca, 0x9c, 0x73, 0x0c, 0xee, 0x40,
+	0x33, 0x41, 0x8b, 0x16, 0x34, 0x36, 0x07, 0x0f, 0xd6, 0x9f, 0x6c, 0xa3, 0x92, 0x00, 0xd4, 0xf7,
+	0xb7, 0x76, 0x1e, 0x6e, 0x0f, 0xf8, 0xb3, 0xb6, 0xb7, 0xf6, 0x87, 0xbd, 0xb2, 0xf9, 0x37, 0x25,
+	0x68, 0x26, 0xae, 0x88, 0xf8, 0x00, 0xfd, 0x5a, 0x8a, 0x29, 0xfa, 0xa5, 0x2c, 0x41, 0x99, 0x4b,
+	0x97, 0xc8, 0x84, 0x8e, 0x07, 0x83, 0xef, 0x2c, 0xed, 0x78, 0x13, 0x90, 0x4f, 0xe2, 0x54, 0x0a,
+	0x49, 0x1c, 0xcc, 0x47, 0xf9, 0x1e, 0x1f, 0x48, 0xcc, 0x47, 0x61, 0xa2, 0x00, 0x75, 0xd0, 0xf1,
+	0xc6, 0x2a, 0x8b, 0x75, 0x1b, 0x04, 0x0f, 0x23, 0x8c, 0x85, 0x43, 0x15, 0x4d, 0x27, 0x68, 0x51,
+	0xbc, 0x23, 0xad, 0x39, 0x6d, 0xd9, 0x66, 0xa4, 0x24, 0x9c, 0x19, 0x73, 0x24, 0x98, 0xee, 0x3e,
+	0xdd, 0x52, 0x29, 0xbf, 0xa5, 0x0b, 0x61, 0x75, 0xf9, 0x62, 0x58, 0x9d, 0x79, 0x45, 0xb5, 0x97,
+	0x79, 0x45, 0xe6, 0x5f, 0x56, 0xa1, 0x2b, 0x55, 0x14, 0xfb, 0xa1, 0x92, 0xea, 0xab, 0xa9, 0x8a,
+	0xe2, 0x17, 0x9d, 0xb3, 0xb7, 0x01, 0x42, 0xee, 0x9c, 0x2d, 0x6d, 0x68, 0x0c, 0xe7, 0x03, 0x5c,
+	0x7f, 0x4c, 0x0a, 0xae, 0xdd, 0x9f, 0x14, 0xc6, 0x3b, 0xfb, 0xc0, 0x1a, 0x9f, 0xf0, 0xb4, 0xec,
+	0x04, 0x35, 0x19, 0xc1, 0xf3, 0x5a, 0xe3, 0xb1, 0x8a, 0xa2, 0x11, 0xea, 0x0b, 0xbb, 0x42, 0x06,
+	0x63, 0x1e, 0xa9, 0x73, 0x24, 0x47, 0x6a, 0x1c, 0xaa, 0x98, 0xc8, 0x6c, 0xbb, 0x0c, 0xc6, 0x20,
+	0xf9, 0x16, 0x74, 0x22, 0x15, 0xa1, 0xbf, 0x30, 0x8a, 0xfd, 0x13, 0xe5, 0x69, 0x43, 0xd6, 0xd6,
+	0xc8, 0x21, 0xe2, 0xf0, 0xe2, 0xb6, 0x3c, 0xdf, 0x3b, 0x9f, 0xf8, 0xd3, 0x48, 0x5f, 0xa4, 0x19,
+	0x42, 0xac, 0xc2, 0x35, 0xe5, 0x8d, 0xc3, 0xf3, 0x00, 0xf7, 0x8a, 0xab, 0x60, 0x96, 0x5a, 0xe9,
+	0xf0, 0xf3, 0x6a, 0x46, 0x7a, 0xa4, 0xce, 0x1f, 0x38, 0xae, 0xc2, 0x1d, 0x9d, 0x5a, 0x53, 0x37,
+	0x1e, 0x51, 0x16, 0x0b, 0x78, 0x47, 0x84, 0x59, 0xc7, 0x54, 0xd6, 0x87, 0x70, 0x95, 0xc9, 0xa1,
+	0xef, 0x2a, 0xc7, 0xe6, 0xc9, 0x5a, 0xd4, 0x6b, 0x81, 0x08, 0x92, 0xf0, 0x34, 0xd5, 0x2a, 0x5c,
+	0xe3, 0xbe, 0xfc, 0x41, 0x49, 0xef, 0x36, 0x2f, 0x4d, 0xa4, 0x7d, 0x4d, 0x29, 0x2e, 0x1d, 0x58,
+	0xf1, 0x71, 0xbf, 0x93, 0x5b, 0x7a, 0xcf, 0x8a, 0x8f, 0xd1, 0xfd, 0x61, 0xf2, 0xa1, 0xa3, 0x5c,
+	0xce, 0x30, 0x19, 0x92, 0x47, 0x3c, 0x40, 0x0c, 0xba, 0x73, 0xba, 0x83, 0x1f, 0x4e, 0x2c, 0x4e,
+	0x86, 0x1b, 0x92, 0x07, 0x3d, 0x20, 0x14, 0x2e, 0xa1, 0x65, 0xe5, 0xe9, 0xb4, 0x78, 0x55, 0x6a,
+	0xe9, 0xed, 0x4c, 0x27, 0xe6, 0x9f, 0x57, 0xa0, 0x99, 0x26, 0x2c, 0x3e, 0x02, 0x63, 0x92, 0x18,
+	0x35, 0xed, 0x85, 0x77, 0x0a, 0x96, 0x4e, 0x66, 0x74, 0xf1, 0x36, 0x94, 0x4f, 0x4e, 0xb5, 0x81,
+	0xed, 0xac, 0x72, 0x71, 0x28, 0x38, 0x58, 0x5b, 0x7d, 0xf4, 0x54, 0x96, 0x4f, 0x4e, 0xbf, 0x83,
+	0xde, 0x8a, 0xf7, 0x61, 0x61, 0xec, 0x2a, 0xcb, 0x1b, 0x65, 0xde, 0x18, 0xeb, 0x45, 0x97, 0xd0,
+	0x7b, 0x09, 0x56, 0xdc, 0x86, 0x9a, 0xad, 0xdc, 0xd8, 0xca, 0xd7, 0x28, 0x76, 0x43, 0x6b, 0xec,
+	0xaa, 0x4d, 0x44, 0x4b, 0xa6, 0xa2, 0x81, 0x4d, 0xd3, 0x06, 0x39, 0x03, 0x3b, 0x27, 0x65, 0x90,
+	0x9e, 0x4b, 0xc8, 0x9f, 0xcb, 0x8f, 0xe0, 0xaa, 0x3a, 0x0b, 0xe8, 0x56, 0x19, 0xa5, 0x39, 0x31,
+	0xf6, 0x94, 0x7b, 0x09, 0xe1, 0xbe, 0xc6, 0x8b, 0x8f, 0xa1, 0xa1, 0x0f, 0x8d, 0xce, 0x3c, 0x08,
+	0x32, 0x4c, 0x85, 0x63, 0x28, 0x93, 0x2e, 0xb8, 0xe0, 0x81, 0x15, 0x8f, 0x8f, 0x29, 0xf9, 0xd0,
+	0x96, 0x0c, 0xa0, 0x9c, 0x03, 0x92, 0x81, 0xb2, 0x47, 0x56, 0xac, 0xfd, 0x63, 0x48, 0x50, 0xeb,
+	0xf1, 0x97, 0xd5, 0x66, 0xa3, 0xd7, 0x34, 0xc7, 0x50, 0x79, 0xf4, 0x74, 0x9f, 0x0c, 0x16, 0xde,
+	0x1d, 0x35, 0x72, 0x2e, 0xa8, 0x9d, 0x1a, 0xb1, 0x72, 0xce, 0x88, 0xdd, 0x64, 0xfb, 0x4f, 0xac,
+	0x4b, 0x32, 0xeb, 0x39, 0x0c, 0xee, 0x85, 0xef, 0xbe, 0x2a, 0x91, 0x18, 0x30, 0xff, 0xbb, 0x02,
+	0x0d, 0xed, 0x90, 0xa0, 0xcd, 0x9f, 0xa6, 0xc9, 0x61, 0x6c, 0x16, 0xa3, 0xbe, 0xd4, 0xb3, 0xc9,
+	0x57, 0xe6, 0x2a, 0x2f, 0xaf, 0xcc, 0x89, 0xcf, 0xa1, 0x1d, 0x30, 0x2d, 0xef, 0x0b, 0xbd, 0x9e,
+	0x1f, 0xa3, 0x7f, 0x69, 0x5c, 0x2b, 0xc8, 0x00, 0xb4, 0x68, 0x54, 0x9e, 0x88, 0xad, 0x23, 0xcd,
+	0x81, 0x06, 0xc2, 0x43, 0xeb, 0xe8, 0x12, 0x8f, 0xe8, 0x55, 0x1c, 0x9b, 0x2e, 0x79, 0x48, 0x6d,
+	0x32, 0x90, 0xe8, 0x0c, 0xe5, 0x7d, 0x90, 0x4e, 0xd1, 0x07, 0x79, 0x13, 0x8c, 0xb1, 0x3f, 0x99,
+	0x38, 0x44, 0xeb, 0xea, 0x44, 0x29, 0x21, 0x86, 0x91, 0xf9, 0xfb, 0x25, 0x68, 0xe8, 0xaf, 0xbd,
+	0x70, 0xc3, 0x6d, 0x6c, 0xed, 0xac, 0xcb, 0x9f, 0xf5, 0x4a, 0x78, 0x83, 0x6f, 0xed, 0x0c, 0x7b,
+	0x65, 0x61, 0x40, 0xed, 0xc1, 0xf6, 0xee, 0xfa, 0xb0, 0x57, 0xc1, 0x5b, 0x6f, 0x63, 0x77, 0x77,
+	0xbb, 0x57, 0x15, 0x6d, 0x68, 0x6e, 0xae, 0x0f, 0x07, 0xc3, 0xad, 0xc7, 0x83, 0x5e, 0x0d, 0xfb,
+	0x3e, 0x1c, 0xec, 0xf6, 0xea, 0xd8, 0x78, 0xb2, 0xb5, 0xd9, 0x6b, 0x20, 0x7d, 0x6f, 0x7d, 0x7f,
+	0xff, 0x27, 0xbb, 0x72, 0xb3, 0xd7, 0xa4, 0x9b, 0x73, 0x28, 0xb7, 0x76, 0x1e, 0xf6, 0x0c, 0x6c,
+	0xef, 0x6e, 0x7c, 0x39, 0xb8, 0x3f, 0xec, 0x81, 0xf9, 0x09, 0xb4, 0x72, 0x1c, 0xc4, 0xd1, 0x72,
+	0xf0, 0xa0, 0x77, 0x05, 0x97, 0x7c, 0xba, 0xbe, 0xfd, 0x04, 0x2f, 0xda, 0x2e, 0x00, 0x35, 0x47,
+	0xdb, 0xeb, 0x3b, 0x0f, 0x7b, 0x65, 0xf3, 0xc7, 0xd0, 0x7c, 0xe2, 0xd8, 0x1b, 0x14, 0x94, 0x09,
+	0xa8, 0x1e, 0x58, 0x91, 0xd2, 0xd7, 0x15, 0xb5, 0xd1, 0x01, 0xa6, 0xe3, 0x15, 0x69, 0xd9, 0x6b,
+	0x08, 0x79, 0xe5, 0x4d, 0x27, 0x23, 0xaa, 0xe6, 0x56, 0xf8, 0x8a, 0xf1, 0xa6, 0x93, 0x27, 0x58,
+	0xd0, 0x3d, 0x81, 0xc6, 0x13, 0xc7, 0xde, 0xb3, 0xc6, 0x27, 0x64, 0x86, 0x70, 0xea, 0x51, 0xe4,
+	0x7c, 0xa3, 0xf4, 0x55, 0x64, 0x10, 0x66, 0xdf, 0xf9, 0x46, 0x89, 0x77, 0xa1, 0x4e, 0x40, 0x92,
+	0x9c, 0xa2, 0x03, 0x9b, 0x6c, 0x47, 0x6a, 0x1a, 0x15, 0x53, 0x5d, 0xd7, 0x1f, 0x8f, 0x42, 0x75,
+	0xd8, 0x7f, 0x9d, 0x79, 0x4f, 0x08, 0xa9, 0x0e, 0xcd, 0x3f, 0x2c, 0xa5, 0xdf, 0x4c, 0x35, 0xbb,
+	0x25, 0xa8, 0x06, 0xd6, 0xf8, 0xa4, 0x5f, 0xca, 0x72, 0x3d, 0x7a, 0x33, 0x92, 0x08, 0xe2, 0x7d,
+	0x68, 0x6a, 0xc5, 0x4a, 0x56, 0x6d, 0xe5, 0x34, 0x50, 0xa6, 0xc4, 0xa2, 0xc8, 0x2b, 0x45, 0x91,
+	0x53, 0x92, 0x00, 0x63, 0x14, 0x3e, 0x46, 0x55, 0xa9, 0x21, 0xf3, 0x7b, 0x00, 0x59, 0xf9, 0x74,
+	0x8e, 0xf7, 0x74, 0x1d, 0x6a, 0x96, 0xeb, 0x58, 0x49, 0xd2, 0x81, 0x01, 0x73, 0x07, 0x5a, 0xd9,
+	0x28, 0xe2, 0xad, 0xe5, 0xba, 0x78, 0x87, 0x45, 0x34, 0xb6, 0x29, 0x1b, 0x96, 0xeb, 0x3e, 0x52,
+	0xe7, 0x11, 0x7a, 0xae, 0x5c, 0xaf, 0x2d, 0xcf, 0x94, 0xf4, 0x68, 0xa8, 0x64, 0xa2, 0xf9, 0x31,
+	0xd4, 0x1f, 0x24, 0xbe, 0x7b, 0x72, 0x0c, 0x4a, 0x97, 0x1d, 0x03, 0xf3, 0x33, 0x80, 0xac, 0x2a,
+	0x28, 0x3e, 0xd2, 0x75, 0xe1, 0x88, 0xab, 0xd0, 0xa5, 0x2c, 0x1b, 0xc6, 0x9d, 0x74, 0x49, 0x98,
+	0x3a, 0x9b, 0x9b, 0xd0, 0x7c, 0x61, 0xa5, 0x5d, 0x33, 0xa0, 0x9c, 0x31, 0x60, 0x4e, 0xed, 0xdd,
+	0xfc, 0x05, 0x40, 0x56, 0x3f, 0xd6, 0xa7, 0x92, 0x67, 0xc1, 0x53, 0xf9, 0x21, 0x96, 0x28, 0x1c,
+	0xd7, 0x0e, 0x95, 0x57, 0xf8, 0xea, 0x74, 0x84, 0x4c, 0xe9, 0x62, 0x19, 0xaa, 0x54, 0x16, 0xaf,
+	0x64, 0xf6, 0x3f, 0xd9, 0x9f, 0x24, 0x8a, 0x79, 0x06, 0x1d, 0x0e, 0x09, 0x5e, 0xc1, 0x57, 0x2a,
+	0x9a, 0xd2, 0xf2, 0x05, 0x53, 0x7a, 0x03, 0xea, 0x74, 0x45, 0x27, 0x5f, 0xa3, 0xa1, 0x4b, 0x4c,
+	0xec, 0x9f, 0x94, 0x01, 0x78, 0x69, 0x2c, 0x37, 0x14, 0xd3, 0x10, 0xa5, 0xd9, 0x34, 0x84, 0x80,
+	0x6a, 0xfa, 0xe2, 0xc1, 0x90, 0xd4, 0xce, 0xae, 0x2d, 0x9d, 0x9a, 0x20, 0x00, 0xe7, 0x21, 0x97,
+	0xc9, 0xf9, 0x46, 0x85, 0x7a, 0xc1, 0x0c, 0x91, 0xaf, 0xff, 0xd7, 0x8a, 0xf5, 0xff, 0xb4, 0x18,
+	0x5a, 0xe7, 0xd9, 0x08, 0x98, 0x57, 0xd7, 0xe5, 0x44, 0x56, 0xa4, 0xc2, 0x38, 0xc9, 0x5e, 0x30,
+	0x94, 0x06, 0x9d, 0x86, 0xee, 0x6b, 0x71, 0x2a, 0xca, 0xc3, 0xb7, 0x0d, 0xde, 0xa1, 0xeb, 0x8c,
+	0x63, 0x5d, 0xef, 0x07, 0xcf, 0xbf, 0xaf, 0x31, 0xa8, 0x11, 0x71, 0xec, 0xea, 0x7b, 0x15, 0x9b,
+	0xe6, 0xe7, 0xd0, 0x4e, 0x24, 0x42, 0x85, 0xd3, 0x0f, 0xd3, 0x30, 0xae, 0x94, 0x49, 0x3b, 0x63,
+	0xdc, 0x46, 0xb9, 0x5f, 0x4a, 0x02, 0x39, 0xf3, 0xf7, 0xaa, 0xc9, 0x60, 0x5d, 0xff, 0x7b, 0x31,
+	0x57, 0x8b, 0xb1, 0x78, 0xf9, 0x95, 0x62, 0xf1, 0xef, 0x83, 0x61, 0x53, 0xb0, 0xe9, 0x9c, 0x26,
+	0xd7, 0xdc, 0xe2, 0x6c, 0x60, 0xa9, 0xc3, 0x51, 0xe7, 0x54, 0xc9, 0xac, 0xf3, 0x4b, 0x24, 0x93,
+	0xf2, 0xbf, 0x36, 0x8f, 0xff, 0xf5, 0xdf, 0x90, 0xff, 0xef, 0x40, 0xdb, 0xf3, 0xbd, 0x91, 0x37,
+	0x75, 0x5d, 0xcc, 0x8f, 0x69, 0x01, 0xb4, 0x3c, 0xdf, 0xdb, 0xd1, 0x28, 0xf4, 0x6c, 0xf3, 0x5d,
+	0xf8, 0x98, 0xb7, 0xa8, 0xdf, 0x42, 0xae, 0x1f, 0x19, 0x83, 0x15, 0xe8, 0xf9, 0x07, 0xbf, 0xc0,
+	0xc7, 0x06, 0xc8, 0xb1, 0x11, 0x9d, 0x6f, 0x76, 0x6b, 0xbb, 0x8c, 0x47, 0x16, 0xed, 0xe0, 0x49,
+	0x9f, 0x11, 0x7c, 0xe7, 0x32, 0xc1, 0x77, 0x33, 0xc1, 0x7f, 0x06, 0x46, 0xca, 0xb7, 0x5c, 0xa8,
+	0x6b, 0x40, 0x6d, 0x6b, 0x67, 0x73, 0xf0, 0xd3, 0x5e, 0x09, 0x2f, 0x53, 0x39, 0x78, 0x3a, 0x90,
+	0xfb, 0x83, 0x5e, 0x19, 0x2f, 0xba, 0xcd, 0xc1, 0xf6, 0x60, 0x38, 0xe8, 0x55, 0xd8, 0x33, 0xa2,
+	0xf2, 0x9c, 0xeb, 0x8c, 0x9d, 0xd8, 0xdc, 0x07, 0xc8, 0xe2, 0x77, 0xb4, 0xdc, 0xd9, 0x76, 0x75,
+	0xe2, 0x37, 0x4e, 0x36, 0xba, 0x92, 0x1e, 0xda, 0xf2, 0x65, 0x59, 0x02, 0xa6, 0xe3, 0xf3, 0x91,
+	0xc7, 0x56, 0xf0, 0x05, 0x97, 0xb0, 0x6f, 0x43, 0x37, 0xb0, 0xc2, 0xd8, 0x49, 0xa2, 0x0b, 0x36,
+	0xa8, 0x6d, 0xd9, 0x49, 0xb1, 0x68, 0x9f, 0xcd, 0x5f, 0x97, 0xe0, 0xfa, 0x63, 0xff, 0x54, 0xa5,
+	0xde, 0xeb, 0x9e, 0x75, 0xee, 0xfa, 0x96, 0xfd, 0x12, 0xc5, 0xc4, 0xf0, 0xc8, 0x9f, 0x52, 0x61,
+	0x39, 0x29, 0xc0, 0x4b, 0x83, 0x31, 0x0f, 0xf5, 0x8b, 0x21, 0x15, 0xc5, 0x44, 0xd4, 0x97, 0x2d,
+	0xc2, 0x48, 0x7a, 0x0d, 0xea, 0xf1, 0x99, 0x97, 0xd5, 0xfb, 0x6b, 0x31, 0xd5, 0x52, 0xe6, 0x3a,
+	0xb3, 0xb5, 0x4b, 0x9c, 0xd9, 0xb7, 0x30, 0xb0, 0xf3, 0xec, 0xaf, 0x1d, 0x3b, 0x3e, 0x4e, 0x0a,
+	0x2f, 0x29, 0xc2, 0xbc, 0x0f, 0xc6, 0xf0, 0x8c, 0x12, 0xfa, 0xd3, 0xa8, 0xe0, 0x22, 0x95, 0x5e,
+	0xe0, 0x22, 0x95, 0x67, 0x5c, 0xa4, 0xff, 0x28, 0x41, 0x2b, 0xe7, 0xb3, 0x8b, 0x77, 0xa0, 0x1a,
+	0x9f, 0x79, 0xc5, 0xb7, 0x38, 0xc9, 0x22, 0x92, 0x48, 0x17, 0x92, 0xd6, 0xe5, 0x8b, 0x49, 0xeb,
+	0x6d, 0x58, 0x60, 0xdb, 0x9d, 0x7c, 0x62, 0x92, 0x23, 0xba, 0x35, 0x13, 0x23, 0x70, 0xd9, 0x24,
+	0xf9, 0x60, 0x9d, 0xf8, 0xe8, 0x1e, 0x15, 0x90, 0x8b, 0xeb, 0x70, 0x6d, 0x4e, 0xb7, 0xef, 0x52,
+	0xe3, 0x33, 0x97, 0xa0, 0x83, 0xb5, 0x2c, 0x67, 0xa2, 0xa2, 0xd8, 0x9a, 0x04, 0xe4, 0x62, 0xea,
+	0xbb, 0xb7, 0x2a, 0xcb, 0x71, 0x64, 0xbe, 0x07, 0xed, 0x3d, 0xa5, 0x42, 0xa9, 0xa2, 0xc0, 0xf7,
+	0xd8, 0xbd, 0xd2, 0xc5, 0x06, 0xbe, 0xe8, 0x35, 0x64, 0xfe, 0x2e, 0x18, 0x98, 0xe5, 0xd8, 0xa0,
+	0x40, 0xe1, 0x3b, 0x64, 0x41, 0xde, 0x83, 0x46, 0xc0, 0x1a, 0xa7, 0x23, 0xb9, 0x36, 0x5d, 0xf8,
+	0x5a, 0x0b, 0x65, 0x42, 0x34, 0x3f, 0x81, 0x6b, 0xfb, 0xd3, 0x83, 0x68, 0x1c, 0x3a, 0x14, 0x14,
+	0x27, 0x97, 0xe1, 0x22, 0x34, 0x83, 0x50, 0x1d, 0x3a, 0x67, 0x2a, 0xd1, 0xef, 0x14, 0x36, 0x7f,
+	0x00, 0xd7, 0x8b, 0x43, 0xf4, 0x27, 0xdc, 0x82, 0xca, 0xc9, 0x69, 0xa4, 0x77, 0x76, 0xb5, 0x10,
+	0x12, 0xd2, 0x53, 0x17, 0xa4, 0x9a, 0x12, 0x2a, 0x3b, 0xd3, 0x49, 0xfe, 0x79, 0x5f, 0x95, 0x9f,
+	0xf7, 0xbd, 0x99, 0x4f, 0x81, 0x73, 0x1c, 0x93, 0xa5, 0xba, 0xdf, 0x02, 0xe3, 0xd0, 0x0f, 0xbf,
+	0xb6, 0x42, 0x5b, 0xd9, 0xfa, 0xd6, 0xcb, 0x10, 0xe6, 0xcf, 0xa1, 0x95, 0x68, 0xc2, 0x96, 0x4d,
+	0x25, 0x7a, 0x52, 0xc5, 0x2d, 0xbb, 0xa0, 0x99, 0x9c, 0x33, 0x55, 0x9e, 0xbd, 0x95, 0xa8, 0x10,
+	0x03, 0xc5, 0x95, 0x75, 0x2d, 0x32, 0x59, 0xd9, 0x7c, 0x00, 0xed, 0x24, 0x70, 0xc4, 0xe4, 0x16,
+	0x29, 0xb7, 0xeb, 0x28, 0x2f, 0xa7, 0xf8, 0x4d, 0x46, 0x0c, 0x8b, 0x69, 0xcd, 0x72, 0xc1, 0x85,
+	0x30, 0x57, 0xa1, 0xae, 0x4f, 0x8e, 0x80, 0xea, 0xd8, 0xb7, 0xf9, 0xec, 0xd7, 0x24, 0xb5, 0x91,
+	0x1d, 0x93, 0xe8, 0x28, 0x71, 0x8f, 0x26, 0xd1, 0x91, 0xf9, 0xb7, 0x65, 0xe8, 0x6c, 0x50, 0x98,
+	0x9e, 0x88, 0x24, 0x97, 0xc1, 0x2a, 0x15, 0x32, 0x58, 0xf9, 0x6c, 0x55, 0xb9, 0x98, 0xad, 0xca,
+	0x6f, 0xa8, 0x52, 0xf4, 0x69, 0x5e, 0x87, 0xc6, 0xd4, 0x73, 0xce, 0x12, 0x83, 0x61, 0xc8, 0x3a,
+	0x82, 0xc3, 0x48, 0x2c, 0x43, 0x0b, 0x6d, 0x8a, 0xe3, 0x71, 0xf2, 0x87, 0x33, 0x38, 0x79, 0xd4,
+	0x4c, 0x8a, 0xa7, 0xfe, 0xe2, 0x14, 0x4f, 0xe3, 0xa5, 0x29, 0x9e, 0xe6, 0xcb, 0x52, 0x3c, 0xc6,
+	0x6c, 0x8a, 0xa7, 0xe8, 0x8f, 0xc1, 0xac, 0x3f, 0x66, 0x6e, 0x43, 0x37, 0xe1, 0x9d, 0xd6, 0xcd,
+	0xcf, 0x61, 0x41, 0xa7, 0x70, 0x55, 0xa8, 0x13, 0x1c, 0x6c, 0x71, 0xae, 0x52, 0x12, 0x99, 0xb2,
+	0xac, 0x9a, 0x22, 0xbb, 0x76, 0x1e, 0x8c, 0xcc, 0x3f, 0x28, 0x41, 0xa7, 0xd0, 0x43, 0x7c, 0x92,
+	0x25, 0x84, 0x4b, 0xe4, 0x08, 0xf4, 0x2f, 0xcc, 0xf2, 0xe2, 0xa4, 0x70, 0x79, 0x26, 0x29, 0x6c,
+	0xde, 0x4e, 0x53, 0xbd, 0x3a, 0xc1, 0x7b, 0x25, 0x4d, 0xf0, 0x52, 0x4e, 0x74, 0x7d, 0x38, 0x94,
+	0xbd, 0xb2, 0xf9, 0xa7, 0x65, 0xe8, 0x0c, 0xce, 0x02, 0x7a, 0x5c, 0xf6, 0x52, 0xaf, 0x35, 0xa7,
+	0x30, 0xe5, 0x82, 0xc2, 0xe4, 0x44, 0x5f, 0xd1, 0x15, 0x49, 0x16, 0x3d, 0xfa, 0xb1, 0x9c, 0x49,
+	0xd2, 0x2a, 0xc1, 0xd0, 0xff, 0x01, 0x95, 0x40, 0x91, 0x27, 0x8c, 0xd1, 0x22, 0x7f, 0xa5, 0x73,
+	0xc6, 0x0f, 0x49, 0xdd, 0x34, 0x41, 0xc2, 0x80, 0xf9, 0x47, 0x65, 0x30, 0x58, 0x83, 0x70, 0x7b,
+	0x1f, 0x68, 0x1f, 0xbc, 0x94, 0x25, 0xba, 0x53, 0xe2, 0xea, 0x23, 0x75, 0x4e, 0x9e, 0x22, 0x75,
+	0x99, 0x5b, 0x0e, 0xd2, 0x69, 0x14, 0x8e, 0x1c, 0xb1, 0x89, 0x46, 0x84, 0x2f, 0xcf, 0xa9, 0x93,
+	0xbc, 0x8d, 0xe0, 0xdb, 0x14, 0x5f, 0x05, 0xa3, 0xc7, 0x8f, 0xc5, 0x50, 0xe6, 0x32, 0xb5, 0x8b,
+	0x3e, 0x7a, 0x47, 0xfb, 0x88, 0xe6, 0x31, 0x34, 0xf4, 0xea, 0xe8, 0x20, 0x3d, 0xd9, 0x79, 0xb4,
+	0xb3, 0xfb, 0x93, 0x9d, 0x82, 0xe6, 0xa4, 0x2e, 0x54, 0x39, 0xef, 0x42, 0x55, 0x10, 0x7f, 0x7f,
+	0xf7, 0xc9, 0xce, 0xb0, 0x57, 0x15, 0x1d, 0x30, 0xa8, 0x39, 0x92, 0x83, 0xa7, 0xbd, 0x1a, 0x65,
+	0x14, 0xee, 0x7f, 0x31, 0x78, 0xbc, 0xde, 0xab, 0xa7, 0x85, 0x85, 0x86, 0xf9, 0x67, 0x25, 0xb8,
+	0xca, 0x9f, 0x9c, 0x0f, 0xb1, 0xf3, 0x8f, 0xb8, 0xab, 0xfc, 0x88, 0xfb, 0xb7, 0x1b, 0x55, 0xe3,
+	0xa0, 0xa9, 0x93, 0x94, 0x2e, 0x39, 0xfd, 0x83, 0xef, 0xa4, 0xa9, 0x20, 0x69, 0xfe, 0x7d, 0x09,
+	0x16, 0xd9, 0x73, 0x7b, 0x88, 0x6f, 0xd6, 0x7f, 0xbc, 0x7d, 0x21, 0xbe, 0xbb, 0xcc, 0x63, 0xb9,
+	0x0d, 0x5d, 0x7a, 0xe6, 0xfe, 0x95, 0x3b, 0xd2, 0x11, 0x07, 0xcb, 0xaf, 0xa3, 0xb1, 0x3c, 0x91,
+	0xf8, 0x14, 0xda, 0xfc, 0x1c, 0x9e, 0x32, 0x95, 0x85, 0x32, 0x54, 0xc1, 0x6f, 0x6c, 0x71, 0x2f,
+	0x2a, 0x88, 0xe1, 0x13, 0x5c, 0x3d, 0x28, 0x0b, 0x05, 0x2f, 0x56, 0x9a, 0xf4, 0x90, 0x21, 0x05,
+	0x88, 0x77, 0xe1, 0xcd, 0xb9, 0xdf, 0xa1, 0x15, 0x3b, 0x97, 0x96, 0x63, 0x7d, 0x5a, 0xfb, 0xbb,
+	0x12, 0x54, 0xd1, 0x0b, 0x10, 0x77, 0xc0, 0xf8, 0x42, 0x59, 0x61, 0x7c, 0xa0, 0xac, 0x58, 0x14,
+	0x6e, 0xfc, 0x45, 0x5a, 0x31, 0x7b, 0x13, 0x61, 0x5e, 0xb9, 0x57, 0x12, 0xab, 0xfc, 0xe2, 0x34,
+	0x79, 0x60, 0xdb, 0x49, 0xbc, 0x09, 0xf2, 0x36, 0x16, 0x0b, 0xe3, 0xcd, 0x2b, 0x2b, 0xd4, 0xff,
+	0x4b, 0xdf, 0xf1, 0xee, 0xf3, 0x63, 0x48, 0x31, 0xeb, 0x7d, 0xcc, 0x8e, 0x10, 0x77, 0xa0, 0xbe,
+	0x15, 0xed, 0xa9, 0x79, 0x5d, 0x89, 0x6b, 0x79, 0x0f, 0xc8, 0xbc, 0xb2, 0xf6, 0x17, 0x15, 0xa8,
+	0x62, 0xdd, 0x04, 0x93, 0xaa, 0xfa, 0x05, 0x89, 0xc8, 0xbd, 0x14, 0x59, 0xa4, 0x08, 0x6d, 0xe6,
+	0x69, 0x09, 0xad, 0xd2, 0x63, 0x76, 0x65, 0xf9, 0x65, 0x91, 0x3d, 0x91, 0xb9, 0xb0, 0xa9, 0xcf,
+	0xa0, 0xb7, 0x1f, 0x87, 0xca, 0x9a, 0xe4, 0xba, 0x17, 0x59, 0x35, 0x2f, 0x59, 0x4d, 0xfc, 0xfa,
+	0x08, 0xea, 0xec, 0x4b, 0xce, 0x0c, 0x98, 0xcd, 0x44, 0x53, 0xe7, 0xf7, 0xa1, 0xb5, 0x7f, 0xec,
+	0x4f, 0x5d, 0x7b, 0x5f, 0x85, 0xa7, 0x4a, 0xe4, 0xde, 0x11, 0x2d, 0xe6, 0xda, 0xe6, 0x15, 0xb1,
+	0x02, 0xc0, 0xee, 0x0b, 0x26, 0xcd, 0x44, 0x03, 0x69, 0x3b, 0xd3, 0x09, 0x4f, 0x9a, 0xf3, 0x6b,
+	0xb8, 0x67, 0xce, 0xa5, 0x7c, 0x51, 0xcf, 0x4f, 0xa1, 0x73, 0x9f, 0x0e, 0xd3, 0x6e, 0xb8, 0x7e,
+	0xe0, 0x87, 0xb1, 0x98, 0x7d, 0x9a, 0xb7, 0x38, 0x8b, 0x30, 0xaf, 0xe0, 0x13, 0x8a, 0x61, 0x78,
+	0xce, 0xfd, 0xaf, 0x6a, 0x4f, 0x3c, 0x5b, 0x6f, 0xce, 0x57, 0xae, 0xfd, 0x4b, 0x0d, 0xea, 0x3f,
+	0xf1, 0xc3, 0x13, 0x85, 0x75, 0x92, 0x3a, 0xd5, 0x09, 0xb4, 0x1a, 0xa5, 0x35, 0x83, 0x79, 0x0b,
+	0xbd, 0x0b, 0x06, 0x31, 0x05, 0x5f, 0xdd, 0xb3, 0xa8, 0xe8, 0x7f, 0x15, 0xcc, 0x17, 0x8e, 0xfe,
+	0x49, 0xae, 0x5d, 0x16, 0x54, 0x5a, 0x47, 0x2b, 0xe4, 0xf1, 0x17, 0xe9, 0xfb, 0x1f, 0x3d, 0xdd,
+	0x47, 0xd5, 0xbc, 0x57, 0x42, 0x2b, 0xbd, 0xcf, 0x5f, 0x8a, 0x9d, 0xb2, 0x77, 0xe3, 0x8b, 0xdd,
+	0x04, 0x91, 0xce, 0x7c, 0x17, 0xea, 0xfa, 0x48, 0x5f, 0xcd, 0x0e, 0xaf, 0xb6, 0x13, 0x8b, 0xbd,
+	0x3c, 0x4a, 0x0f, 0xf8, 0x04, 0xea, 0x6c, 0xfe, 0x78, 0x40, 0xc1, 0x31, 0x5b, 0x14, 0x79, 0x54,
+	0xa2, 0xcc, 0xe2, 0x23, 0x68, 0xe8, 0x2a, 0x80, 0x98, 0x53, 0x12, 0xe0, 0x4f, 0x65, 0x8f, 0x90,
+	0xe7, 0xe7, 0xdb, 0x8b, 0xe7, 0x2f, 0x5c, 0xf1, 0x8b, 0x22, 0x8f, 0x4a, 0xe7, 0xbf, 0x03, 0x3d,
+	0xa9, 0xc6, 0xca, 0xc9, 0x85, 0x98, 0x22, 0xe1, 0xc8, 0x9c, 0xa3, 0xfb, 0x19, 0x74, 0x0a, 0xe1,
+	0xa8, 0x20, 0x97, 0x65, 0x5e, 0x84, 0x7a, 0xe1, 0xc0, 0xfc, 0x00, 0x0c, 0xed, 0xef, 0x1f, 0x28,
+	0x41, 0x59, 0xfa, 0x39, 0x11, 0xc3, 0xe2, 0x45, 0x87, 0x9f, 0x4e, 0xc1, 0x4f, 0xe1, 0xda, 0x1c,
+	0x5b, 0x26, 0xe8, 0x15, 0xe1, 0xe5, 0xc6, 0x7a, 0x71, 0xe9, 0x52, 0x7a, 0xca, 0x80, 0x7b, 0x20,
+	0x86, 0xa1, 0xe5, 0x45, 0x87, 0x2a, 0xe4, 0xd7, 0x40, 0x74, 0x92, 0xf3, 0xf6, 0x62, 0xf6, 0x43,
+	0x3e, 0x86, 0xee, 0xd0, 0x3a, 0x51, 0xaf, 0xd6, 0x7b, 0xa3, 0xf7, 0x0f, 0xdf, 0xde, 0x2c, 0xfd,
+	0xfa, 0xdb, 0x9b, 0xa5, 0x7f, 0xfb, 0xf6, 0x66, 0xe9, 0x57, 0xff, 0x7e, 0xf3, 0xca, 0x41, 0x9d,
+	0x9e, 0x34, 0x7d, 0xfa, 0x3f, 0x03, 0x00, 0xf1, 0x09, 0x79, 0xd4, 0x39, 0x35, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6192,6 +6396,8 @@ type WorkerClient interface {
 	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
 	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
 	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
+	TransferLeadership(ctx context.Context, in *Member, opts ...grpc.CallOption) (*api.Payload, error)
+	TakeLeadership(ctx context.Context, in *Member, opts ...grpc.CallOption) (*api.Payload, error)
 }
 
 type workerClient struct {
@@ -6380,6 +6586,24 @@ func (c *workerClient) UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQ
 	return out, nil
 }
 
+func (c *workerClient) TransferLeadership(ctx context.Context, in *Member, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/TransferLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TakeLeadership(ctx context.Context, in *Member, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/TakeLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServer is the server API for Worker service.
 type WorkerServer interface {
 	// Data serving RPCs.
@@ -6395,6 +6619,8 @@ type WorkerServer interface {
 	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
 	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
 	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
+	TransferLeadership(context.Context, *Member) (*api.Payload, error)
+	TakeLeadership(context.Context, *Member) (*api.Payload, error)
 }
 
 // UnimplementedWorkerServer can be embedded to have forward compatible implementations.
@@ -6437,6 +6663,12 @@ func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker
 func (*UnimplementedWorkerServer) UpdateGraphQLSchema(ctx context.Context, req *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGraphQLSchema not implemented")
 }
+func (*UnimplementedWorkerServer) TransferLeadership(ctx context.Context, req *Member) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
+func (*UnimplementedWorkerServer) TakeLeadership(ctx context.Context, req *Member) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeLeadership not implemented")
+}
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
 	s.RegisterService(&_Worker_serviceDesc, srv)
@@ -6677,6 +6909,42 @@ func _Worker_UpdateGraphQLSchema_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/TransferLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TransferLeadership(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_TakeLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TakeLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/TakeLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TakeLeadership(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -6717,6 +6985,14 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGraphQLSchema",
 			Handler:    _Worker_UpdateGraphQLSchema_Handler,
 		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _Worker_TransferLeadership_Handler,
+		},
+		{
+			MethodName: "TakeLeadership",
+			Handler:    _Worker_TakeLeadership_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6891,6 +7167,18 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Quorum {
+		i--
+		if m.Quorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.First != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.First))
 		i--
@@ -7284,6 +7572,16 @@ func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Quorum {
+		i--
+		if m.Quorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.ReadTs != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
 		i--
@@ -7465,6 +7763,13 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x68
 	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.LastUpdate != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
 		i--
@@ -7681,6 +7986,33 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SnapshotHolds) > 0 {
+		for k := range m.SnapshotHolds {
+			v := m.SnapshotHolds[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Pin != nil {
+		{
+			size, err := m.Pin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Snapshot != nil {
 		{
 			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
@@ -7805,6 +8137,35 @@ func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SnapshotHolds) > 0 {
+		for k := range m.SnapshotHolds {
+			v := m.SnapshotHolds[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Pinned) > 0 {
+		for iNdEx := len(m.Pinned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pinned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.License != nil {
 		{
 			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
@@ -7989,6 +8350,38 @@ func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RaftLeader {
+		i--
+		if m.RaftLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RaftTerm != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.RaftTerm))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Clock != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Clock))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LastAppliedIndex != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastAppliedIndex))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.MaxAssigned != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
 		i--
@@ -8093,12 +8486,34 @@ func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UncompressedBytes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.UncompressedBytes))
+	if m.WriteRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WriteRate))))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x79
 	}
-	if m.MoveTs != 0 {
+	if m.ReadRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReadRate))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if m.SplitCount != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SplitCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UncompressedBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UncompressedBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MoveTs != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
 		i--
 		dAtA[i] = 0x50
@@ -8424,6 +8839,15 @@ func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ResumeRanges) > 0 {
+		for iNdEx := len(m.ResumeRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResumeRanges[iNdEx])
+			copy(dAtA[i:], m.ResumeRanges[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.ResumeRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SinceTs != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
 		i--
@@ -8675,6 +9099,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ProposedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ProposedAt))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Batch) > 0 {
+		for iNdEx := len(m.Batch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Batch[iNdEx])
+			copy(dAtA[i:], m.Batch[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Batch[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.Restore != nil {
 		{
 			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
@@ -9464,6 +9902,11 @@ func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Ttl != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.NoConflict {
 		i--
 		if m.NoConflict {
@@ -9625,6 +10068,11 @@ func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Ttl != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.NoConflict {
 		i--
 		if m.NoConflict {
@@ -9839,6 +10287,11 @@ func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Bandwidth != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Bandwidth))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ExpectedChecksum != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
 		i--
@@ -11028,6 +11481,9 @@ func (m *Query) Size() (n int) {
 	if m.First != 0 {
 		n += 1 + sovPb(uint64(m.First))
 	}
+	if m.Quorum {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11171,6 +11627,9 @@ func (m *SortMessage) Size() (n int) {
 	if m.ReadTs != 0 {
 		n += 1 + sovPb(uint64(m.ReadTs))
 	}
+	if m.Quorum {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11245,6 +11704,10 @@ func (m *Member) Size() (n int) {
 	if m.LastUpdate != 0 {
 		n += 1 + sovPb(uint64(m.LastUpdate))
 	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
 	if m.ClusterInfoOnly {
 		n += 2
 	}
@@ -11376,6 +11839,18 @@ func (m *ZeroProposal) Size() (n int) {
 		l = m.Snapshot.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if m.Pin != nil {
+		l = m.Pin.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.SnapshotHolds) > 0 {
+		for k, v := range m.SnapshotHolds {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11440,6 +11915,20 @@ func (m *MembershipState) Size() (n int) {
 		l = m.License.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if len(m.Pinned) > 0 {
+		for _, e := range m.Pinned {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.SnapshotHolds) > 0 {
+		for k, v := range m.SnapshotHolds {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11522,6 +12011,21 @@ func (m *HealthInfo) Size() (n int) {
 	if m.MaxAssigned != 0 {
 		n += 1 + sovPb(uint64(m.MaxAssigned))
 	}
+	if m.LastAppliedIndex != 0 {
+		n += 1 + sovPb(uint64(m.LastAppliedIndex))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovPb(uint64(m.ProtocolVersion))
+	}
+	if m.Clock != 0 {
+		n += 1 + sovPb(uint64(m.Clock))
+	}
+	if m.RaftTerm != 0 {
+		n += 1 + sovPb(uint64(m.RaftTerm))
+	}
+	if m.RaftLeader {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11559,6 +12063,18 @@ func (m *Tablet) Size() (n int) {
 	if m.UncompressedBytes != 0 {
 		n += 1 + sovPb(uint64(m.UncompressedBytes))
 	}
+	if m.KeyCount != 0 {
+		n += 1 + sovPb(uint64(m.KeyCount))
+	}
+	if m.SplitCount != 0 {
+		n += 1 + sovPb(uint64(m.SplitCount))
+	}
+	if m.ReadRate != 0 {
+		n += 9
+	}
+	if m.WriteRate != 0 {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11706,6 +12222,12 @@ func (m *Snapshot) Size() (n int) {
 	if m.SinceTs != 0 {
 		n += 1 + sovPb(uint64(m.SinceTs))
 	}
+	if len(m.ResumeRanges) > 0 {
+		for _, b := range m.ResumeRanges {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11848,6 +12370,15 @@ func (m *Proposal) Size() (n int) {
 		l = m.Restore.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if len(m.Batch) > 0 {
+		for _, b := range m.Batch {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.ProposedAt != 0 {
+		n += 1 + sovPb(uint64(m.ProposedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12214,6 +12745,9 @@ func (m *SchemaNode) Size() (n int) {
 	if m.NoConflict {
 		n += 2
 	}
+	if m.Ttl != 0 {
+		n += 1 + sovPb(uint64(m.Ttl))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12285,6 +12819,9 @@ func (m *SchemaUpdate) Size() (n int) {
 	if m.NoConflict {
 		n += 2
 	}
+	if m.Ttl != 0 {
+		n += 1 + sovPb(uint64(m.Ttl))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12353,6 +12890,9 @@ func (m *MovePredicatePayload) Size() (n int) {
 	if m.ExpectedChecksum != 0 {
 		n += 1 + sovPb(uint64(m.ExpectedChecksum))
 	}
+	if m.Bandwidth != 0 {
+		n += 1 + sovPb(uint64(m.Bandwidth))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13567,6 +14107,26 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quorum = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14364,6 +14924,26 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quorum = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14760,6 +15340,38 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 13:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
@@ -15721,65 +16333,11 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pin", wireType)
 			}
-			m.Counter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15789,14 +16347,31 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Counter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pin == nil {
+				m.Pin = &Tablet{}
+			}
+			if err := m.Pin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHolds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15823,11 +16398,11 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
+			if m.SnapshotHolds == nil {
+				m.SnapshotHolds = make(map[uint64]int64)
 			}
-			var mapkey uint32
-			var mapvalue *Group
+			var mapkey uint64
+			var mapvalue int64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -15856,13 +16431,12 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
+						mapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPb
@@ -15872,26 +16446,11 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Group{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipPb(dAtA[iNdEx:])
@@ -15907,12 +16466,200 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Groups[mapkey] = mapvalue
+			m.SnapshotHolds[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
-			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
+			}
+			var mapkey uint32
+			var mapvalue *Group
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Group{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -16183,6 +16930,139 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pinned = append(m.Pinned, &Tablet{})
+			if err := m.Pinned[len(m.Pinned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHolds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotHolds == nil {
+				m.SnapshotHolds = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SnapshotHolds[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -16695,6 +17575,102 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedIndex", wireType)
+			}
+			m.LastAppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			m.Clock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Clock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftTerm", wireType)
+			}
+			m.RaftTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftTerm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftLeader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RaftLeader = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -16917,6 +17893,66 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			m.KeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitCount", wireType)
+			}
+			m.SplitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReadRate = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WriteRate = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17840,6 +18876,38 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeRanges", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeRanges = append(m.ResumeRanges, make([]byte, postIndex-iNdEx))
+			copy(m.ResumeRanges[len(m.ResumeRanges)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18821,6 +19889,57 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batch = append(m.Batch, make([]byte, postIndex-iNdEx))
+			copy(m.Batch[len(m.Batch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			m.ProposedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20924,6 +22043,25 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoConflict = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21340,6 +22478,25 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoConflict = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21708,6 +22865,25 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bandwidth", wireType)
+			}
+			m.Bandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bandwidth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
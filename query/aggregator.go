@@ -19,12 +19,14 @@ package query
 import (
 	"bytes"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 )
 
 type aggregator struct {
@@ -35,7 +37,9 @@ type aggregator struct {
 
 func isUnary(f string) bool {
 	return f == "ln" || f == "exp" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" || f == "abs" ||
+		f == "sin" || f == "cos" || f == "tan" || f == "asin" || f == "acos" || f == "atan" ||
+		f == "lower" || f == "upper" || f == "trim"
 }
 
 func isBinaryBoolean(f string) bool {
@@ -44,12 +48,34 @@ func isBinaryBoolean(f string) bool {
 }
 
 func isTernary(f string) bool {
-	return f == "cond"
+	return f == "cond" || f == "substring" || f == "dateadd"
 }
 
 func isBinary(f string) bool {
 	return f == "+" || f == "*" || f == "-" || f == "/" || f == "%" ||
-		f == "max" || f == "min" || f == "logbase" || f == "pow"
+		f == "max" || f == "min" || f == "logbase" || f == "pow" || f == "atan2" ||
+		f == "concat" || f == "datetrunc" || f == "datepart" || f == "distance" ||
+		f == "jsonpath"
+}
+
+// isStringFunc tells whether f works on strings. Its arguments of other types are converted to
+// strings, and missing values are treated as empty strings.
+func isStringFunc(f string) bool {
+	return f == "concat" || f == "lower" || f == "upper" || f == "trim" || f == "jsonpath"
+}
+
+// toString converts v to a string, as it would be returned in the result of a query.
+func toString(v *types.Val) (string, error) {
+	if v.Tid == types.StringID || v.Tid == types.DefaultID {
+		if str, ok := v.Value.(string); ok {
+			return str, nil
+		}
+	}
+	res := types.Val{Tid: types.StringID}
+	if err := types.Marshal(*v, &res); err != nil {
+		return "", err
+	}
+	return res.Value.(string), nil
 }
 
 func convertTo(from *pb.TaskValue) (types.Val, error) {
@@ -231,6 +257,164 @@ func applyLog(a, b, c *types.Val) error {
 	return nil
 }
 
+func applyAtan2(a, b, c *types.Val) error {
+	vBase := getValType(a)
+	switch vBase {
+	case INT:
+		c.Value = math.Atan2(float64(a.Value.(int64)), float64(b.Value.(int64)))
+		c.Tid = types.FloatID
+
+	case FLOAT:
+		c.Value = math.Atan2(a.Value.(float64), b.Value.(float64))
+
+	case DEFAULT:
+		return errors.Errorf("Wrong type %v encountered for func atan2", a.Tid)
+	}
+	return nil
+}
+
+func applyConcat(a, b, c *types.Val) error {
+	sa, err := toString(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func concat", a.Tid)
+	}
+	sb, err := toString(b)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func concat", b.Tid)
+	}
+	c.Tid = types.StringID
+	c.Value = sa + sb
+	return nil
+}
+
+// dateUnit returns the unit of time given by v, which must be a string.
+func dateUnit(fn string, v *types.Val) (string, error) {
+	unit, ok := v.Value.(string)
+	if !ok || (v.Tid != types.StringID && v.Tid != types.DefaultID) {
+		return "", errors.Errorf("Expected a string for the unit of func %s", fn)
+	}
+	unit = strings.ToLower(unit)
+	switch unit {
+	case "year", "month", "day", "hour", "minute", "second":
+		return unit, nil
+	}
+	return "", errors.Errorf("Unknown unit %q for func %s. It must be one of year, month, day, "+
+		"hour, minute or second", unit, fn)
+}
+
+func dateTime(fn string, v *types.Val) (time.Time, error) {
+	t, ok := v.Value.(time.Time)
+	if !ok || v.Tid != types.DateTimeID {
+		return time.Time{}, errors.Errorf("Wrong type %s encountered for func %s", v.Tid.Name(), fn)
+	}
+	return t, nil
+}
+
+func applyDateTrunc(a, b, c *types.Val) error {
+	t, err := dateTime("datetrunc", a)
+	if err != nil {
+		return err
+	}
+	unit, err := dateUnit("datetrunc", b)
+	if err != nil {
+		return err
+	}
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	switch unit {
+	case "year":
+		month, day, hour, min, sec = time.January, 1, 0, 0, 0
+	case "month":
+		day, hour, min, sec = 1, 0, 0, 0
+	case "day":
+		hour, min, sec = 0, 0, 0
+	case "hour":
+		min, sec = 0, 0
+	case "minute":
+		sec = 0
+	}
+	c.Tid = types.DateTimeID
+	c.Value = time.Date(year, month, day, hour, min, sec, 0, t.Location())
+	return nil
+}
+
+func applyDatePart(a, b, c *types.Val) error {
+	t, err := dateTime("datepart", a)
+	if err != nil {
+		return err
+	}
+	unit, err := dateUnit("datepart", b)
+	if err != nil {
+		return err
+	}
+	var part int
+	switch unit {
+	case "year":
+		part = t.Year()
+	case "month":
+		part = int(t.Month())
+	case "day":
+		part = t.Day()
+	case "hour":
+		part = t.Hour()
+	case "minute":
+		part = t.Minute()
+	case "second":
+		part = t.Second()
+	}
+	c.Tid = types.IntID
+	c.Value = int64(part)
+	return nil
+}
+
+// geoPoint returns the point in v, which is either a geo value or a string with a point, given
+// like the point of near().
+func geoPoint(v *types.Val) (*geom.Point, error) {
+	switch val := v.Value.(type) {
+	case *geom.Point:
+		return val, nil
+	case string:
+		return types.ParsePoint(val)
+	}
+	return nil, errors.Errorf("Expected a point for func distance, but got a value of type %s",
+		v.Tid.Name())
+}
+
+// applyJSONPath sets c to the value at the path b in the JSON document a. It's left unset when
+// there's no value there, so there's no result for the uid of a.
+func applyJSONPath(a, b, c *types.Val) error {
+	doc, err := toString(a)
+	if err != nil {
+		return err
+	}
+	path, err := toString(b)
+	if err != nil {
+		return err
+	}
+	p, err := types.ParseJSONPath(path)
+	if err != nil {
+		return err
+	}
+	if v, ok := p.Lookup(doc); ok {
+		*c = v
+	}
+	return nil
+}
+
+func applyDistance(a, b, c *types.Val) error {
+	p1, err := geoPoint(a)
+	if err != nil {
+		return err
+	}
+	p2, err := geoPoint(b)
+	if err != nil {
+		return err
+	}
+	c.Tid = types.FloatID
+	c.Value = types.PointDistance(p1, p2)
+	return nil
+}
+
 func applyMin(a, b, c *types.Val) error {
 	r, err := types.Less(*a, *b)
 	if err != nil {
@@ -350,6 +534,59 @@ func applyCeil(a, res *types.Val) error {
 	return nil
 }
 
+func applyAbs(a, res *types.Val) error {
+	vBase := getValType(a)
+	switch vBase {
+	case INT:
+		v := a.Value.(int64)
+		if v < 0 {
+			v = -v
+		}
+		res.Value = v
+
+	case FLOAT:
+		res.Value = math.Abs(a.Value.(float64))
+
+	case DEFAULT:
+		return errors.Errorf("Wrong type %v encountered for func abs", a.Tid)
+	}
+	return nil
+}
+
+// floatFunc returns a unaryFunc for the function with the given name, which applies fn to its
+// argument as a float. It's used for the trigonometric functions, which all work on floats.
+func floatFunc(name string, fn func(float64) float64) unaryFunc {
+	return func(a, res *types.Val) error {
+		vBase := getValType(a)
+		switch vBase {
+		case INT:
+			res.Value = fn(float64(a.Value.(int64)))
+			res.Tid = types.FloatID
+
+		case FLOAT:
+			res.Value = fn(a.Value.(float64))
+
+		case DEFAULT:
+			return errors.Errorf("Wrong type %v encountered for func %s", a.Tid, name)
+		}
+		return nil
+	}
+}
+
+// stringFunc returns a unaryFunc for the function with the given name, which applies fn to its
+// argument as a string.
+func stringFunc(name string, fn func(string) string) unaryFunc {
+	return func(a, res *types.Val) error {
+		str, err := toString(a)
+		if err != nil {
+			return errors.Wrapf(err, "Wrong type %v encountered for func %s", a.Tid, name)
+		}
+		res.Tid = types.StringID
+		res.Value = fn(str)
+		return nil
+	}
+}
+
 func applySince(a, res *types.Val) error {
 	if a.Tid == types.DateTimeID {
 		a.Value = float64(time.Since(a.Value.(time.Time))) / 1000000000.0
@@ -360,8 +597,66 @@ func applySince(a, res *types.Val) error {
 	return errors.Errorf("Wrong type %v encountered for func since", a.Tid)
 }
 
+func applySubstring(a, b, c, res *types.Val) error {
+	str, err := toString(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func substring", a.Tid)
+	}
+	start, ok1 := b.Value.(int64)
+	length, ok2 := c.Value.(int64)
+	if !ok1 || !ok2 || start < 0 || length < 0 {
+		return errors.Errorf("Expected non-negative int start and length for func substring")
+	}
+
+	// substring counts characters, not bytes, and returns fewer of them if str is shorter.
+	// length is clamped before it's added to start, so that a huge one can't overflow.
+	runes := []rune(str)
+	if start > int64(len(runes)) {
+		start = int64(len(runes))
+	}
+	if length > int64(len(runes))-start {
+		length = int64(len(runes)) - start
+	}
+	res.Tid = types.StringID
+	res.Value = string(runes[start : start+length])
+	return nil
+}
+
+func applyDateAdd(a, b, c, res *types.Val) error {
+	t, err := dateTime("dateadd", a)
+	if err != nil {
+		return err
+	}
+	n, ok := b.Value.(int64)
+	if !ok {
+		return errors.Errorf("Expected an int amount for func dateadd")
+	}
+	unit, err := dateUnit("dateadd", c)
+	if err != nil {
+		return err
+	}
+	switch unit {
+	case "year":
+		t = t.AddDate(int(n), 0, 0)
+	case "month":
+		t = t.AddDate(0, int(n), 0)
+	case "day":
+		t = t.AddDate(0, 0, int(n))
+	case "hour":
+		t = t.Add(time.Duration(n) * time.Hour)
+	case "minute":
+		t = t.Add(time.Duration(n) * time.Minute)
+	case "second":
+		t = t.Add(time.Duration(n) * time.Second)
+	}
+	res.Tid = types.DateTimeID
+	res.Value = t
+	return nil
+}
+
 type unaryFunc func(a, res *types.Val) error
 type binaryFunc func(a, b, res *types.Val) error
+type ternaryFunc func(a, b, c, res *types.Val) error
 
 var unaryFunctions = map[string]unaryFunc{
 	"ln":    applyLn,
@@ -371,18 +666,41 @@ var unaryFunctions = map[string]unaryFunc{
 	"floor": applyFloor,
 	"ceil":  applyCeil,
 	"since": applySince,
+	"abs":   applyAbs,
+	"sin":   floatFunc("sin", math.Sin),
+	"cos":   floatFunc("cos", math.Cos),
+	"tan":   floatFunc("tan", math.Tan),
+	"asin":  floatFunc("asin", math.Asin),
+	"acos":  floatFunc("acos", math.Acos),
+	"atan":  floatFunc("atan", math.Atan),
+	"lower": stringFunc("lower", strings.ToLower),
+	"upper": stringFunc("upper", strings.ToUpper),
+	"trim":  stringFunc("trim", strings.TrimSpace),
 }
 
 var binaryFunctions = map[string]binaryFunc{
-	"+":       applyAdd,
-	"-":       applySub,
-	"*":       applyMul,
-	"/":       applyDiv,
-	"%":       applyMod,
-	"pow":     applyPow,
-	"logbase": applyLog,
-	"min":     applyMin,
-	"max":     applyMax,
+	"+":         applyAdd,
+	"-":         applySub,
+	"*":         applyMul,
+	"/":         applyDiv,
+	"%":         applyMod,
+	"pow":       applyPow,
+	"logbase":   applyLog,
+	"atan2":     applyAtan2,
+	"concat":    applyConcat,
+	"datetrunc": applyDateTrunc,
+	"datepart":  applyDatePart,
+	"distance":  applyDistance,
+	"jsonpath":  applyJSONPath,
+	"min":       applyMin,
+	"max":       applyMax,
+}
+
+// ternaryFunctions are the ternary functions other than cond, which picks one of its arguments
+// instead of computing a value from them.
+var ternaryFunctions = map[string]ternaryFunc{
+	"substring": applySubstring,
+	"dateadd":   applyDateAdd,
 }
 
 type valType int
@@ -433,7 +751,11 @@ func (ag *aggregator) matchType(v, va *types.Val) error {
 }
 
 func (ag *aggregator) ApplyVal(v types.Val) error {
-	if v.Value == nil {
+	switch {
+	case v.Value == nil && isStringFunc(ag.name):
+		// If the value is missing, treat it as an empty string.
+		v = types.Val{Tid: types.StringID, Value: ""}
+	case v.Value == nil:
 		// If the value is missing, treat it as 0.
 		v.Value = int64(0)
 		v.Tid = types.IntID
@@ -456,8 +778,11 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 	}
 
 	va := ag.result
-	if err := ag.matchType(&v, &va); err != nil {
-		return err
+	// String functions take values of any type, and convert them to strings themselves.
+	if !isStringFunc(ag.name) {
+		if err := ag.matchType(&v, &va); err != nil {
+			return err
+		}
 	}
 
 	if function, ok := binaryFunctions[ag.name]; ok {
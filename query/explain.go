@@ -0,0 +1,202 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+// execStats holds what's known about how a SubGraph was run once it has been processed.
+type execStats struct {
+	// taskLatency is how long the task sent to the workers took, if one was sent.
+	taskLatency time.Duration
+	// fetched is the number of uids found before the filters were applied.
+	fetched int
+	// filtered is the number of uids left after the filters, before pagination.
+	filtered int
+}
+
+// planNode is how a SubGraph was run, as returned by explain queries.
+type planNode struct {
+	Block    string `json:"block,omitempty"`
+	Attr     string `json:"attr,omitempty"`
+	Alias    string `json:"alias,omitempty"`
+	Func     string `json:"func,omitempty"`
+	Index    bool   `json:"index"`
+	TaskNs   int64  `json:"task_ns"`
+	SrcUids  int    `json:"src_uids"`
+	Fetched  int    `json:"uids_fetched"`
+	Filtered int    `json:"uids_filtered"`
+	Uids     int    `json:"uids"`
+	Values   int    `json:"values,omitempty"`
+	// Estimated is the most uids it could return, as known before it ran, or nil if nothing
+	// bounded it.
+	Estimated *int `json:"estimated_uids,omitempty"`
+	// Combine is how the results of the filters are combined: intersect, union or difference.
+	Combine  string      `json:"combine,omitempty"`
+	Filters  []*planNode `json:"filters,omitempty"`
+	Children []*planNode `json:"children,omitempty"`
+}
+
+// ToExplainJson returns the plan of the processed query blocks in sgl, in place of their
+// results. Blocks that only define variables are part of it too.
+func ToExplainJson(sgl []*SubGraph) ([]byte, error) {
+	plan := make([]*planNode, 0, len(sgl))
+	for _, sg := range sgl {
+		n := sg.plan(false)
+		n.Block, n.Alias = sg.Params.Alias, ""
+		plan = append(plan, n)
+	}
+	data, err := json.Marshal(map[string]interface{}{"explain": plan})
+	return data, errors.Wrapf(err, "while running ToExplainJson")
+}
+
+func (sg *SubGraph) plan(isFilter bool) *planNode {
+	n := &planNode{
+		Attr:     sg.Attr,
+		TaskNs:   sg.stats.taskLatency.Nanoseconds(),
+		Fetched:  sg.stats.fetched,
+		Filtered: sg.stats.filtered,
+	}
+	if !isFilter && sg.Params.Alias != sg.Attr {
+		n.Alias = sg.Params.Alias
+	}
+	if sg.SrcFunc != nil {
+		n.Func = sg.describeFunc()
+		n.Index = sg.stats.taskLatency > 0 && worker.UsesIndex(sg.SrcFunc.Name, isFilter)
+	}
+	if sg.SrcUIDs != nil {
+		n.SrcUids = len(sg.SrcUIDs.Uids)
+	}
+	if estimated, ok := sg.estimate(isFilter); ok {
+		n.Estimated = &estimated
+	}
+	if sg.DestUIDs != nil {
+		n.Uids = len(sg.DestUIDs.Uids)
+	}
+	for _, vl := range sg.valueMatrix {
+		n.Values += len(vl.Values)
+	}
+
+	if len(sg.Filters) > 0 {
+		switch sg.FilterOp {
+		case "or":
+			n.Combine = "union"
+		case "not":
+			n.Combine = "difference"
+		default:
+			n.Combine = "intersect"
+		}
+	}
+	for _, f := range sg.Filters {
+		n.Filters = append(n.Filters, f.plan(true))
+	}
+	for _, child := range sg.Children {
+		n.Children = append(n.Children, child.plan(false))
+	}
+	return n
+}
+
+// estimate returns the most uids sg could return, from what's known before it runs: the uids it
+// starts from, whether its predicate holds a single uid or a list of them, and its first
+// argument. Dgraph keeps no statistics of how many uids a function matches, so it returns false
+// when none of these bound it, like for a root function that's looked up in an index.
+func (sg *SubGraph) estimate(isFilter bool) (int, bool) {
+	first := sg.Params.Count
+	if first < 0 {
+		first = -first
+	}
+	srcUids := len(sg.SrcUIDs.GetUids())
+
+	var bound int
+	switch {
+	case isFilter && sg.SrcUIDs != nil:
+		// A filter only keeps some of the uids it's given.
+		return srcUids, true
+	case sg.SrcFunc != nil && sg.SrcFunc.Name == "uid":
+		// uid() finds the uids it was given, or those of its variables, without reading any.
+		bound = sg.stats.fetched
+	case sg.SrcFunc == nil && sg.SrcUIDs != nil && sg.Attr != "" && sg.Attr != "uid":
+		if typ, err := schema.State().TypeOf(sg.Attr); err != nil || typ != types.UidID {
+			return 0, false
+		}
+		switch {
+		case !strings.HasPrefix(sg.Attr, "~") && !schema.State().IsList(sg.Attr):
+			return srcUids, true
+		case first > 0:
+			// The first argument of a predicate limits the uids of each of the uids it starts
+			// from.
+			return srcUids * first, true
+		}
+		return 0, false
+	case sg.SrcUIDs == nil && first > 0:
+		return first, true
+	default:
+		return 0, false
+	}
+	if first > 0 && first < bound {
+		bound = first
+	}
+	return bound, true
+}
+
+// describeFunc returns the function of sg as it's written in the query, like
+// eq(name, "Alice").
+func (sg *SubGraph) describeFunc() string {
+	f := sg.SrcFunc
+	var args []string
+	switch {
+	case f.Name == "uid":
+		for _, v := range sg.Params.NeedsVar {
+			args = append(args, v.Name)
+		}
+		if len(args) == 0 && sg.SrcUIDs != nil {
+			for _, uid := range sg.SrcUIDs.Uids {
+				args = append(args, "0x"+strconv.FormatUint(uid, 16))
+			}
+		}
+	case f.IsValueVar || f.IsLenVar:
+		wrap := "val("
+		if f.IsLenVar {
+			wrap = "len("
+		}
+		for _, v := range sg.Params.NeedsVar {
+			args = append(args, wrap+v.Name+")")
+		}
+	case f.IsCount:
+		args = append(args, "count("+sg.Attr+")")
+	case sg.Attr != "":
+		args = append(args, sg.Attr)
+	}
+	for _, arg := range f.Args {
+		if arg.IsValueVar {
+			args = append(args, "val("+arg.Value+")")
+		} else {
+			args = append(args, strconv.Quote(arg.Value))
+		}
+	}
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
@@ -22,8 +22,10 @@ import (
 	"strconv"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
 
@@ -39,14 +41,11 @@ type groupResult struct {
 }
 
 func (grp *groupResult) aggregateChild(child *SubGraph) error {
-	fieldName := child.Params.Alias
+	fieldName := aggregateName(child)
 	if child.Params.DoCount {
 		if child.Attr != "uid" {
 			return errors.Errorf("Only uid predicate is allowed in count within groupby")
 		}
-		if fieldName == "" {
-			fieldName = "count"
-		}
 		grp.aggregates = append(grp.aggregates, groupPair{
 			attr: fieldName,
 			key: types.Val{
@@ -57,9 +56,6 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 		return nil
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
-		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
-		}
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
 			return err
@@ -72,6 +68,38 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 	return nil
 }
 
+// aggregateName returns the name of the aggregate that child computes for each group.
+func aggregateName(child *SubGraph) string {
+	switch {
+	case child.Params.Alias != "":
+		return child.Params.Alias
+	case child.Params.DoCount:
+		return "count"
+	case child.SrcFunc != nil:
+		return fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+	}
+	return ""
+}
+
+// checkGroupOrder checks that the groups are ordered by names of aggregates, or of grouping
+// attributes, of the @groupby.
+func (sg *SubGraph) checkGroupOrder() error {
+	for _, o := range sg.Params.GroupbyArgs.Order {
+		found := false
+		for _, attr := range sg.Params.GroupbyAttrs {
+			found = found || attr.Alias == o.Attr || (attr.Alias == "" && attr.Attr == o.Attr)
+		}
+		for _, child := range sg.Children {
+			found = found || (!child.Params.IgnoreResult && aggregateName(child) == o.Attr)
+		}
+		if !found {
+			return errors.Errorf("Groups can't be ordered by %s, as it isn't an aggregate or "+
+				"an attribute of the groupby", o.Attr)
+		}
+	}
+	return nil
+}
+
 type groupResults struct {
 	group []*groupResult
 }
@@ -263,10 +291,64 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 	sort.Slice(res.group, func(i, j int) bool {
 		return groupLess(res.group[i], res.group[j])
 	})
+	res.orderAndPaginate(sg.Params.GroupbyArgs)
 
 	return res, nil
 }
 
+// orderAndPaginate orders the groups as asked by the @groupby directive, keeping the
+// deterministic order of groupLess between groups that are equal, and then keeps only the page
+// of groups that it asks for.
+func (res *groupResults) orderAndPaginate(args gql.GroupbyArgs) {
+	if len(args.Order) > 0 {
+		sort.SliceStable(res.group, func(i, j int) bool {
+			return groupOrderLess(res.group[i], res.group[j], args.Order)
+		})
+	}
+	start, end := x.PageRange(args.First, args.Offset, len(res.group))
+	res.group = res.group[start:end]
+}
+
+// groupValue returns the value of the aggregate, or of the grouping attribute, with the given
+// name in the result of grp.
+func (grp *groupResult) groupValue(name string) (types.Val, bool) {
+	for _, pair := range grp.aggregates {
+		if pair.attr == name {
+			return pair.key, true
+		}
+	}
+	for _, pair := range grp.keys {
+		if pair.attr == name {
+			return pair.key, true
+		}
+	}
+	return types.Val{}, false
+}
+
+// groupOrderLess tells whether group a comes before group b when ordered by the given values.
+// Groups without one of the values come after those with it.
+func groupOrderLess(a, b *groupResult, order []*pb.Order) bool {
+	for _, o := range order {
+		va, oka := a.groupValue(o.Attr)
+		vb, okb := b.groupValue(o.Attr)
+		switch {
+		case !oka && !okb:
+			continue
+		case !oka:
+			return false
+		case !okb:
+			return true
+		}
+		if l, err := types.Less(va, vb); err == nil && l {
+			return !o.Desc
+		}
+		if l, err := types.Less(vb, va); err == nil && l {
+			return o.Desc
+		}
+	}
+	return false
+}
+
 // This function is to use the fillVars. It is similar to formResult, the only difference being
 // that it considers the whole uidMatrix to do the grouping before assigning the variable.
 // TODO - Check if we can reduce this duplication.
@@ -369,6 +451,9 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 }
 
 func (sg *SubGraph) processGroupBy(doneVars map[string]varValue, path []*SubGraph) error {
+	if err := sg.checkGroupOrder(); err != nil {
+		return err
+	}
 	for _, ul := range sg.uidMatrix {
 		// We need to process groupby for each list as grouping needs to happen for each path of the
 		// tree.
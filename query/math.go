@@ -63,10 +63,15 @@ func processBinary(mNode *mathTree) error {
 		if err != nil {
 			return err
 		}
-		destMap[k], err = ag.Value()
-		if err != nil {
+		v, err := ag.Value()
+		switch {
+		case err == ErrEmptyVal:
+			// The function has no value for k, like jsonpath() with a path that isn't there.
+			return nil
+		case err != nil:
 			return err
 		}
+		destMap[k] = v
 		return nil
 	}
 
@@ -173,6 +178,10 @@ func processBinaryBoolean(mNode *mathTree) error {
 
 // processTernary handles the ternary operand cond()
 func processTernary(mNode *mathTree) error {
+	if fn, ok := ternaryFunctions[mNode.Fn]; ok {
+		return processTernaryFunc(mNode, fn)
+	}
+
 	destMap := make(map[uint64]types.Val)
 	aggName := mNode.Fn
 	condMap := mNode.Child[0].Val
@@ -210,6 +219,55 @@ func processTernary(mNode *mathTree) error {
 	return nil
 }
 
+// processTernaryFunc handles the ternary functions other than cond, by applying fn to the
+// values of the three arguments. A node only gets a value if it has all three of them.
+func processTernaryFunc(mNode *mathTree, fn ternaryFunc) error {
+	apply := func(k uint64) (types.Val, bool, error) {
+		var args [3]types.Val
+		for i, ch := range mNode.Child {
+			args[i] = ch.Const
+			if args[i].Value == nil {
+				var ok bool
+				if args[i], ok = ch.Val[k]; !ok {
+					return types.Val{}, false, nil
+				}
+			}
+		}
+		var res types.Val
+		err := fn(&args[0], &args[1], &args[2], &res)
+		return res, err == nil, err
+	}
+
+	hasVar := false
+	destMap := make(map[uint64]types.Val)
+	for _, ch := range mNode.Child {
+		if ch.Const.Value != nil {
+			continue
+		}
+		hasVar = true
+		for k := range ch.Val {
+			if _, ok := destMap[k]; ok {
+				continue
+			}
+			res, ok, err := apply(k)
+			if err != nil {
+				return err
+			}
+			if ok {
+				destMap[k] = res
+			}
+		}
+	}
+	if !hasVar {
+		// All the arguments are constants.
+		res, _, err := apply(0)
+		mNode.Const = res
+		return err
+	}
+	mNode.Val = destMap
+	return nil
+}
+
 func evalMathTree(mNode *mathTree) error {
 	if mNode.Const.Value != nil {
 		return nil
@@ -17,10 +17,13 @@
 package query
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/stretchr/testify/require"
+	geom "github.com/twpayne/go-geom"
 )
 
 func TestProcessBinary(t *testing.T) {
@@ -220,6 +223,76 @@ func TestProcessBinary(t *testing.T) {
 			}},
 			out: types.Val{Tid: types.FloatID, Value: 8.0},
 		},
+		{in: &mathTree{
+			Fn: "atan2",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.IntID, Value: int64(1)}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(-1)}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: 3 * math.Pi / 4},
+		},
+		{in: &mathTree{
+			Fn: "concat",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.StringID, Value: "Dgraph "}},
+				{Const: types.Val{Tid: types.DefaultID, Value: "rocks"}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "Dgraph rocks"},
+		},
+		{in: &mathTree{
+			Fn: "concat",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.StringID, Value: "v"}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(21)}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "v21"},
+		},
+		{in: &mathTree{
+			Fn: "datetrunc",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.DateTimeID,
+					Value: time.Date(2021, time.March, 14, 15, 9, 26, 535, time.UTC)}},
+				{Const: types.Val{Tid: types.StringID, Value: "month"}},
+			}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{in: &mathTree{
+			Fn: "datetrunc",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.DateTimeID,
+					Value: time.Date(2021, time.March, 14, 15, 9, 26, 535, time.UTC)}},
+				{Const: types.Val{Tid: types.DefaultID, Value: "Hour"}},
+			}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, time.March, 14, 15, 0, 0, 0, time.UTC)},
+		},
+		{in: &mathTree{
+			Fn: "datepart",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.DateTimeID,
+					Value: time.Date(2021, time.March, 14, 15, 9, 26, 535, time.UTC)}},
+				{Const: types.Val{Tid: types.StringID, Value: "month"}},
+			}},
+			out: types.Val{Tid: types.IntID, Value: int64(3)},
+		},
+		{in: &mathTree{
+			Fn: "distance",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.GeoID,
+					Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1.1, 2.0})}},
+				{Const: types.Val{Tid: types.StringID, Value: "[1.1, 2.0]"}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: 0.0},
+		},
+		{in: &mathTree{
+			Fn: "jsonpath",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.StringID, Value: `{"a": {"b": [1, "two"]}}`}},
+				{Const: types.Val{Tid: types.StringID, Value: "$.a.b[1]"}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "two"},
+		},
 	}
 	for _, tc := range tests {
 		t.Logf("Test %s", tc.in.Fn)
@@ -229,6 +302,25 @@ func TestProcessBinary(t *testing.T) {
 	}
 }
 
+func TestProcessBinaryJSONPath(t *testing.T) {
+	in := &mathTree{
+		Fn: "jsonpath",
+		Child: []*mathTree{
+			{Val: map[uint64]types.Val{
+				1: {Tid: types.StringID, Value: `{"n": 3}`},
+				2: {Tid: types.StringID, Value: `{"m": 3}`},
+				3: {Tid: types.StringID, Value: `not json`},
+			}},
+			{Const: types.Val{Tid: types.StringID, Value: "$.n"}},
+		}}
+	require.NoError(t, processBinary(in))
+	// Only the documents with a value at the path have a result.
+	require.Equal(t, map[uint64]types.Val{1: {Tid: types.IntID, Value: int64(3)}}, in.Val)
+
+	in.Child[1].Const = types.Val{Tid: types.StringID, Value: "n"}
+	require.EqualError(t, processBinary(in), `Invalid JSON path "n": it must start with $`)
+}
+
 func TestProcessUnary(t *testing.T) {
 	tests := []struct {
 		in  *mathTree
@@ -276,6 +368,83 @@ func TestProcessUnary(t *testing.T) {
 			}},
 			out: types.Val{Tid: types.FloatID, Value: 3.0},
 		},
+		{in: &mathTree{
+			Fn: "abs",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.IntID, Value: int64(-2)}},
+			}},
+			out: types.Val{Tid: types.IntID, Value: int64(2)},
+		},
+		{in: &mathTree{
+			Fn: "abs",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.FloatID, Value: -2.5}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: 2.5},
+		},
+		{in: &mathTree{
+			Fn: "sin",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.IntID, Value: int64(0)}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: 0.0},
+		},
+		{in: &mathTree{
+			Fn: "cos",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.FloatID, Value: 0.0}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: 1.0},
+		},
+		{in: &mathTree{
+			Fn: "tan",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.FloatID, Value: 0.0}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: 0.0},
+		},
+		{in: &mathTree{
+			Fn: "asin",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.IntID, Value: int64(1)}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: math.Pi / 2},
+		},
+		{in: &mathTree{
+			Fn: "acos",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.IntID, Value: int64(-1)}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: math.Pi},
+		},
+		{in: &mathTree{
+			Fn: "atan",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.FloatID, Value: 1.0}},
+			}},
+			out: types.Val{Tid: types.FloatID, Value: math.Pi / 4},
+		},
+		{in: &mathTree{
+			Fn: "lower",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.StringID, Value: "DGraph"}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "dgraph"},
+		},
+		{in: &mathTree{
+			Fn: "upper",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.DefaultID, Value: "DGraph"}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "DGRAPH"},
+		},
+		{in: &mathTree{
+			Fn: "trim",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.StringID, Value: "  Dgraph \n"}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "Dgraph"},
+		},
 	}
 	for _, tc := range tests {
 		t.Logf("Test %s", tc.in.Fn)
@@ -376,6 +545,64 @@ func TestProcessTernary(t *testing.T) {
 			}},
 			out: types.Val{Tid: types.FloatID, Value: 2.0},
 		},
+		{in: &mathTree{
+			Fn: "substring",
+			Child: []*mathTree{
+				{Val: map[uint64]types.Val{0: {Tid: types.StringID, Value: "Grüße, Dgraph"}}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(2)}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(3)}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "üße"},
+		},
+		{in: &mathTree{
+			Fn: "substring",
+			Child: []*mathTree{
+				{Val: map[uint64]types.Val{0: {Tid: types.DefaultID, Value: "Dgraph"}}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(3)}},
+				{Val: map[uint64]types.Val{0: {Tid: types.IntID, Value: int64(10)}}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "aph"},
+		},
+		{in: &mathTree{
+			Fn: "substring",
+			Child: []*mathTree{
+				{Val: map[uint64]types.Val{0: {Tid: types.StringID, Value: "Dgraph"}}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(1)}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(math.MaxInt64)}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: "graph"},
+		},
+		{in: &mathTree{
+			Fn: "substring",
+			Child: []*mathTree{
+				{Val: map[uint64]types.Val{0: {Tid: types.StringID, Value: "Dgraph"}}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(math.MaxInt64)}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(math.MaxInt64)}},
+			}},
+			out: types.Val{Tid: types.StringID, Value: ""},
+		},
+		{in: &mathTree{
+			Fn: "dateadd",
+			Child: []*mathTree{
+				{Val: map[uint64]types.Val{0: {Tid: types.DateTimeID,
+					Value: time.Date(2020, time.December, 31, 23, 0, 0, 0, time.UTC)}}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(2)}},
+				{Const: types.Val{Tid: types.StringID, Value: "month"}},
+			}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, time.March, 3, 23, 0, 0, 0, time.UTC)},
+		},
+		{in: &mathTree{
+			Fn: "dateadd",
+			Child: []*mathTree{
+				{Val: map[uint64]types.Val{0: {Tid: types.DateTimeID,
+					Value: time.Date(2021, time.March, 1, 0, 30, 0, 0, time.UTC)}}},
+				{Const: types.Val{Tid: types.IntID, Value: int64(-90)}},
+				{Const: types.Val{Tid: types.StringID, Value: "minute"}},
+			}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, time.February, 28, 23, 0, 0, 0, time.UTC)},
+		},
 	}
 	for _, tc := range tests {
 		t.Logf("Test %s", tc.in.Fn)
@@ -385,4 +612,52 @@ func TestProcessTernary(t *testing.T) {
 	}
 }
 
+func TestProcessFuncErrors(t *testing.T) {
+	date := types.Val{Tid: types.DateTimeID, Value: time.Date(2021, time.March, 1, 0, 0, 0, 0,
+		time.UTC)}
+	tests := []struct {
+		in     *mathTree
+		errMsg string
+	}{
+		{in: &mathTree{
+			Fn: "datetrunc",
+			Child: []*mathTree{
+				{Const: date},
+				{Const: types.Val{Tid: types.StringID, Value: "fortnight"}},
+			}},
+			errMsg: `Unknown unit "fortnight" for func datetrunc. It must be one of year, ` +
+				`month, day, hour, minute or second`,
+		},
+		{in: &mathTree{
+			Fn: "datepart",
+			Child: []*mathTree{
+				{Const: types.Val{Tid: types.StringID, Value: "2021-03-01"}},
+				{Const: types.Val{Tid: types.StringID, Value: "year"}},
+			}},
+			errMsg: "Wrong type string encountered for func datepart",
+		},
+		{in: &mathTree{
+			Fn: "dateadd",
+			Child: []*mathTree{
+				{Const: date},
+				{Const: types.Val{Tid: types.FloatID, Value: 1.5}},
+				{Const: types.Val{Tid: types.StringID, Value: "day"}},
+			}},
+			errMsg: "Expected an int amount for func dateadd",
+		},
+		{in: &mathTree{
+			Fn: "distance",
+			Child: []*mathTree{
+				{Const: date},
+				{Const: types.Val{Tid: types.StringID, Value: "[1.1, 2.0]"}},
+			}},
+			errMsg: "Expected a point for func distance, but got a value of type datetime",
+		},
+	}
+	for _, tc := range tests {
+		t.Logf("Test %s", tc.in.Fn)
+		require.EqualError(t, evalMathTree(tc.in), tc.errMsg)
+	}
+}
+
 func TestEvalMathTree(t *testing.T) {}
@@ -879,6 +879,65 @@ func (enc *encoder) normalize(fj fastJsonNode) ([]fastJsonNode, error) {
 	return parentSlice, nil
 }
 
+// nestDottedAttrs returns a copy of the list of attributes starting at head, in which the
+// attributes with dotted names are nested in objects, so that the attributes author.name and
+// author.age become {"author": {"name": ..., "age": ...}}. The results of @normalize are flat
+// otherwise, so this lets their aliases give them a structure. It returns an error if an
+// attribute has the name of an object, like author next to author.name, as the result would
+// have the key twice.
+func (enc *encoder) nestDottedAttrs(head fastJsonNode) (fastJsonNode, error) {
+	var out, tail fastJsonNode
+	appendTo := func(first, last *fastJsonNode, n fastJsonNode) {
+		if *first == nil {
+			*first = n
+		} else {
+			(*last).next = n
+		}
+		*last = n
+	}
+
+	var objects []fastJsonNode
+	lastChild := make(map[fastJsonNode]fastJsonNode)
+	objectFor := make(map[string]fastJsonNode)
+	plain := make(map[string]bool)
+	for cur := head; cur != nil; cur = cur.next {
+		n := enc.copySingleNode(cur)
+		attr := enc.attrForID(enc.getAttr(cur))
+		i := strings.IndexByte(attr, '.')
+		if i <= 0 || i == len(attr)-1 {
+			plain[attr] = true
+			appendTo(&out, &tail, n)
+			continue
+		}
+
+		obj, ok := objectFor[attr[:i]]
+		if !ok {
+			obj = enc.newNode(enc.idForAttr(attr[:i]))
+			objectFor[attr[:i]] = obj
+			objects = append(objects, obj)
+			appendTo(&out, &tail, obj)
+		}
+		enc.setAttr(n, enc.idForAttr(attr[i+1:]))
+		first, last := enc.children(obj), lastChild[obj]
+		appendTo(&first, &last, n)
+		obj.child, lastChild[obj] = first, last
+	}
+
+	// The attributes in an object can have dotted names too, like author.address.city.
+	for _, obj := range objects {
+		name := enc.attrForID(enc.getAttr(obj))
+		if plain[name] {
+			return nil, errors.Errorf("The alias %s of @normalize is also the object of the "+
+				"aliases that start with %s., so the result would have it twice", name, name)
+		}
+		var err error
+		if obj.child, err = enc.nestDottedAttrs(obj.child); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 func (sg *SubGraph) addGroupby(enc *encoder, fj fastJsonNode,
 	res *groupResults, fname string) error {
 
@@ -961,6 +1020,20 @@ func (sg *SubGraph) handleCountUIDNodes(enc *encoder, n fastJsonNode, count int)
 	return addedNewChild, nil
 }
 
+// addTotal adds the number of nodes that the root of sg found after its filters, sort and
+// @cascade, before they were paginated, for the @total directive.
+func (sg *SubGraph) addTotal(enc *encoder, fj fastJsonNode) error {
+	c := types.ValueForType(types.IntID)
+	c.Value = int64(sg.total)
+
+	n1 := enc.newNode(enc.idForAttr(sg.Params.Alias))
+	if err := enc.AddValue(n1, enc.idForAttr("total"), c); err != nil {
+		return err
+	}
+	enc.AddListChild(fj, n1)
+	return nil
+}
+
 func processNodeUids(fj fastJsonNode, enc *encoder, sg *SubGraph) error {
 	if sg.Params.IsEmpty {
 		return sg.addAggregations(enc, fj)
@@ -978,6 +1051,12 @@ func processNodeUids(fj fastJsonNode, enc *encoder, sg *SubGraph) error {
 	if err != nil {
 		return err
 	}
+	if sg.Params.Total {
+		if err := sg.addTotal(enc, fj); err != nil {
+			return err
+		}
+		hasChild = true
+	}
 	if sg.Params.IsGroupBy {
 		if len(sg.GroupbyRes) == 0 {
 			return errors.Errorf("Expected GroupbyRes to have length > 0.")
@@ -1024,6 +1103,9 @@ func processNodeUids(fj fastJsonNode, enc *encoder, sg *SubGraph) error {
 			return err
 		}
 		for _, c := range normalized {
+			if c, err = enc.nestDottedAttrs(c); err != nil {
+				return err
+			}
 			node := enc.newNode(attrID)
 			enc.setVisited(node, true)
 			enc.addChildren(node, c)
@@ -1299,6 +1381,13 @@ func (sg *SubGraph) preTraverse(enc *encoder, uid uint64, dst fastJsonNode) erro
 						}
 
 						for _, c := range normAttrs {
+							if !sg.Params.Normalize {
+								// This is the outermost @normalize, so its attributes won't be
+								// flattened any further.
+								if c, err = enc.nestDottedAttrs(c); err != nil {
+									return err
+								}
+							}
 							// Adding as list child irrespective of the type of pc
 							// (list or non-list), otherwise result might be inconsistent or might
 							// depend on children and grandchildren of pc. Consider the case:
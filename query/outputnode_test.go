@@ -259,3 +259,54 @@ func TestChildrenOrder(t *testing.T) {
 	}
 	require.Nil(t, child)
 }
+
+func TestNestDottedAttrs(t *testing.T) {
+	enc := newEncoder()
+	var head, tail fastJsonNode
+	for _, attr := range []string{"name", "author.name", "author.address.city", "author.age",
+		".hidden"} {
+		n, err := enc.makeScalarNode(enc.idForAttr(attr), []byte(`"`+attr+`"`), false)
+		require.NoError(t, err)
+		if head == nil {
+			head = n
+		} else {
+			tail.next = n
+		}
+		tail = n
+	}
+
+	root := enc.newNode(enc.idForAttr("root"))
+	var err error
+	root.child, err = enc.nestDottedAttrs(head)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, enc.encode(root, &out))
+	require.Equal(t, `{"name":"name","author":{"name":"author.name",`+
+		`"address":{"city":"author.address.city"},"age":"author.age"},".hidden":".hidden"}`,
+		out.String())
+}
+
+func TestNestDottedAttrsCollision(t *testing.T) {
+	for _, attrs := range [][]string{
+		{"author", "author.name"},
+		{"author.name", "author"},
+		{"book.author", "book.author.name"},
+	} {
+		enc := newEncoder()
+		var head, tail fastJsonNode
+		for _, attr := range attrs {
+			n, err := enc.makeScalarNode(enc.idForAttr(attr), []byte(`"`+attr+`"`), false)
+			require.NoError(t, err)
+			if head == nil {
+				head = n
+			} else {
+				tail.next = n
+			}
+			tail = n
+		}
+
+		_, err := enc.nestDottedAttrs(head)
+		require.Error(t, err, "%v", attrs)
+		require.Contains(t, err.Error(), "would have it twice")
+	}
+}
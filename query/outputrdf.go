@@ -223,6 +223,9 @@ func validateSubGraphForRDF(sg *SubGraph) error {
 	if sg.Params.IgnoreReflex {
 		return errors.New("ignorereflex directive is not supported in the rdf output format")
 	}
+	if sg.Params.Total {
+		return errors.New("total directive is not supported in the rdf output format")
+	}
 	if sg.SrcFunc != nil && sg.SrcFunc.Name == "checkpwd" {
 		return errors.New("chkpwd function is not supported in the rdf output format")
 	}
@@ -149,6 +149,10 @@ type params struct {
 	// variable that is part of req.Vars. This value variable would have been defined
 	// in some other query.
 	UidToVal map[uint64]types.Val
+	// OrderVals is the mapping of uid to values of the value variable that the results are
+	// ordered by, like score in orderdesc: val(score). It's kept apart from UidToVal, as the
+	// function of the SubGraph can take another value variable.
+	OrderVals map[uint64]types.Val
 
 	// Normalize is true if the @normalize directive is specified.
 	Normalize bool
@@ -156,12 +160,17 @@ type params struct {
 	Recurse bool
 	// RecurseArgs stores the arguments passed to the @recurse directive.
 	RecurseArgs gql.RecurseArgs
+	// Levels limits a predicate of a @recurse block to some levels, if the @levels directive is
+	// specified.
+	Levels *gql.RecurseLevels
 	// Cascade is the list of predicates to apply @cascade to.
 	// __all__ is special to mean @cascade i.e. all the children of this subgraph are mandatory
 	// and should have values otherwise the node will be excluded.
 	Cascade []string
 	// IgnoreReflex is true if the @ignorereflex directive is specified.
 	IgnoreReflex bool
+	// Total is true if the @total directive is specified.
+	Total bool
 
 	// ShortestPathArgs contains the from and to functions to execute a shortest path query.
 	ShortestPathArgs gql.ShortestPathArgs
@@ -186,11 +195,15 @@ type params struct {
 	IgnoreResult bool
 	// Expand holds the argument passed to the expand function.
 	Expand string
+	// ExpandExcept holds the predicates that the expand function leaves out.
+	ExpandExcept []string
 
 	// IsGroupBy is true if @groupby is specified.
 	IsGroupBy bool // True if @groupby is specified.
 	// GroupbyAttrs holds the list of attributes to group by.
 	GroupbyAttrs []gql.GroupByAttr
+	// GroupbyArgs holds the ordering and pagination of the groups.
+	GroupbyArgs gql.GroupbyArgs
 
 	// ParentIds is a stack that is maintained and passed down to children.
 	ParentIds []uint64
@@ -244,6 +257,7 @@ type Function struct {
 type SubGraph struct {
 	ReadTs      uint64
 	Cache       int
+	Quorum      bool
 	Attr        string
 	UnknownAttr bool
 	// read only parameters which are populated before the execution of the query and are used to
@@ -288,6 +302,18 @@ type SubGraph struct {
 	List     bool // whether predicate is of list type
 
 	pathMeta *pathMetadata
+	// stats records how this SubGraph was run, for the plans of explain queries.
+	stats execStats
+	// total is the number of nodes that the root found for @total, before pagination.
+	total int
+	// heldPage is the pagination of a root with @total, which is held back until its nodes are
+	// counted.
+	heldPage *page
+}
+
+// page is the first and offset arguments of a block.
+type page struct {
+	count, offset int
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -519,6 +545,15 @@ func uniqueKey(gchild *gql.GraphQuery) string {
 	return key
 }
 
+// levelsKey distinguishes the predicates of a @recurse block that are the same except for their
+// @levels, as they're allowed as long as their levels don't overlap.
+func levelsKey(gchild *gql.GraphQuery) string {
+	if gchild.Levels == nil {
+		return ""
+	}
+	return fmt.Sprintf("levels(%d,%d)", gchild.Levels.From, gchild.Levels.To)
+}
+
 func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 	// Typically you act on the current node, and leave recursion to deal with
 	// children. But, in this case, we don't want to muck with the current
@@ -537,26 +572,33 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 		} else {
 			key = uniqueKey(gchild)
 		}
-		if _, ok := attrsSeen[key]; ok {
+		if gchild.Levels != nil && !sg.Params.Recurse {
+			return errors.Errorf("@levels is only allowed on predicates of a @recurse block, "+
+				"but was used on %s.", key)
+		}
+		if _, ok := attrsSeen[key+levelsKey(gchild)]; ok {
 			return errors.Errorf("%s not allowed multiple times in same sub-query.",
 				key)
 		}
-		attrsSeen[key] = struct{}{}
+		attrsSeen[key+levelsKey(gchild)] = struct{}{}
 
 		args := params{
 			Alias:        gchild.Alias,
 			Expand:       gchild.Expand,
+			ExpandExcept: gchild.ExpandExcept,
 			Facet:        gchild.Facets,
 			FacetsOrder:  gchild.FacetsOrder,
 			FacetVar:     gchild.FacetVar,
 			GetUid:       sg.Params.GetUid,
 			IgnoreReflex: sg.Params.IgnoreReflex,
 			Langs:        gchild.Langs,
+			Levels:       gchild.Levels,
 			NeedsVar:     append(gchild.NeedsVar[:0:0], gchild.NeedsVar...),
 			Normalize:    gchild.Normalize || sg.Params.Normalize,
 			Order:        gchild.Order,
 			Var:          gchild.Var,
 			GroupbyAttrs: gchild.GroupbyAttrs,
+			GroupbyArgs:  gchild.GroupbyArgs,
 			IsGroupBy:    gchild.IsGroupby,
 			IsInternal:   gchild.IsInternal,
 		}
@@ -739,6 +781,10 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// ExplainKey is the key used to ask for the plan of a query instead of its results.
+	ExplainKey
+	// QuorumKey is the key used to ask for a quorum read.
+	QuorumKey
 )
 
 func isDebug(ctx context.Context) bool {
@@ -759,6 +805,29 @@ func isDebug(ctx context.Context) bool {
 	return debug || d
 }
 
+// IsExplain returns whether the plan of the query is asked for instead of its results. Like
+// debug mode, it's set in the metadata by gRPC clients and in a query parameter over HTTP.
+func IsExplain(ctx context.Context) bool {
+	var explain bool
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["explain"]) > 0 {
+		explain, _ = strconv.ParseBool(md["explain"][0])
+	}
+	e, _ := ctx.Value(ExplainKey).(bool)
+	return explain || e
+}
+
+// IsQuorum returns whether a quorum read is asked for, so that each group reads its data only
+// once its leader has confirmed that it has applied all the committed writes. It's set like debug
+// mode too.
+func IsQuorum(ctx context.Context) bool {
+	var quorum bool
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["quorum"]) > 0 {
+		quorum, _ = strconv.ParseBool(md["quorum"][0])
+	}
+	q, _ := ctx.Value(QuorumKey).(bool)
+	return quorum || q
+}
+
 func (sg *SubGraph) populate(uids []uint64) error {
 	// Put sorted entries in matrix.
 	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
@@ -791,8 +860,10 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		ShortestPathArgs: gq.ShortestPathArgs,
 		Var:              gq.Var,
 		GroupbyAttrs:     gq.GroupbyAttrs,
+		GroupbyArgs:      gq.GroupbyArgs,
 		IsGroupBy:        gq.IsGroupby,
 		AllowedPreds:     gq.AllowedPreds,
+		Total:            gq.Total,
 	}
 
 	for argk := range gq.Args {
@@ -907,12 +978,13 @@ func createTaskQuery(sg *SubGraph) (*pb.Query, error) {
 	out := &pb.Query{
 		ReadTs:       sg.ReadTs,
 		Cache:        int32(sg.Cache),
+		Quorum:       sg.Quorum,
 		Attr:         attr,
 		Langs:        sg.Params.Langs,
 		Reverse:      reverse,
 		SrcFunc:      srcFunc,
 		AfterUid:     sg.Params.AfterUID,
-		DoCount:      len(sg.Filters) == 0 && sg.Params.DoCount,
+		DoCount:      (len(sg.Filters) == 0 && sg.Params.DoCount) || sg.countsTotal(),
 		FacetParam:   sg.Params.Facet,
 		FacetsFilter: sg.facetsFilter,
 		ExpandAll:    sg.Params.ExpandAll,
@@ -954,8 +1026,9 @@ func calculateFirstN(sg *SubGraph) int32 {
 	// Manish: Shouldn't all functions allow this? If we don't have a order and we don't have a
 	// filter, then we can respect the first N, offset Y arguments when retrieving data.
 	isSupportedFunction := true
+	// - No @total (We need all the results to count them), unless the worker counts them
 	if len(sg.Filters) == 0 && len(sg.Params.Order) == 0 &&
-		isSupportedFunction {
+		(!sg.Params.Total || sg.countsTotal()) && isSupportedFunction {
 		// Offset also added because, we need n results to trim the offset.
 		if sg.Params.Count != 0 {
 			count = sg.Params.Count + sg.Params.Offset
@@ -964,6 +1037,16 @@ func calculateFirstN(sg *SubGraph) int32 {
 	return int32(count)
 }
 
+// countsTotal returns whether the @total of sg is counted by the worker that runs its has()
+// function, which reads it from the count index of the predicate if there's one. That needs
+// every node that has() finds to be in the result, so nothing must drop any of them.
+func (sg *SubGraph) countsTotal() bool {
+	return sg.Params.Total && sg.SrcFunc != nil && sg.SrcFunc.Name == "has" &&
+		sg.SrcUIDs == nil && len(sg.Filters) == 0 && len(sg.Params.Cascade) == 0 &&
+		len(sg.Params.Order) == 0 && len(sg.Params.FacetsOrder) == 0 &&
+		len(sg.Params.Langs) == 0 && sg.Params.AfterUID == 0 && !sg.Params.DoCount
+}
+
 // varValue is a generic representation of a variable and holds multiple things.
 // TODO(pawan) - Come back to this and document what do individual fields mean and when are they
 // populated.
@@ -1386,6 +1469,9 @@ func (sg *SubGraph) populateVarMap(doneVars map[string]varValue, sgPath []*SubGr
 	// Note the we can't overwrite DestUids, as it'd also modify the SrcUids of
 	// next level and the mapping from SrcUids to uidMatrix would be lost.
 	sg.DestUIDs = &pb.List{Uids: out}
+	if sg.heldPage != nil {
+		sg.applyHeldPage()
+	}
 
 AssignStep:
 	return sg.updateVars(doneVars, sgPath)
@@ -1701,9 +1787,15 @@ func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 			lists = append(lists, l.Uids)
 
 		case (v.Typ == gql.AnyVar || v.Typ == gql.ValueVar):
-			// This should happen only once.
-			// TODO: This allows only one value var per subgraph, change it later
-			sg.Params.UidToVal = l.Vals
+			isOrder := len(sg.Params.Order) > 0 && v.Name == sg.Params.Order[0].Attr
+			if isOrder {
+				sg.Params.OrderVals = l.Vals
+			}
+			// TODO: This allows only one value var besides the one to order by per subgraph,
+			// change it later
+			if !isOrder || sg.Params.UidToVal == nil {
+				sg.Params.UidToVal = l.Vals
+			}
 
 		case (v.Typ == gql.AnyVar || v.Typ == gql.UidVar) && len(l.Vals) != 0:
 			// Derive the UID list from value var.
@@ -1877,6 +1969,20 @@ func recursiveCopy(dst *SubGraph, src *SubGraph) {
 	}
 }
 
+// exceptPreds returns preds without the predicates in except.
+func exceptPreds(preds, except []string) []string {
+	if len(except) == 0 {
+		return preds
+	}
+	res := preds[:0]
+	for _, pred := range preds {
+		if !x.HasString(except, pred) {
+			res = append(res, pred)
+		}
+	}
+	return res
+}
+
 func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	span := otrace.FromContext(ctx)
 	stop := x.SpanTimer(span, "expandSubgraph: "+sg.Attr)
@@ -1934,6 +2040,7 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			}
 		}
 		preds = uniquePreds(preds)
+		preds = exceptPreds(preds, child.Params.ExpandExcept)
 
 		// There's a types filter at this level so filter out any non-uid predicates
 		// since only uid nodes can have a type.
@@ -1947,6 +2054,7 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		for _, pred := range preds {
 			temp := &SubGraph{
 				ReadTs: sg.ReadTs,
+				Quorum: sg.Quorum,
 				Attr:   pred,
 			}
 			temp.Params = child.Params
@@ -2090,7 +2198,9 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
+			taskStart := time.Now()
 			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			sg.stats.taskLatency = time.Since(taskStart)
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
 				sg.UnknownAttr = true
@@ -2107,6 +2217,13 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.counts = result.Counts
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
+			if sg.countsTotal() {
+				// The worker counted all the nodes that has() found, not only the first ones.
+				if len(result.Counts) > 0 {
+					sg.total = int(result.Counts[0])
+				}
+				sg.counts = nil
+			}
 
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
@@ -2130,6 +2247,9 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
+	if sg.DestUIDs != nil {
+		sg.stats.fetched = len(sg.DestUIDs.Uids)
+	}
 	// Run filters if any.
 	if len(sg.Filters) > 0 {
 		// Run all filters in parallel.
@@ -2190,6 +2310,16 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.DestUIDs = algo.IntersectSorted(lists)
 		}
 	}
+	if sg.DestUIDs != nil {
+		sg.stats.filtered = len(sg.DestUIDs.Uids)
+	}
+	if parent == nil && sg.Params.Total && !sg.countsTotal() && sg.DestUIDs != nil {
+		// @total counts the nodes that are left once the sort has dropped those without a value
+		// to sort by, and @cascade those without the predicates it needs. So everything is sorted,
+		// and the page is taken once they're counted.
+		sg.heldPage = &page{count: sg.Params.Count, offset: sg.Params.Offset}
+		sg.Params.Count, sg.Params.Offset = len(sg.DestUIDs.Uids), 0
+	}
 
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetsOrder) == 0 {
 		// There is no ordering. Just apply pagination and return.
@@ -2208,6 +2338,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
+	if sg.heldPage != nil && len(sg.Params.Cascade) == 0 {
+		sg.applyHeldPage()
+	}
+
 	// Here we consider handling count with filtering. We do this after
 	// pagination because otherwise, we need to do the count with pagination
 	// taken into account. For example, a PL might have only 50 entries but the
@@ -2239,6 +2373,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.Children = append(sg.Children, &SubGraph{
 				Attr:   it.Attr,
 				ReadTs: sg.ReadTs,
+				Quorum: sg.Quorum,
 				Params: params{
 					Alias:        it.Alias,
 					IgnoreResult: true,
@@ -2322,6 +2457,28 @@ func (sg *SubGraph) applyPagination(ctx context.Context) error {
 	return nil
 }
 
+// applyHeldPage counts the nodes of a root with @total, and then applies the pagination that was
+// held back for it. It doesn't change the lists in place, as with @cascade they're the SrcUIDs of
+// the children already.
+func (sg *SubGraph) applyHeldPage() {
+	sg.total = len(sg.DestUIDs.Uids)
+	sg.Params.Count, sg.Params.Offset = sg.heldPage.count, sg.heldPage.offset
+	sg.heldPage = nil
+
+	for i, ul := range sg.uidMatrix {
+		uids := make([]uint64, 0, len(ul.Uids))
+		for _, uid := range ul.Uids {
+			if algo.IndexOf(sg.DestUIDs, uid) >= 0 {
+				uids = append(uids, uid)
+			}
+		}
+		start, end := x.PageRange(sg.Params.Count, sg.Params.Offset, len(uids))
+		sg.uidMatrix[i] = &pb.List{Uids: uids[start:end]}
+	}
+	sg.DestUIDs = &pb.List{Uids: append([]uint64{}, sg.DestUIDs.Uids...)}
+	sg.updateDestUids()
+}
+
 // applyOrderAndPagination orders each posting list by a given attribute
 // before applying pagination.
 func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
@@ -2345,6 +2502,10 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		}
 	}
 
+	if sg.heldPage != nil && sg.heldPage.count == 0 {
+		// Only return up to 1000 results by default, also once they're counted for @total.
+		sg.heldPage.count = 1000
+	}
 	if sg.Params.Count == 0 {
 		// Only retrieve up to 1000 results by default.
 		sg.Params.Count = 1000
@@ -2358,6 +2519,7 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		Offset:    int32(sg.Params.Offset),
 		Count:     int32(sg.Params.Count),
 		ReadTs:    sg.ReadTs,
+		Quorum:    sg.Quorum,
 	}
 	result, err := worker.SortOverNetwork(ctx, sortMsg)
 	if err != nil {
@@ -2492,9 +2654,9 @@ func (sg *SubGraph) sortAndPaginateUsingFacet(ctx context.Context) error {
 
 func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 	// nil has a different meaning from an initialized map of zero length here. If the variable
-	// didn't return any values then UidToVal would be an empty with zero length. If the variable
-	// was used before definition, UidToVal would be nil.
-	if sg.Params.UidToVal == nil {
+	// didn't return any values then OrderVals would be an empty with zero length. If the variable
+	// was used before definition, OrderVals would be nil.
+	if sg.Params.OrderVals == nil {
 		return errors.Errorf("Variable: [%s] used before definition.", sg.Params.Order[0].Attr)
 	}
 
@@ -2503,7 +2665,7 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 		uids := make([]uint64, 0, len(ul.Uids))
 		values := make([][]types.Val, 0, len(ul.Uids))
 		for _, uid := range ul.Uids {
-			v, ok := sg.Params.UidToVal[uid]
+			v, ok := sg.Params.OrderVals[uid]
 			if !ok {
 				// We skip the UIDs which don't have a value.
 				continue
@@ -2547,7 +2709,7 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "jsonpath":
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2578,6 +2740,7 @@ func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 		Attr:    "dgraph.type",
 		SrcUIDs: sg.DestUIDs,
 		ReadTs:  sg.ReadTs,
+		Quorum:  sg.Quorum,
 	}
 	taskQuery, err := createTaskQuery(temp)
 	if err != nil {
@@ -2644,6 +2807,7 @@ func UidToHex(uid uint64) string {
 type Request struct {
 	ReadTs   uint64 // ReadTs for the transaction.
 	Cache    int    // 0 represents use txn cache, 1 represents not to use cache.
+	Quorum   bool   // Whether the tasks are read through the leaders of the groups.
 	Latency  *Latency
 	GqlQuery *gql.Result
 
@@ -2680,6 +2844,7 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 		sg.recurse(func(sg *SubGraph) {
 			sg.ReadTs = req.ReadTs
 			sg.Cache = req.Cache
+			sg.Quorum = req.Quorum
 		})
 		span.Annotate(nil, "Query parsed")
 		req.Subgraphs = append(req.Subgraphs, sg)
@@ -1437,6 +1437,42 @@ func TestGroupByRootAlias2(t *testing.T) {
 	require.JSONEq(t, `{"data":{"me":[{"@groupby":[{"Age":17,"Count":1},{"Age":19,"Count":1},{"Age":38,"Count":1},{"Age":15,"Count":2}]}]}}`, js)
 }
 
+func TestGroupByRootOrderAndPaginate(t *testing.T) {
+	query := `
+	{
+		me(func: uid(1, 23, 24, 25, 31)) @groupby(age, orderdesc: count, orderasc: age, first: 2) {
+			count(uid)
+		}
+	}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"@groupby":[{"age":15,"count":2},{"age":17,"count":1}]}]}}`, js)
+
+	query = `
+	{
+		me(func: uid(1, 23, 24, 25, 31)) @groupby(Age: age, orderdesc: Age, offset: 1, first: 2) {
+			Count: count(uid)
+		}
+	}
+	`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"@groupby":[{"Age":19,"Count":1},{"Age":17,"Count":1}]}]}}`, js)
+}
+
+func TestGroupByOrderUnknownError(t *testing.T) {
+	query := `
+	{
+		me(func: uid(1, 23, 24, 25, 31)) @groupby(age, orderdesc: total) {
+			count(uid)
+		}
+	}
+	`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		"Groups can't be ordered by total, as it isn't an aggregate or an attribute of the groupby")
+}
+
 func TestGroupBy_RepeatAttr(t *testing.T) {
 	query := `
 	{
@@ -2099,6 +2135,26 @@ func TestVarInIneq5(t *testing.T) {
 	require.JSONEq(t, js2, js1)
 }
 
+func TestVarInIneqOrderByOtherVar(t *testing.T) {
+	query := `
+		{
+			var(func: uid(0x19, 0x1f)) {
+				a as name
+				s as age
+			}
+
+			me(func: eq(name, val(a)), orderdesc: val(s)) {
+				name
+				val(s)
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Andrea","val(s)":19},{"name":"Daryl Dixon","val(s)":17}]}}`,
+		js)
+}
+
 func TestNestedFuncRoot(t *testing.T) {
 	query := `
     {
@@ -536,6 +536,102 @@ func TestDebugUid(t *testing.T) {
 	require.JSONEq(t, `[{"friend":[{"name":"Rick Grimes","uid":"0x17"},{"name":"Glenn Rhee","uid":"0x18"},{"name":"Daryl Dixon","uid":"0x19"},{"name":"Andrea","uid":"0x1f"}],"name":"Michonne","uid":"0x1"}]`, string(body))
 }
 
+func TestExplain(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				friend @filter(anyofterms(name, "Rick Andrea")) {
+					name
+				}
+			}
+		}`
+
+	md := metadata.Pairs("explain", "true")
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	buf, err := processQuery(ctx, t, query)
+	require.NoError(t, err)
+	var res struct {
+		Data struct {
+			Explain []*planNode
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(buf), &res))
+	require.Len(t, res.Data.Explain, 1)
+
+	me := res.Data.Explain[0]
+	require.Equal(t, "me", me.Block)
+	require.Equal(t, "uid(0x1)", me.Func)
+	require.Equal(t, 1, *me.Estimated)
+	require.Equal(t, 1, me.Uids)
+	require.Len(t, me.Children, 2)
+
+	name := me.Children[0]
+	require.Equal(t, "name", name.Attr)
+	require.Equal(t, 1, name.SrcUids)
+	require.Equal(t, 1, name.Values)
+	require.Nil(t, name.Estimated)
+	require.Greater(t, name.TaskNs, int64(0))
+
+	friend := me.Children[1]
+	require.Equal(t, "friend", friend.Attr)
+	// friend is a list of uids, so nothing bounds how many it finds.
+	require.Nil(t, friend.Estimated)
+	require.Equal(t, 5, friend.Fetched)
+	require.Equal(t, 2, friend.Filtered)
+	require.Equal(t, 2, friend.Uids)
+	require.Equal(t, "intersect", friend.Combine)
+	require.Len(t, friend.Filters, 1)
+	require.Equal(t, `anyofterms(name, "Rick Andrea")`, friend.Filters[0].Func)
+	require.True(t, friend.Filters[0].Index)
+	require.Equal(t, 5, friend.Filters[0].SrcUids)
+	require.Equal(t, 5, *friend.Filters[0].Estimated)
+	require.Equal(t, 2, friend.Filters[0].Uids)
+}
+
+func TestExplainEstimates(t *testing.T) {
+	query := `
+		{
+			me(func: has(name), first: 3) {
+				friend(first: 2) {
+					name
+				}
+				best_friend {
+					name
+				}
+			}
+		}`
+
+	md := metadata.Pairs("explain", "true")
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	buf, err := processQuery(ctx, t, query)
+	require.NoError(t, err)
+	var res struct {
+		Data struct {
+			Explain []*planNode
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(buf), &res))
+	require.Len(t, res.Data.Explain, 1)
+
+	// has() reads the predicate, so only first bounds it.
+	me := res.Data.Explain[0]
+	require.Equal(t, 3, *me.Estimated)
+	require.Len(t, me.Children, 2)
+
+	friend := me.Children[0]
+	require.Equal(t, "friend", friend.Attr)
+	require.Equal(t, 2*friend.SrcUids, *friend.Estimated)
+	require.LessOrEqual(t, friend.Uids, *friend.Estimated)
+
+	// best_friend holds a single uid, so there's at most one for each uid it starts from.
+	bestFriend := me.Children[1]
+	require.Equal(t, "best_friend", bestFriend.Attr)
+	require.Equal(t, bestFriend.SrcUids, *bestFriend.Estimated)
+}
+
 func TestUidAlias(t *testing.T) {
 
 	query := `
@@ -840,6 +936,22 @@ func TestMathVar3(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"age":38,"val(a)":76.000000},{"age":15,"val(a)":30.000000},{"age":19,"val(a)":38.000000}],"me2":[{"val(a)":76.000000},{"val(a)":30.000000},{"val(a)":38.000000}]}}`, js)
 }
 
+func TestMathStringFunctions(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(1)) @normalize {
+				n as name
+				a as age
+				greeting: math(concat(upper(n), concat(" is ", a)))
+				short: math(lower(substring(n, 0, 3)))
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"greeting":"MICHONNE is 38","short":"mic"}]}}`, js)
+}
+
 func TestMultipleEquality(t *testing.T) {
 
 	query := `
@@ -18,6 +18,7 @@ package query
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -553,6 +554,80 @@ func TestToFastJSONFilterOrFirstOffsetCount(t *testing.T) {
 		js)
 }
 
+func TestToFastJSONFirstOffsetTotal(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01, 23, 24, 25, 31), first: 2) @total {
+				name
+			}
+			older(func: uid(0x01, 23, 24, 25, 31), offset: 1, first: 1) @filter(ge(age, 17)) @total {
+				name
+			}
+			none(func: uid(0x01, 23, 24, 25, 31)) @filter(ge(age, 100)) @total {
+				name
+			}
+		}
+	`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"total":5},{"name":"Michonne"},{"name":"Rick Grimes"}],
+		"older":[{"total":3},{"name":"Daryl Dixon"}],"none":[{"total":0}]}}`,
+		js)
+}
+
+func TestToFastJSONTotalAfterSortAndCascade(t *testing.T) {
+
+	query := `
+		{
+			sorted(func: uid(0x01, 23, 24, 25, 31, 0x99999), orderdesc: age, first: 2) @total {
+				name
+			}
+			cascaded(func: uid(0x01, 23, 24, 25, 31), first: 1) @cascade @total {
+				name
+				friend {
+					name
+				}
+			}
+		}
+	`
+
+	// The node without an age isn't sorted, and the ones without friends are dropped by the
+	// cascade, so none of them are counted.
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"sorted":[{"total":5},{"name":"Michonne"},{"name":"Andrea"}],
+		"cascaded":[{"total":3},{"name":"Michonne","friend":[{"name":"Rick Grimes"},
+		{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"}]}]}}`,
+		js)
+}
+
+func TestToFastJSONTotalOfHas(t *testing.T) {
+
+	query := `
+		{
+			first(func: has(friend), first: 1) @total {
+				uid
+			}
+			all(func: has(friend)) {
+				count(uid)
+			}
+		}
+	`
+
+	js := processQueryNoErr(t, query)
+	var res struct {
+		Data struct {
+			First []map[string]interface{}
+			All   []map[string]interface{}
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	require.Len(t, res.Data.First, 2)
+	require.Equal(t, res.Data.All[0]["count"], res.Data.First[0]["total"])
+}
+
 func TestToFastJSONFilterOrFirstNegative(t *testing.T) {
 
 	// When negative first/count is specified, we ignore offset and returns the last
@@ -1529,6 +1604,32 @@ func TestGeneratorRootFilterOnCountGt(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"}]}}`, js)
 }
 
+func TestGeneratorRootFilterOnCountEq(t *testing.T) {
+
+	query := `
+                {
+                        me(func:anyofterms(name, "Michonne Rick")) @filter(eq(count(friend), 1)) {
+                                name
+                        }
+                }
+        `
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"}]}}`, js)
+}
+
+func TestGeneratorRootFilterOnCountBetween(t *testing.T) {
+
+	query := `
+                {
+                        me(func:anyofterms(name, "Michonne Rick")) @filter(between(count(friend), 2, 5)) {
+                                name
+                        }
+                }
+        `
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"}]}}`, js)
+}
+
 func TestGeneratorRootFilterOnCountle(t *testing.T) {
 
 	query := `
@@ -1673,6 +1774,45 @@ func TestNearGeneratorErrorMissDist(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNearestGenerator(t *testing.T) {
+	query := `{
+		me(func: nearest(geometry, [-122.082, 37.425], 2)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Googleplex"},{"name":"Shoreline Amphitheater"}]}}`, js)
+}
+
+func TestNearestOrderedByDistance(t *testing.T) {
+	query := `{
+		var(func: has(geometry)) @filter(nearest(geometry, [-122.2527, 37.5136], 2)) {
+			g as geometry
+			d as math(distance(g, "[-122.2527, 37.5136]"))
+		}
+		me(func: uid(d), orderasc: val(d)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"San Carlos Airport"},{"name":"Googleplex"}]}}`, js)
+}
+
+func TestNearestGeneratorError(t *testing.T) {
+	query := `{
+		me(func: nearest(geometry, [-122.082, 37.425], 0)) {
+			name
+		}
+	}`
+
+	_, err := processQuery(context.Background(), t, query)
+	require.Contains(t, err.Error(), "The number of nodes of nearest must be a positive int, got 0")
+}
+
 func TestWithinGeneratorError(t *testing.T) {
 
 	query := `{
@@ -1829,6 +1969,29 @@ func TestNormalizeDirective(t *testing.T) {
 		}`, js)
 }
 
+func TestNormalizeDirectiveDottedAlias(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) @normalize {
+				name: name
+				son {
+					son.name: name
+				}
+			}
+		}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+			"data": {
+				"me": [
+					{"name": "Michonne", "son": {"name": "Andre"}},
+					{"name": "Michonne", "son": {"name": "Helmut"}}
+				]
+			}
+		}`, js)
+}
+
 func TestNormalizeDirectiveSubQueryLevel1(t *testing.T) {
 	query := `
 		{
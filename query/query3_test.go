@@ -194,6 +194,53 @@ func TestRecurseQueryLimitDepth2(t *testing.T) {
 		`{"data": {"me":[{"uid":"0x1","friend":[{"uid":"0x17","name":"Rick Grimes"},{"uid":"0x18","name":"Glenn Rhee"},{"uid":"0x19","name":"Daryl Dixon"},{"uid":"0x1f","name":"Andrea"},{"uid":"0x65"}],"name":"Michonne"}]}}`, js)
 }
 
+func TestRecurseQueryLevels(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(depth: 3) {
+				name
+				friend @levels(to: 1) @filter(eq(name, "Rick Grimes") OR eq(name, "Andrea"))
+				friend @levels(from: 2)
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes", "friend":[{"name":"Michonne"}]},{"name":"Andrea", "friend":[{"name":"Glenn Rhee"}]}]}]}}`, js)
+}
+
+func TestRecurseQueryLevelsOverlapError(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(depth: 3) {
+				name
+				friend @levels(to: 2)
+				friend @levels(from: 2)
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		"friend is specified more than once in the recurse query, with @levels that overlap")
+}
+
+func TestLevelsWithoutRecurseError(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend @levels(to: 2) {
+					name
+				}
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		"@levels is only allowed on predicates of a @recurse block, but was used on friend.")
+}
+
 func TestRecurseVariable(t *testing.T) {
 
 	query := `
@@ -2150,6 +2197,46 @@ func TestMultiLevelAgg1(t *testing.T) {
 		js)
 }
 
+func TestMultiLevelAggReverse(t *testing.T) {
+	// Each node splits a score of 10 among its friends, and the nodes sum up what they get from
+	// the nodes that have them as a friend, like a step of PageRank does.
+	query := `
+	{
+		var(func: uid(0x01, 23, 31)) {
+			s as math(10)
+			d as count(friend)
+		}
+
+		var(func: uid(24)) {
+			~friend {
+				c as math(s / d)
+			}
+			r as sum(val(c))
+		}
+
+		var(func: uid(23)) {
+			friend {
+				fc as math(s / d)
+			}
+			fr as sum(val(fc))
+		}
+
+		reverse(func: uid(r)) {
+			name
+			val(r)
+		}
+		forward(func: uid(fr)) {
+			name
+			val(fr)
+		}
+	}
+`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"reverse":[{"name":"Glenn Rhee","val(r)":12}],"forward":[{"name":"Rick Grimes","val(fr)":2}]}}`,
+		js)
+}
+
 func TestMultiLevelAgg1Error(t *testing.T) {
 
 	// Alright. Now we have everything set up. Let's create the query.
@@ -2358,6 +2445,18 @@ func TestPasswordExpandAll2(t *testing.T) {
 	"gender":"female", "name":"Michonne"}]}}`, js)
 }
 
+func TestExpandAllExcept(t *testing.T) {
+	query := `
+    {
+        me(func: uid(0x01)) {
+			expand(_all_, except: [gender, alive])
+		}
+    }
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"name":"Michonne"}]}}`, js)
+}
+
 func TestPasswordExpandError(t *testing.T) {
 	query := `
     {
@@ -3060,6 +3159,32 @@ func TestFilterRegex17(t *testing.T) {
 	require.Contains(t, err.Error(), "Function 'regexp' requires 2 arguments,")
 }
 
+func TestFilterRegexCaseInsensitiveAtRoot(t *testing.T) {
+	query := `
+		{
+			me(func: regexp(value, /^MISSION/i)) {
+				value
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"value":"mission"}, {"value":"missionary"}]}}`, js)
+}
+
+func TestFilterRegexAnchored(t *testing.T) {
+	query := `
+		{
+			me(func: regexp(value, /^(sub)?mission$/)) {
+				value
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"value":"mission"}, {"value":"submission"}]}}`, js)
+}
+
 func TestTypeFunction(t *testing.T) {
 	query := `
 		{
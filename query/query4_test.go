@@ -26,6 +26,7 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestBigMathValue(t *testing.T) {
@@ -1561,3 +1562,69 @@ func TestNumUids(t *testing.T) {
 	require.Equal(t, metrics.NumUids["name"], uint64(16))
 	require.Equal(t, metrics.NumUids["_total"], uint64(26))
 }
+
+func TestJSONPath(t *testing.T) {
+	s1 := testSchema + "\n payload: string .\n"
+	setSchema(s1)
+	triples := `
+		_:order1 <payload> "{\"status\": \"shipped\", \"items\": [{\"qty\": 2}], \"total\": 30.5}" .
+		_:order2 <payload> "{\"status\": \"pending\", \"items\": [{\"qty\": 3}]}" .
+		_:order3 <payload> "not json" .
+	`
+	require.NoError(t, addTriplesToCluster(triples))
+
+	t.Run("filter with a value", func(t *testing.T) {
+		q := `
+		{
+			q(func: has(payload)) @filter(jsonpath(payload, "$.status", "shipped")) {
+				t as payload
+				total: math(jsonpath(t, "$.total"))
+			}
+		}`
+		js := processQueryNoErr(t, q)
+		require.JSONEq(t, `{"data": {"q": [{"payload": "{\"status\": \"shipped\", `+
+			`\"items\": [{\"qty\": 2}], \"total\": 30.5}", "total": 30.5}]}}`, js)
+	})
+
+	t.Run("filter on a path being there", func(t *testing.T) {
+		q := `
+		{
+			q(func: has(payload)) @filter(jsonpath(payload, "$.items[0].qty")) {
+				p as payload
+				qty: math(jsonpath(p, "$.items[0].qty") * 2)
+			}
+		}`
+		js := processQueryNoErr(t, q)
+		require.JSONEq(t, `{"data": {"q": [
+			{"payload": "{\"status\": \"shipped\", \"items\": [{\"qty\": 2}], \"total\": 30.5}",
+				"qty": 4},
+			{"payload": "{\"status\": \"pending\", \"items\": [{\"qty\": 3}]}", "qty": 6}
+		]}}`, js)
+	})
+
+	t.Run("at root", func(t *testing.T) {
+		q := `
+		{
+			q(func: jsonpath(payload, "$.status")) {
+				payload
+			}
+		}`
+		_, err := processQuery(context.Background(), t, q)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "jsonpath() can only be used in a filter")
+	})
+}
+
+func TestIsQuorum(t *testing.T) {
+	require.False(t, IsQuorum(context.Background()))
+	require.True(t, IsQuorum(context.WithValue(context.Background(), QuorumKey, true)))
+
+	withMetadata := func(v string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("quorum", v))
+	}
+	require.True(t, IsQuorum(withMetadata("true")))
+	require.True(t, IsQuorum(withMetadata("1")))
+	require.False(t, IsQuorum(withMetadata("false")))
+	// A value that isn't a bool doesn't ask for a quorum read.
+	require.False(t, IsQuorum(withMetadata("maybe")))
+}
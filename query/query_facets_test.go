@@ -17,6 +17,7 @@
 package query
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -1157,6 +1158,66 @@ func TestFacetsFilterNotAndOrgeMutuallyExclusive(t *testing.T) {
 		js)
 }
 
+func TestFacetsFilterBetween(t *testing.T) {
+	populateClusterWithFacets()
+	// find friends of 1 since 2005 or 2006.
+	query := `
+		{
+			me(func: uid(0x1)) {
+				name
+				friend @facets(between(since, "2005-01-01", "2006-12-31")) {
+					name
+					uid
+				}
+			}
+		}
+	`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"uid":"0x17","name":"Rick Grimes"},{"uid":"0x1f","name":"Andrea"},{"uid":"0x65"}],"name":"Michonne"}]}}`,
+		js)
+}
+
+func TestFacetsFilterBetweenAnd(t *testing.T) {
+	populateClusterWithFacets()
+	// find close friends of 1 since 2005 or 2006.
+	query := `
+		{
+			me(func: uid(0x1)) {
+				name
+				friend @facets(between(since, "2005-01-01", "2006-12-31") AND eq(close, true)) {
+					name
+					uid
+				}
+			}
+		}
+	`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"uid":"0x65"}],"name":"Michonne"}]}}`,
+		js)
+}
+
+func TestFacetsFilterBetweenOneArg(t *testing.T) {
+	populateClusterWithFacets()
+	query := `
+		{
+			me(func: uid(0x1)) {
+				name
+				friend @facets(between(since, "2005-01-01")) {
+					name
+				}
+			}
+		}
+	`
+
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Two arguments expected in between, but got 1.")
+}
+
 func TestFacetsFilterUnknownFacets(t *testing.T) {
 	populateClusterWithFacets()
 	// unknown facets should filter out edges.
@@ -22,6 +22,7 @@ import (
 	"math"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
@@ -56,7 +57,7 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 	}
 
 	// Add children back and expand if necessary
-	if exec, err = expandChildren(ctx, start, startChildren); err != nil {
+	if exec, err = expandChildren(ctx, start, childrenAtLevel(startChildren, 1)); err != nil {
 		return err
 	}
 
@@ -146,6 +147,7 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 		}
 
 		// modify the exec and attach child nodes.
+		levelChildren := childrenAtLevel(startChildren, depth+1)
 		var out []*SubGraph
 		var exp []*SubGraph
 		for _, sg := range exec {
@@ -155,7 +157,7 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 			if len(sg.DestUIDs.Uids) == 0 {
 				continue
 			}
-			if exp, err = expandChildren(ctx, sg, startChildren); err != nil {
+			if exp, err = expandChildren(ctx, sg, levelChildren); err != nil {
 				return err
 			}
 			out = append(out, exp...)
@@ -174,6 +176,37 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 	}
 }
 
+// childrenAtLevel returns the children of a @recurse block that are expanded for the nodes at
+// the given level, leaving out those whose @levels don't include it.
+func childrenAtLevel(children []*SubGraph, level uint64) []*SubGraph {
+	out := make([]*SubGraph, 0, len(children))
+	for _, child := range children {
+		if includesLevel(child.Params.Levels, level) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+func includesLevel(levels *gql.RecurseLevels, level uint64) bool {
+	if levels == nil {
+		return true
+	}
+	return level >= levels.From && (levels.To == 0 || level <= levels.To)
+}
+
+// levelsOverlap tells whether two @levels, either of which may be missing, share a level.
+func levelsOverlap(a, b *gql.RecurseLevels) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	from := a.From
+	if b.From > from {
+		from = b.From
+	}
+	return includesLevel(a, from) && includesLevel(b, from)
+}
+
 // expandChildren adds child nodes to a SubGraph with no children, expanding them if necessary.
 func expandChildren(ctx context.Context, sg *SubGraph, children []*SubGraph) ([]*SubGraph, error) {
 	if len(sg.Children) > 0 {
@@ -214,11 +247,21 @@ func recurse(ctx context.Context, sg *SubGraph) error {
 		depth = math.MaxUint64
 	}
 
-	for _, child := range sg.Children {
+	for i, child := range sg.Children {
 		if len(child.Children) > 0 {
 			return errors.Errorf(
 				"recurse queries require that all predicates are specified in one level")
 		}
+		// The same predicate may be given more than once, with different filters, but the
+		// results for a node would have the same name twice if their levels overlapped.
+		for _, other := range sg.Children[:i] {
+			if (other.Params.Levels != nil || child.Params.Levels != nil) &&
+				other.fieldName() == child.fieldName() &&
+				levelsOverlap(other.Params.Levels, child.Params.Levels) {
+				return errors.Errorf("%s is specified more than once in the recurse query, "+
+					"with @levels that overlap", child.fieldName())
+			}
+		}
 	}
 
 	return sg.expandRecurse(ctx, depth)
@@ -70,7 +70,7 @@ func TestEntryReadWrite(t *testing.T) {
 func TestLogRotate(t *testing.T) {
 	dir, err := ioutil.TempDir("", "raftwal")
 	require.NoError(t, err)
-	el, err := openWal(dir)
+	el, err := openWal(dir, false)
 	require.NoError(t, err)
 	defer os.RemoveAll(dir)
 
@@ -97,7 +97,7 @@ func TestLogRotate(t *testing.T) {
 	log.Printf("Wrote %d bytes", totalBytes)
 
 	// Reopen the file and retrieve all entries.
-	el, err = openWal(dir)
+	el, err = openWal(dir, false)
 	require.NoError(t, err)
 	entries := el.allEntries(0, math.MaxInt64, math.MaxInt64)
 	require.Equal(t, totalEntries, len(entries))
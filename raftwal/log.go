@@ -96,8 +96,8 @@ func logFname(dir string, id int64) string {
 }
 
 // openLogFile opens a logFile in the given directory. The filename is
-// constructed based on the value of fid.
-func openLogFile(dir string, fid int64) (*logFile, error) {
+// constructed based on the value of fid. If readOnly is set, the file must already exist.
+func openLogFile(dir string, fid int64, readOnly bool) (*logFile, error) {
 	glog.V(3).Infof("opening log file: %d\n", fid)
 	fpath := logFname(dir, fid)
 	lf := &logFile{
@@ -109,7 +109,7 @@ func openLogFile(dir string, fid int64) (*logFile, error) {
 	// later then the older log files which were previously encrypted can't be opened.
 	if len(encryptionKey) > 0 {
 		krOpt := badger.KeyRegistryOptions{
-			ReadOnly:                      false,
+			ReadOnly:                      readOnly,
 			Dir:                           dir,
 			EncryptionKey:                 encryptionKey,
 			EncryptionKeyRotationDuration: 10 * 24 * time.Hour,
@@ -120,8 +120,17 @@ func openLogFile(dir string, fid int64) (*logFile, error) {
 			return nil, err
 		}
 	}
-	// Open the file in read-write mode and create it if it doesn't exist yet.
-	lf.MmapFile, err = z.OpenMmapFile(fpath, os.O_RDWR|os.O_CREATE, logFileSize)
+	if readOnly {
+		lf.MmapFile, err = z.OpenMmapFile(fpath, os.O_RDONLY, 0)
+		if err == nil && len(lf.Data) < logFileOffset {
+			err = errors.Errorf("log file %s has %d bytes, less than the %d of its entries",
+				fpath, len(lf.Data), logFileOffset)
+			_ = lf.MmapFile.Close(-1)
+		}
+	} else {
+		// Open the file in read-write mode and create it if it doesn't exist yet.
+		lf.MmapFile, err = z.OpenMmapFile(fpath, os.O_RDWR|os.O_CREATE, logFileSize)
+	}
 
 	if err == z.NewFile {
 		glog.V(3).Infof("New file: %d\n", fid)
@@ -130,7 +139,7 @@ func openLogFile(dir string, fid int64) (*logFile, error) {
 			return nil, err
 		}
 	} else if err != nil {
-		x.Check(err)
+		return nil, err
 	} else {
 		buf := lf.Data[encOffset : encOffset+16]
 		keyID := binary.BigEndian.Uint64(buf[:8])
@@ -250,17 +259,17 @@ func (lf *logFile) slotGe(raftIndex uint64) int {
 
 // delete unmaps and deletes the file.
 func (lf *logFile) delete() error {
-	glog.V(2).Infof("Deleting file: %s\n", lf.Fd.Name())
+	glog.V(2).Infof("Deleting file: %s\n", fileName(lf.MmapFile))
 	err := lf.Delete()
 	if err != nil {
-		glog.Errorf("while deleting file: %s, error: %v\n", lf.Fd.Name(), err)
+		glog.Errorf("while deleting file: %s, error: %v\n", fileName(lf.MmapFile), err)
 	}
 	return err
 }
 
 // getLogFiles returns all the log files in the directory sorted by the first
 // index in each file.
-func getLogFiles(dir string) ([]*logFile, error) {
+func getLogFiles(dir string, readOnly bool) ([]*logFile, error) {
 	entryFiles := x.WalkPathFunc(dir, func(path string, isDir bool) bool {
 		if isDir {
 			return false
@@ -284,11 +293,11 @@ func getLogFiles(dir string) ([]*logFile, error) {
 		}
 
 		if _, ok := seen[fid]; ok {
-			glog.Fatalf("Entry file with id: %d is repeated", fid)
+			return nil, errors.Errorf("Entry file with id: %d is repeated", fid)
 		}
 		seen[fid] = struct{}{}
 
-		f, err := openLogFile(dir, fid)
+		f, err := openLogFile(dir, fid, readOnly)
 		if err != nil {
 			return nil, err
 		}
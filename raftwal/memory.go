@@ -0,0 +1,87 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package raftwal
+
+import (
+	"github.com/dgraph-io/ristretto/z"
+)
+
+// newMemoryFile returns a file of sz bytes that's only held in memory. It has no Fd, which
+// makes Delete and Close no-ops on it, so it has to be resized and synced with truncate and
+// syncFile instead of its own methods.
+func newMemoryFile(sz int) *z.MmapFile {
+	return &z.MmapFile{Data: make([]byte, sz)}
+}
+
+// newMemoryLogFile returns an empty log file held in memory. It starts with room for the
+// entries and as much data, and grows as it's written to.
+func newMemoryLogFile(fid int64) *logFile {
+	return &logFile{
+		MmapFile: newMemoryFile(2 * logFileOffset),
+		fid:      fid,
+	}
+}
+
+// openMemoryWal returns a wal that only holds its log files in memory.
+func openMemoryWal() *wal {
+	return &wal{
+		current:  newMemoryLogFile(1),
+		inMemory: true,
+	}
+}
+
+// truncate resizes mf to sz bytes.
+func truncate(mf *z.MmapFile, sz int64) error {
+	if mf.Fd != nil {
+		return mf.Truncate(sz)
+	}
+	data := make([]byte, sz)
+	copy(data, mf.Data)
+	mf.Data = data
+	return nil
+}
+
+// allocateFileSlice allocates a slice of sz bytes at offset in mf, like mf.AllocateSlice does.
+func allocateFileSlice(mf *z.MmapFile, sz, offset int) ([]byte, int, error) {
+	if mf.Fd == nil && offset+4+sz > len(mf.Data) {
+		// Grow the file here, as AllocateSlice can only grow the ones that have an Fd.
+		grow := len(mf.Data)
+		if grow < sz+4 {
+			grow = sz + 4
+		}
+		if err := truncate(mf, int64(len(mf.Data)+grow)); err != nil {
+			return nil, 0, err
+		}
+	}
+	return mf.AllocateSlice(sz, offset)
+}
+
+// syncFile flushes mf to disk, if it's a file on disk.
+func syncFile(mf *z.MmapFile) error {
+	if mf.Fd == nil {
+		return nil
+	}
+	return mf.Sync()
+}
+
+// fileName returns the name of the file mf, for logs and errors.
+func fileName(mf *z.MmapFile) string {
+	if mf.Fd == nil {
+		return "<memory>"
+	}
+	return mf.Fd.Name()
+}
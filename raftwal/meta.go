@@ -95,9 +95,21 @@ type metaFile struct {
 	*z.MmapFile
 }
 
-// newMetaFile opens the meta file in the given directory.
-func newMetaFile(dir string) (*metaFile, error) {
+// newMetaFile opens the meta file in the given directory. If readOnly is set, the file must
+// already exist.
+func newMetaFile(dir string, readOnly bool) (*metaFile, error) {
 	fname := filepath.Join(dir, metaName)
+	if readOnly {
+		mf, err := z.OpenMmapFile(fname, os.O_RDONLY, 0)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to open meta file")
+		}
+		if len(mf.Data) < metaFileSize {
+			return nil, errors.Errorf("meta file %s has %d bytes, instead of %d", fname,
+				len(mf.Data), metaFileSize)
+		}
+		return &metaFile{MmapFile: mf}, nil
+	}
 	// Open the file in read-write mode and creates it if it doesn't exist.
 	mf, err := z.OpenMmapFile(fname, os.O_RDWR|os.O_CREATE, metaFileSize)
 	if err == z.NewFile {
@@ -159,8 +171,8 @@ func (m *metaFile) StoreSnapshot(snap *raftpb.Snapshot) error {
 	glog.V(1).Infof("Got valid snapshot to store of length: %d\n", len(buf))
 
 	for len(m.Data)-snapshotOffset < len(buf) {
-		if err := m.Truncate(2 * int64(len(m.Data))); err != nil {
-			return errors.Wrapf(err, "while truncating: %s", m.Fd.Name())
+		if err := truncate(m.MmapFile, 2*int64(len(m.Data))); err != nil {
+			return errors.Wrapf(err, "while truncating: %s", fileName(m.MmapFile))
 		}
 	}
 	writeSlice(m.Data[snapshotOffset:], buf)
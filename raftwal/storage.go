@@ -79,6 +79,8 @@ type DiskStorage struct {
 	meta *metaFile
 	wal  *wal
 	lock sync.Mutex
+	// readOnly is set if the files were opened with OpenReadOnly.
+	readOnly bool
 }
 
 type indexRange struct {
@@ -100,14 +102,14 @@ func InitEncrypted(dir string, encKey x.SensitiveByteSlice) (*DiskStorage, error
 	}
 
 	var err error
-	if w.meta, err = newMetaFile(dir); err != nil {
+	if w.meta, err = newMetaFile(dir, false); err != nil {
 		return nil, err
 	}
 	// fmt.Printf("meta: %s\n", hex.Dump(w.meta.data[1024:2048]))
 	// fmt.Printf("found snapshot of size: %d\n", sliceSize(w.meta.data, snapshotOffset))
 
 	encryptionKey = encKey
-	if w.wal, err = openWal(dir); err != nil {
+	if w.wal, err = openWal(dir, false); err != nil {
 		return nil, err
 	}
 
@@ -134,6 +136,51 @@ func InitEncrypted(dir string, encKey x.SensitiveByteSlice) (*DiskStorage, error
 	return w, nil
 }
 
+// OpenReadOnly opens the WAL in dir only to read it, like tools that inspect it do. Unlike
+// InitEncrypted, it doesn't create, change or delete any of the files, and it returns an error
+// instead of crashing when they're missing or don't agree with each other.
+func OpenReadOnly(dir string, encKey x.SensitiveByteSlice) (*DiskStorage, error) {
+	w := &DiskStorage{
+		dir:      dir,
+		readOnly: true,
+	}
+
+	var err error
+	if w.meta, err = newMetaFile(dir, true); err != nil {
+		return nil, err
+	}
+	encryptionKey = encKey
+	if w.wal, err = openWal(dir, true); err != nil {
+		_ = w.meta.Close(-1)
+		return nil, err
+	}
+	w.elog = trace.NewEventLog("Badger", "RaftStorage")
+
+	snap, err := w.meta.snapshot()
+	if err != nil {
+		_ = w.Close()
+		return nil, err
+	}
+	first, _ := w.FirstIndex()
+	if !raft.IsEmptySnap(snap) && snap.Metadata.Index+1 != first {
+		_ = w.Close()
+		return nil, errors.Errorf("the first entry %d doesn't follow the snapshot at %d", first,
+			snap.Metadata.Index)
+	}
+	return w, nil
+}
+
+// InitMemory initializes an instance of DiskStorage that only holds the log in memory, so that
+// it's lost when the process exits. It's meant for tests and ephemeral clusters.
+func InitMemory() *DiskStorage {
+	glog.Infof("Init Raft Storage in memory")
+	return &DiskStorage{
+		meta: &metaFile{MmapFile: newMemoryFile(metaFileSize)},
+		wal:  openMemoryWal(),
+		elog: trace.NewEventLog("Badger", "RaftStorage"),
+	}
+}
+
 func (w *DiskStorage) SetUint(info MetaInfo, id uint64) { w.meta.SetUint(info, id) }
 func (w *DiskStorage) Uint(info MetaInfo) uint64        { return w.meta.Uint(info) }
 
@@ -374,10 +421,10 @@ func (w *DiskStorage) NumLogFiles() int {
 
 // Sync calls the Sync method in the underlying badger instance to write all the contents to disk.
 func (w *DiskStorage) Sync() error {
-	if err := w.meta.Sync(); err != nil {
+	if err := syncFile(w.meta.MmapFile); err != nil {
 		return errors.Wrapf(err, "while syncing meta")
 	}
-	if err := w.wal.current.Sync(); err != nil {
+	if err := syncFile(w.wal.current.MmapFile); err != nil {
 		return errors.Wrapf(err, "while syncing current file")
 	}
 	return nil
@@ -385,5 +432,15 @@ func (w *DiskStorage) Sync() error {
 
 // Close closes the DiskStorage.
 func (w *DiskStorage) Close() error {
+	if w.readOnly {
+		// There's nothing to sync, so only the files are closed.
+		files := append([]*logFile{w.wal.current}, w.wal.files...)
+		for _, lf := range files {
+			if err := lf.MmapFile.Close(-1); err != nil {
+				return err
+			}
+		}
+		return w.meta.Close(-1)
+	}
 	return w.Sync()
 }
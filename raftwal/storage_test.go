@@ -38,6 +38,7 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/dgraph-io/dgraph/x"
@@ -284,7 +285,7 @@ func TestMetaFile(t *testing.T) {
 	dir, err := ioutil.TempDir("", "badger-test")
 	require.NoError(t, err)
 
-	mf, err := newMetaFile(dir)
+	mf, err := newMetaFile(dir, false)
 	require.NoError(t, err)
 	id := mf.Uint(RaftId)
 	require.Zero(t, id)
@@ -330,7 +331,7 @@ func TestMetaFile(t *testing.T) {
 func TestEntryFile(t *testing.T) {
 	dir, err := ioutil.TempDir("", "raftwal")
 	require.NoError(t, err)
-	el, err := openWal(dir)
+	el, err := openWal(dir, false)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), el.firstIndex())
 	require.Zero(t, el.LastIndex())
@@ -466,7 +467,7 @@ func TestStorageBig(t *testing.T) {
 
 		require.Equal(t, 0, len(ds.wal.files))
 
-		files, err := getLogFiles(dir)
+		files, err := getLogFiles(dir, false)
 		require.NoError(t, err)
 		require.Equal(t, 1, len(files))
 
@@ -509,3 +510,95 @@ func TestStorageBig(t *testing.T) {
 	t.Run("without encryption", func(t *testing.T) { test(t, nil) })
 	t.Run("with encryption", func(t *testing.T) { test(t, []byte("badger16byteskey")) })
 }
+
+func TestStorageMemory(t *testing.T) {
+	ds := InitMemory()
+	ds.SetUint(RaftId, 3)
+	require.Equal(t, uint64(3), ds.Uint(RaftId))
+
+	// Write enough data to grow the files in memory, and enough entries to rotate them.
+	data := make([]byte, 1<<10)
+	rand.Read(data)
+	N := uint64(70000)
+	for idx := uint64(1); idx <= N; idx++ {
+		ent := raftpb.Entry{Term: 1, Index: idx, Type: raftpb.EntryNormal, Data: data}
+		require.NoError(t, ds.wal.AddEntries([]raftpb.Entry{ent}))
+	}
+	require.Equal(t, 2, ds.NumLogFiles())
+	require.Equal(t, int(N), ds.NumEntries())
+
+	ents, err := ds.Entries(29990, 30010, math.MaxInt64)
+	require.NoError(t, err)
+	require.Equal(t, 20, len(ents))
+	for i, e := range ents {
+		require.Equal(t, uint64(29990+i), e.Index)
+		require.Equal(t, data, e.Data)
+	}
+
+	// The snapshot is larger than the meta file, so it has to grow too.
+	snapData := make([]byte, 2*metaFileSize)
+	rand.Read(snapData)
+	require.NoError(t, ds.CreateSnapshot(N-100, &raftpb.ConfState{}, snapData))
+	snap, err := ds.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, N-100, snap.Metadata.Index)
+	require.Equal(t, snapData, snap.Data)
+
+	fi, err := ds.FirstIndex()
+	require.NoError(t, err)
+	require.Equal(t, N-100+1, fi)
+	require.NoError(t, ds.Sync())
+}
+
+func TestOpenReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raftwal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// A missing WAL isn't created.
+	missing := dir + "/missing"
+	_, err = OpenReadOnly(missing, nil)
+	require.Error(t, err)
+	_, err = os.Stat(missing)
+	require.True(t, os.IsNotExist(err))
+
+	ds := Init(dir)
+	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
+	require.NoError(t, ds.reset(ents))
+	require.NoError(t, ds.Close())
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	contents := make(map[string][]byte)
+	for _, fi := range files {
+		data, err := ioutil.ReadFile(dir + "/" + fi.Name())
+		require.NoError(t, err)
+		contents[fi.Name()] = data
+	}
+
+	ro, err := OpenReadOnly(dir, nil)
+	require.NoError(t, err)
+	all, err := ro.Entries(3, 6, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, ents, all)
+	require.NoError(t, ro.Close())
+
+	// Reading the WAL doesn't change any of its files.
+	files, err = ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, len(contents), len(files))
+	for _, fi := range files {
+		data, err := ioutil.ReadFile(dir + "/" + fi.Name())
+		require.NoError(t, err)
+		require.Equal(t, contents[fi.Name()], data, fi.Name())
+	}
+
+	// A cut short entry file is an error, instead of a crash.
+	for name := range contents {
+		if strings.HasSuffix(name, logSuffix) {
+			require.NoError(t, os.Truncate(dir+"/"+name, 100))
+		}
+	}
+	_, err = OpenReadOnly(dir, nil)
+	require.Error(t, err)
+}
@@ -45,6 +45,8 @@ type wal struct {
 	nextEntryIdx int
 	// dir is the directory to use to store files.
 	dir string
+	// inMemory is set if the files are only held in memory, in which case dir isn't used.
+	inMemory bool
 }
 
 // allEntries returns all the entries in the range [lo, hi).
@@ -137,7 +139,7 @@ func (l *wal) AddEntries(entries []raftpb.Entry) error {
 			for _, ef := range extra {
 				glog.V(2).Infof("Deleting extra file: %d\n", ef.fid)
 				if err := ef.delete(); err != nil {
-					glog.Errorf("deleting file: %s. error: %v\n", ef.Fd.Name(), err)
+					glog.Errorf("deleting file: %s. error: %v\n", fileName(ef.MmapFile), err)
 				}
 			}
 			z.ZeroOut(l.current.Data, entrySize*eidx, logFileOffset)
@@ -181,7 +183,7 @@ func (l *wal) AddEntries(entries []raftpb.Entry) error {
 		}
 
 		// Allocate slice for the data and copy bytes.
-		destBuf, next, err := l.current.AllocateSlice(len(re.Data), offset)
+		destBuf, next, err := allocateFileSlice(l.current.MmapFile, len(re.Data), offset)
 		if err != nil {
 			return err
 		}
@@ -336,7 +338,7 @@ func (l *wal) deleteBefore(raftIndex uint64) {
 
 	for _, ef := range before {
 		if err := ef.delete(); err != nil {
-			glog.Errorf("while deleting file: %s, err: %v\n", ef.Fd.Name(), err)
+			glog.Errorf("while deleting file: %s, err: %v\n", fileName(ef.MmapFile), err)
 		}
 	}
 	return
@@ -346,7 +348,7 @@ func (l *wal) deleteBefore(raftIndex uint64) {
 func (l *wal) reset() error {
 	for _, ef := range l.files {
 		if err := ef.delete(); err != nil {
-			return errors.Wrapf(err, "while deleting %s", ef.Fd.Name())
+			return errors.Wrapf(err, "while deleting %s", fileName(ef.MmapFile))
 		}
 	}
 	l.files = l.files[:0]
@@ -367,14 +369,16 @@ func (l *wal) rotate(firstIndex uint64, offset int) error {
 	}
 	nextFid++
 
-	err := l.current.Truncate(int64(offset))
+	err := truncate(l.current.MmapFile, int64(offset))
 	if err != nil {
 		return errors.Wrapf(err, "while truncating entry file")
 	}
 
-	ef, err := openLogFile(l.dir, nextFid)
-	if err != nil {
-		return errors.Wrapf(err, "while creating a new entry file")
+	ef := newMemoryLogFile(nextFid)
+	if !l.inMemory {
+		if ef, err = openLogFile(l.dir, nextFid, false); err != nil {
+			return errors.Wrapf(err, "while creating a new entry file")
+		}
 	}
 
 	// Move the existing current file to the end of the list of files and
@@ -384,11 +388,11 @@ func (l *wal) rotate(firstIndex uint64, offset int) error {
 	return nil
 }
 
-func openWal(dir string) (*wal, error) {
+func openWal(dir string, readOnly bool) (*wal, error) {
 	e := &wal{
 		dir: dir,
 	}
-	files, err := getLogFiles(dir)
+	files, err := getLogFiles(dir, readOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -399,6 +403,13 @@ func openWal(dir string) (*wal, error) {
 			nextFid = ef.fid
 		}
 		if ef.firstIndex() == 0 {
+			if readOnly {
+				// Empty files are left for the next time the WAL is opened to write to it.
+				if err := ef.MmapFile.Close(-1); err != nil {
+					return nil, err
+				}
+				continue
+			}
 			if err := ef.delete(); err != nil {
 				return nil, err
 			}
@@ -415,9 +426,12 @@ func openWal(dir string) (*wal, error) {
 		return e, nil
 	}
 
+	if readOnly {
+		return nil, errors.Errorf("no entry files found in %s", dir)
+	}
 	// No files found. Create a new file.
 	nextFid += 1
-	ef, err := openLogFile(dir, nextFid)
+	ef, err := openLogFile(dir, nextFid, false)
 	e.current = ef
 	return e, err
 }
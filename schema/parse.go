@@ -17,7 +17,9 @@
 package schema
 
 import (
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -79,6 +81,12 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
 		}
 		schema.Lang = true
+	case "ttl":
+		ttl, err := parseTTLDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = ttl
 	default:
 		return next.Errorf("Invalid index specification")
 	}
@@ -156,6 +164,14 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate,
 		next = it.Item()
 	}
 
+	// The entries of indexes, reverse edges and counts are shared by many nodes, so they can't
+	// expire with the values of one of them.
+	if schema.Ttl > 0 && (schema.Directive != pb.SchemaUpdate_NONE || schema.Count ||
+		schema.Upsert) {
+		return nil, next.Errorf("@ttl can't be used with @index, @reverse, @count or @upsert"+
+			" for predicate: %s", predicate)
+	}
+
 	if next.Typ != itemDot {
 		return nil, next.Errorf("Invalid ending")
 	}
@@ -171,6 +187,37 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate,
 	return schema, nil
 }
 
+// parseTTLDirective works on "@ttl(duration)", like @ttl(90s), @ttl(24h) or @ttl(30d), and
+// returns the duration in seconds.
+func parseTTLDirective(it *lex.ItemIterator, predicate string) (uint64, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return 0, it.Item().Errorf("Require a duration for @ttl of pred: %s", predicate)
+	}
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemText {
+		return 0, next.Errorf("Require a duration for @ttl of pred: %s", predicate)
+	}
+	var ttl time.Duration
+	var err error
+	if days := strings.TrimSuffix(next.Val, "d"); days != next.Val {
+		var n int
+		n, err = strconv.Atoi(days)
+		ttl = time.Duration(n) * 24 * time.Hour
+	} else {
+		ttl, err = time.ParseDuration(next.Val)
+	}
+	if err != nil || ttl < time.Second {
+		return 0, next.Errorf("Invalid duration %s for @ttl of pred: %s. It must be at least 1s,"+
+			" like 90s, 24h or 30d", next.Val, predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return 0, it.Item().Errorf("Expected ) after the duration for @ttl of pred: %s",
+			predicate)
+	}
+	return uint64(ttl / time.Second), nil
+}
+
 // parseIndexDirective works on "@index" or "@index(customtokenizer)".
 func parseIndexDirective(it *lex.ItemIterator, predicate string,
 	typ types.TypeID) ([]string, error) {
@@ -368,6 +368,57 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		session: string @ttl(24h) .
+		visit: [uid] @ttl(30d) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: "session",
+		ValueType: 9,
+		Ttl:       24 * 3600,
+	}, result.Preds[0])
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: "visit",
+		ValueType: 7,
+		List:      true,
+		Ttl:       30 * 24 * 3600,
+	}, result.Preds[1])
+}
+
+func TestParseTTLErrors(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{"session: string @ttl .", "Require a duration for @ttl of pred: session"},
+		{"session: string @ttl(10ms) .", "Invalid duration 10ms for @ttl of pred: session"},
+		{"session: string @ttl(forever) .", "Invalid duration forever for @ttl of pred: session"},
+		{"session: string @ttl(24h) @index(exact) .",
+			"@ttl can't be used with @index, @reverse, @count or @upsert for predicate: session"},
+		{"visit: [uid] @ttl(1h) @count .",
+			"@ttl can't be used with @index, @reverse, @count or @upsert for predicate: visit"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, err := Parse(tc.schema)
+		require.Error(t, err, tc.schema)
+		require.Contains(t, err.Error(), tc.err, tc.schema)
+	}
+}
+
+func TestParseNamesStartingWithDigit(t *testing.T) {
+	for _, schema := range []string{"3: int .", "1abc: string .", "name: string @3d ."} {
+		reset()
+		_, err := Parse(schema)
+		require.Error(t, err, schema)
+		require.Contains(t, err.Error(), "Unexpected", schema)
+	}
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
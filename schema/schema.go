@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -410,6 +411,14 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// TTL returns how long the values of the predicate live after they were last written, or 0 if
+// they don't expire.
+func (s *state) TTL(pred string) time.Duration {
+	s.RLock()
+	defer s.RUnlock()
+	return time.Duration(s.predicate[pred].GetTtl()) * time.Second
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
@@ -72,6 +72,7 @@ Loop:
 			l.Emit(itemColon)
 		case r == '@':
 			l.Emit(itemAt)
+			return lexDirective
 		case r == '[':
 			l.Emit(itemLeftSquare)
 		case r == ']':
@@ -106,6 +107,37 @@ func lexWord(l *lex.Lexer) lex.StateFn {
 	return lexText
 }
 
+// lexDirective lexes the name of a directive after the @. The duration of @ttl(24h) starts with
+// a digit, which names can't, so it's lexed as its argument by lexTTLArgument.
+func lexDirective(l *lex.Lexer) lex.StateFn {
+	l.IgnoreRun(isSpace)
+	if !isNameBegin(l.Peek()) {
+		return lexText
+	}
+	l.AcceptRun(isNameSuffix)
+	isTTL := l.Input[l.Start:l.Pos] == "ttl"
+	l.Emit(itemText)
+	if isTTL {
+		return lexTTLArgument
+	}
+	return lexText
+}
+
+// lexTTLArgument lexes the (duration) that follows @ttl, if there's one.
+func lexTTLArgument(l *lex.Lexer) lex.StateFn {
+	l.IgnoreRun(isSpace)
+	if l.Next() != '(' {
+		l.Backup()
+		return lexText
+	}
+	l.Emit(itemLeftRound)
+	l.IgnoreRun(isSpace)
+	if _, ok := l.AcceptRun(isNameSuffix); ok {
+		l.Emit(itemText)
+	}
+	return lexText
+}
+
 // lexTextComment lexes a comment text inside a schema.
 func lexTextComment(l *lex.Lexer) lex.StateFn {
 	for {
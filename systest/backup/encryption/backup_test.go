@@ -260,6 +260,7 @@ func runBackupInternal(t *testing.T, forceFull bool, numExpectedFiles,
 				code
 				message
 			}
+			taskId
 		}
 	}`
 
@@ -278,7 +279,10 @@ func runBackupInternal(t *testing.T, forceFull bool, numExpectedFiles,
 	require.NoError(t, err)
 	buf, err := ioutil.ReadAll(resp.Body)
 	require.NoError(t, err)
-	require.Contains(t, string(buf), "Backup completed.")
+	var result testutil.GraphQLResponse
+	require.NoError(t, json.Unmarshal(buf, &result))
+	result.RequireNoGraphQLErrors(t)
+	testutil.WaitForTask(t, testutil.TaskId(t, &result, "backup"), testutil.GetAlphaClientConfig(t), "")
 
 	// Verify that the right amount of files and directories were created.
 	copyToLocalFs(t)
@@ -374,6 +374,7 @@ func runBackupInternal(t *testing.T, forceFull bool, numExpectedFiles,
 					code
 					message
 				}
+				taskId
 			}
 		}`
 
@@ -394,7 +395,10 @@ func runBackupInternal(t *testing.T, forceFull bool, numExpectedFiles,
 	defer resp.Body.Close()
 	buf, err := ioutil.ReadAll(resp.Body)
 	require.NoError(t, err)
-	require.Contains(t, string(buf), "Backup completed.")
+	var result testutil.GraphQLResponse
+	require.NoError(t, json.Unmarshal(buf, &result))
+	result.RequireNoGraphQLErrors(t)
+	testutil.WaitForTask(t, testutil.TaskId(t, &result, "backup"), testutil.GetAlphaClientConfig(t), "")
 
 	// Verify that the right amount of files and directories were created.
 	copyToLocalFs(t)
@@ -52,12 +52,9 @@ func TestExportSchemaToMinio(t *testing.T) {
 	result := requestExport(t)
 
 	require.Equal(t, "Success", getFromJSON(result, "data", "export", "response", "code").(string))
-	require.Equal(t, "Export completed.", getFromJSON(result, "data", "export", "response", "message").(string))
+	taskId := getFromJSON(result, "data", "export", "taskId").(string)
 
-	var files []string
-	for _, f := range getFromJSON(result, "data", "export", "exportedFiles").([]interface{}) {
-		files = append(files, f.(string))
-	}
+	files := testutil.WaitForTask(t, taskId, nil, "")
 	require.Equal(t, 3, len(files))
 
 	schemaFile := files[1]
@@ -139,7 +136,7 @@ func requestExport(t *testing.T) map[string]interface{} {
 				code
 				message
 			}
-			exportedFiles
+			taskId
 		}
 	}`
 
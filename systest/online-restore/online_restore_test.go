@@ -49,6 +49,7 @@ func sendRestoreRequest(t *testing.T, location, backupId string, backupNum int)
 				encryptionKeyFile: "/data/keys/enc_key"}) {
 				code
 				message
+				taskId
 			}
 		}`,
 		Variables: map[string]interface{}{
@@ -62,13 +63,14 @@ func sendRestoreRequest(t *testing.T, location, backupId string, backupNum int)
 
 	var restoreResp struct {
 		Restore struct {
-			Code      string
-			Message   string
-			RestoreId int
+			Code    string
+			Message string
+			TaskId  string
 		}
 	}
 	require.NoError(t, json.Unmarshal(resp.Data, &restoreResp))
 	require.Equal(t, restoreResp.Restore.Code, "Success")
+	require.NotEmpty(t, restoreResp.Restore.TaskId)
 	return
 }
 
@@ -555,11 +557,14 @@ func backup(t *testing.T, backupDir string) {
 			  code
 			  message
 			}
+			taskId
 		  }
 		}`,
 		Variables: map[string]interface{}{"backupDir": backupDir},
 	}
-	testutil.MakeGQLRequestWithTLS(t, backupParams, testutil.GetAlphaClientConfig(t)).RequireNoGraphQLErrors(t)
+	resp := testutil.MakeGQLRequestWithTLS(t, backupParams, testutil.GetAlphaClientConfig(t))
+	resp.RequireNoGraphQLErrors(t)
+	testutil.WaitForTask(t, testutil.TaskId(t, resp, "backup"), testutil.GetAlphaClientConfig(t), "")
 }
 
 func backupRestoreAndVerify(t *testing.T, dg *dgo.Dgraph, backupDir, queryToVerify,
@@ -45,6 +45,7 @@ const ExportRequest = `mutation {
 			code
 			message
 		}
+		taskId
 	}
 }`
 
@@ -131,6 +132,55 @@ func MakeGQLRequestWithAccessJwtAndTLS(t *testing.T, params *GraphQLParams, tls
 	return &gqlResp
 }
 
+const taskQuery = `query task($id: String!) {
+	task(input: {id: $id}) {
+		status
+		message
+		exportedFiles
+	}
+}`
+
+// WaitForTask polls the admin task with the given id until it finishes, and returns the files
+// it exported. It fails the test if the task fails, or doesn't finish within a few minutes.
+func WaitForTask(t *testing.T, taskId string, tls *tls.Config, accessToken string) []string {
+	params := &GraphQLParams{
+		Query:     taskQuery,
+		Variables: map[string]interface{}{"id": taskId},
+	}
+	for i := 0; i < 600; i++ {
+		resp := MakeGQLRequestWithAccessJwtAndTLS(t, params, tls, accessToken)
+		resp.RequireNoGraphQLErrors(t)
+
+		var data struct {
+			Task struct {
+				Status        string
+				Message       string
+				ExportedFiles []string
+			}
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		switch data.Task.Status {
+		case "Success":
+			return data.Task.ExportedFiles
+		case "Failed":
+			require.Fail(t, "task failed", "task %s failed: %s", taskId, data.Task.Message)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	require.Fail(t, "task didn't finish", "task %s didn't finish in time", taskId)
+	return nil
+}
+
+// TaskId returns the id of the task started by the given admin mutation in resp.
+func TaskId(t *testing.T, resp *GraphQLResponse, mutation string) string {
+	var data map[string]struct {
+		TaskId string
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.NotEmpty(t, data[mutation].TaskId)
+	return data[mutation].TaskId
+}
+
 type clientCustomClaims struct {
 	Namespace     string
 	AuthVariables map[string]interface{}
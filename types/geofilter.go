@@ -54,7 +54,7 @@ type GeoQueryData struct {
 // IsGeoFunc returns if a function is of geo type.
 func IsGeoFunc(str string) bool {
 	switch str {
-	case "near", "contains", "within", "intersects":
+	case "near", "nearest", "contains", "within", "intersects":
 		return true
 	}
 
@@ -119,6 +119,32 @@ func GetGeoTokens(srcFunc *pb.SrcFunction) ([]string, *GeoQueryData, error) {
 	}
 }
 
+// ParsePoint parses a point given like the point of near(), as [longitude, latitude] or as
+// GeoJSON.
+func ParsePoint(str string) (*geom.Point, error) {
+	g, err := convertToGeom(str)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return nil, errors.Errorf("Expected a point, but got a geometry of type %T", g)
+	}
+	return p, nil
+}
+
+// NearTokens returns the index tokens to look up to find the geometries within maxDistance
+// metres of p.
+func NearTokens(p *geom.Point, maxDistance float64) ([]string, error) {
+	toks, _, err := queryTokensGeo(QueryTypeNear, p, maxDistance)
+	return toks, err
+}
+
+// PointDistance returns the distance in metres between the points a and b.
+func PointDistance(a, b *geom.Point) float64 {
+	return float64(EarthDistance(pointFromPoint(a).Distance(pointFromPoint(b))))
+}
+
 // queryTokensGeo returns the tokens to be used to look up the geo index for a given filter.
 // qt is the type of Geo query - near/intersects/contains/within
 // g is the geom.T representation of the input. It could be a point/polygon/multipolygon.
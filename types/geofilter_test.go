@@ -158,6 +158,27 @@ func TestQueryTokensNearError(t *testing.T) {
 	require.Error(t, err) // no max distance
 }
 
+func TestParsePoint(t *testing.T) {
+	p, err := ParsePoint("[-122.082506, 37.4249518]")
+	require.NoError(t, err)
+	require.Equal(t, []float64{-122.082506, 37.4249518}, p.FlatCoords())
+
+	p, err = ParsePoint(`{"type": "Point", "coordinates": [1.1, 2.0]}`)
+	require.NoError(t, err)
+	require.Equal(t, []float64{1.1, 2.0}, p.FlatCoords())
+
+	_, err = ParsePoint("[[[0, 0], [1, 0], [1, 1], [0, 0]]]")
+	require.EqualError(t, err, "Expected a point, but got a geometry of type *geom.Polygon")
+}
+
+func TestPointDistance(t *testing.T) {
+	googleplex := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
+	shoreline := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.080668, 37.426753})
+	require.Equal(t, 0.0, PointDistance(googleplex, googleplex))
+	require.InDelta(t, 257.8, PointDistance(googleplex, shoreline), 0.1)
+	require.Equal(t, PointDistance(googleplex, shoreline), PointDistance(shoreline, googleplex))
+}
+
 /*
 func TestMatchesFilterWithinPoint(t *testing.T) {
 	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
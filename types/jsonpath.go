@@ -0,0 +1,136 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// JSONPath is a path to a value inside a JSON document, like $.author.names[0]. Each step is
+// either the key of an object, or the index of an array.
+type JSONPath []jsonPathStep
+
+type jsonPathStep struct {
+	key   string
+	index int
+	isKey bool
+}
+
+// ParseJSONPath parses a path that starts at the document, $, and goes down into it with
+// .key, ["key"] and [index] steps.
+func ParseJSONPath(path string) (JSONPath, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.Errorf("Invalid JSON path %q: it must start with $", path)
+	}
+	var res JSONPath
+	rest := path[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			if end == 0 {
+				return nil, errors.Errorf("Invalid JSON path %q: a . must be followed by a key",
+					path)
+			}
+			res = append(res, jsonPathStep{key: rest[1 : end+1], isKey: true})
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, errors.Errorf("Invalid JSON path %q: missing ]", path)
+			}
+			inner := rest[1:end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') &&
+				inner[len(inner)-1] == inner[0] {
+				res = append(res, jsonPathStep{key: inner[1 : len(inner)-1], isKey: true})
+			} else if i, err := strconv.Atoi(inner); err == nil && i >= 0 {
+				res = append(res, jsonPathStep{index: i})
+			} else {
+				return nil, errors.Errorf("Invalid JSON path %q: [%s] must hold a quoted key "+
+					"or an index", path, inner)
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, errors.Errorf("Invalid JSON path %q: unexpected %q", path, rest[0])
+		}
+	}
+	return res, nil
+}
+
+// Lookup returns the value at the path in the JSON document doc, and whether there's one.
+// Strings, numbers and booleans are returned as values of their type, and objects and arrays as
+// strings holding their JSON. A document that isn't valid JSON has no value at any path, and
+// neither does null.
+func (p JSONPath) Lookup(doc string) (Val, bool) {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+	var cur interface{}
+	if err := dec.Decode(&cur); err != nil {
+		return Val{}, false
+	}
+
+	for _, step := range p {
+		switch v := cur.(type) {
+		case map[string]interface{}:
+			if !step.isKey {
+				return Val{}, false
+			}
+			cur = v[step.key]
+		case []interface{}:
+			if step.isKey || step.index >= len(v) {
+				return Val{}, false
+			}
+			cur = v[step.index]
+		default:
+			return Val{}, false
+		}
+	}
+
+	switch v := cur.(type) {
+	case string:
+		return Val{Tid: StringID, Value: v}, true
+	case bool:
+		return Val{Tid: BoolID, Value: v}, true
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return Val{Tid: IntID, Value: i}, true
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return Val{}, false
+		}
+		return Val{Tid: FloatID, Value: f}, true
+	case nil:
+		return Val{}, false
+	default:
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return Val{}, false
+		}
+		return Val{Tid: StringID, Value: strings.TrimSuffix(buf.String(), "\n")}, true
+	}
+}
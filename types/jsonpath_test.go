@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPathLookup(t *testing.T) {
+	doc := `{"author": {"name": "Alice", "age": 32, "score": 4.5, "active": true,
+		"tags": ["a", "b"], "address": {"city": "Oslo"}, "note": null},
+		"a.b": "dotted"}`
+	tests := []struct {
+		path  string
+		val   Val
+		found bool
+	}{
+		{path: "$.author.name", val: Val{Tid: StringID, Value: "Alice"}, found: true},
+		{path: "$.author.age", val: Val{Tid: IntID, Value: int64(32)}, found: true},
+		{path: "$.author.score", val: Val{Tid: FloatID, Value: 4.5}, found: true},
+		{path: "$.author.active", val: Val{Tid: BoolID, Value: true}, found: true},
+		{path: "$.author.tags[1]", val: Val{Tid: StringID, Value: "b"}, found: true},
+		{path: `$["a.b"]`, val: Val{Tid: StringID, Value: "dotted"}, found: true},
+		{path: "$.author['address'].city", val: Val{Tid: StringID, Value: "Oslo"}, found: true},
+		{path: "$.author.address", val: Val{Tid: StringID, Value: `{"city":"Oslo"}`},
+			found: true},
+		{path: "$.author.tags[2]"},
+		{path: "$.author.note"},
+		{path: "$.author.name.first"},
+		{path: "$.editor"},
+	}
+	for _, tc := range tests {
+		p, err := ParseJSONPath(tc.path)
+		require.NoError(t, err, tc.path)
+		val, found := p.Lookup(doc)
+		require.Equal(t, tc.found, found, tc.path)
+		require.Equal(t, tc.val, val, tc.path)
+	}
+
+	p, err := ParseJSONPath("$")
+	require.NoError(t, err)
+	_, found := p.Lookup("not json")
+	require.False(t, found)
+}
+
+func TestParseJSONPathError(t *testing.T) {
+	for _, path := range []string{"author.name", "$.", "$..name", "$[x]", "$[0", "$name"} {
+		_, err := ParseJSONPath(path)
+		require.Error(t, err, path)
+	}
+}
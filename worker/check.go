@@ -0,0 +1,58 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/schema"
+)
+
+// CheckConsistency checks that the index and reverse keys of the predicates served by the group
+// of this alpha match their data, as of the latest transaction. If pred isn't empty, only that
+// predicate is checked. It returns a message for each problem it finds.
+func CheckConsistency(ctx context.Context, pred string) ([]string, error) {
+	readTs := posting.Oracle().MaxAssigned()
+	preds := schema.State().Predicates()
+	if pred != "" {
+		preds = []string{pred}
+	}
+	sort.Strings(preds)
+
+	var problems []string
+	for _, pred := range preds {
+		gid, err := groups().BelongsToReadOnly(pred, readTs)
+		if err != nil {
+			return nil, err
+		}
+		if gid != groups().groupId() {
+			continue
+		}
+		found, err := posting.CheckPredicate(ctx, pred, readTs)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, found...)
+	}
+	glog.Infof("Checked the consistency of %d predicates at %d, found %d problems",
+		len(preds), readTs, len(problems))
+	return problems, nil
+}
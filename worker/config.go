@@ -44,6 +44,12 @@ type Options struct {
 	// higher value means more CPU intensive compression and better compression
 	// ratio.
 	PostingDirCompressionLevel int
+	// PostingDirBlockSize is the size in bytes of the blocks in the tables of the Postings
+	// directory.
+	PostingDirBlockSize int
+	// PostingDirBloomFalsePositive is the false positive rate of the bloom filters of the tables
+	// in the Postings directory. 0 disables them.
+	PostingDirBloomFalsePositive float64
 	// WALDir is the path to the directory storing the write-ahead log.
 	WALDir string
 	// MutationsMode is the mode used to handle mutation requests.
@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -75,6 +76,14 @@ type node struct {
 	elog        trace.EventLog
 
 	ex *executor
+
+	// batchCh receives the proposals to batch into one Raft entry. It's nil if batching is
+	// disabled.
+	batchCh chan *batchedProposal
+
+	// snapResume is what's been kept of a snapshot whose stream was interrupted. It's only used
+	// by populateSnapshot, which runs as the opSnapshot task, one at a time.
+	snapResume *snapshotResume
 }
 
 type op int
@@ -250,6 +259,8 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 	}
 	if x.WorkerConfig.LudicrousMode {
 		n.ex = newExecutor(&m.Applied, x.WorkerConfig.LudicrousConcurrency)
+	} else if x.WorkerConfig.ProposalBatchSize > 0 {
+		n.batchCh = make(chan *batchedProposal, x.WorkerConfig.NumPendingProposals)
 	}
 	return n
 }
@@ -587,7 +598,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 
 	case proposal.Delta != nil:
 		n.elog.Printf("Applying Oracle Delta for key: %d", key)
-		return n.commitOrAbort(key, proposal.Delta)
+		return n.commitOrAbort(key, proposal.Delta, proposal.ProposedAt)
 
 	case proposal.Snapshot != nil:
 		existing, err := n.Store.Snapshot()
@@ -615,8 +626,9 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, but for the snapshots
+		// held by Zero.
+		pstore.SetDiscardTs(groups().discardTs(snap.ReadTs))
 		return nil
 
 	case proposal.Restore != nil:
@@ -642,7 +654,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			Txns: []*pb.TxnStatus{
 				{StartTs: ts, CommitTs: ts},
 			},
-		})
+		}, proposal.ProposedAt)
 	}
 	x.Fatalf("Unknown proposal: %+v", proposal)
 	return nil
@@ -673,62 +685,76 @@ func (n *node) processApplyCh() {
 	}
 	previous := make(map[uint64]*P)
 
+	// apply applies a proposal with the given key and size, which is part of the entry at index.
+	// This function must be run serially.
+	apply := func(proposal *pb.Proposal, key uint64, psz int, index uint64) {
+		proposal.Index = index
+
+		// Ignore the start ts in case of ludicrous mode. We get a new ts and use that as the
+		// commit ts.
+		if x.WorkerConfig.LudicrousMode && proposal.Mutations != nil {
+			proposal.Mutations.StartTs = State.GetTimestamp(false)
+		}
+
+		var perr error
+		p, ok := previous[key]
+		if ok && p.err == nil && p.size == psz {
+			n.elog.Printf("Proposal with key: %s already applied. Skipping index: %d.\n",
+				key, proposal.Index)
+			previous[key].seen = time.Now() // Update the ts.
+			// Don't break here. We still need to call the Done below.
+
+		} else {
+			start := time.Now()
+			perr = n.applyCommitted(proposal, key)
+			if key != 0 {
+				p := &P{err: perr, size: psz, seen: time.Now()}
+				previous[key] = p
+			}
+			if perr != nil {
+				glog.Errorf("Applying proposal. Error: %v. Proposal: %q.", perr, proposal)
+			}
+			n.elog.Printf("Applied proposal with key: %d, index: %d. Err: %v",
+				key, proposal.Index, perr)
+
+			var tags []tag.Mutator
+			switch {
+			case proposal.Mutations != nil:
+				tags = append(tags, tag.Upsert(x.KeyMethod, "apply.Mutations"))
+			case proposal.Delta != nil:
+				tags = append(tags, tag.Upsert(x.KeyMethod, "apply.Delta"))
+			}
+			ms := x.SinceMs(start)
+			_ = ostats.RecordWithTags(context.Background(), tags, x.LatencyMs.M(ms))
+		}
+
+		n.Proposals.Done(key, perr)
+	}
+
 	// This function must be run serially.
 	handle := func(entries []raftpb.Entry) {
 		var totalSize int64
 		for _, entry := range entries {
 			x.AssertTrue(len(entry.Data) > 0)
+			totalSize += int64(entry.Size())
 
-			// We use the size as a double check to ensure that we're
-			// working with the same proposal as before.
-			psz := entry.Size()
-			totalSize += int64(psz)
-
+			// We use the size of the data as a double check to ensure that we're working with
+			// the same proposal as before. A proposal that's retried could be batched with
+			// others or not, so this doesn't depend on the entry.
 			var proposal pb.Proposal
 			key := binary.BigEndian.Uint64(entry.Data[:8])
 			x.Check(proposal.Unmarshal(entry.Data[8:]))
-			proposal.Index = entry.Index
-
-			// Ignore the start ts in case of ludicrous mode. We get a new ts and use that as the
-			// commit ts.
-			if x.WorkerConfig.LudicrousMode && proposal.Mutations != nil {
-				proposal.Mutations.StartTs = State.GetTimestamp(false)
+			if len(proposal.Batch) == 0 {
+				apply(&proposal, key, len(entry.Data), entry.Index)
 			}
-
-			var perr error
-			p, ok := previous[key]
-			if ok && p.err == nil && p.size == psz {
-				n.elog.Printf("Proposal with key: %s already applied. Skipping index: %d.\n",
-					key, proposal.Index)
-				previous[key].seen = time.Now() // Update the ts.
-				// Don't break here. We still need to call the Done below.
-
-			} else {
-				start := time.Now()
-				perr = n.applyCommitted(&proposal, key)
-				if key != 0 {
-					p := &P{err: perr, size: psz, seen: time.Now()}
-					previous[key] = p
-				}
-				if perr != nil {
-					glog.Errorf("Applying proposal. Error: %v. Proposal: %q.", perr, proposal)
-				}
-				n.elog.Printf("Applied proposal with key: %d, index: %d. Err: %v",
-					key, proposal.Index, perr)
-
-				var tags []tag.Mutator
-				switch {
-				case proposal.Mutations != nil:
-					tags = append(tags, tag.Upsert(x.KeyMethod, "apply.Mutations"))
-				case proposal.Delta != nil:
-					tags = append(tags, tag.Upsert(x.KeyMethod, "apply.Delta"))
-				}
-				ms := x.SinceMs(start)
-				_ = ostats.RecordWithTags(context.Background(), tags, x.LatencyMs.M(ms))
+			// The proposals of a batch are applied in the order they were proposed, each with
+			// its own key.
+			for _, data := range proposal.Batch {
+				var p pb.Proposal
+				x.Check(p.Unmarshal(data[8:]))
+				apply(&p, binary.BigEndian.Uint64(data[:8]), len(data), entry.Index)
 			}
-
-			n.Proposals.Done(key, perr)
-			n.Applied.Done(proposal.Index)
+			n.Applied.Done(entry.Index)
 			ostats.Record(context.Background(), x.RaftAppliedIndex.M(int64(n.Applied.DoneUntil())))
 		}
 		if sz := atomic.AddInt64(&n.pendingSize, -totalSize); sz < 0 {
@@ -761,7 +787,7 @@ func (n *node) processApplyCh() {
 }
 
 // TODO(Anurag - 4 May 2020): Are we using pkey? Remove if unused.
-func (n *node) commitOrAbort(pkey uint64, delta *pb.OracleDelta) error {
+func (n *node) commitOrAbort(pkey uint64, delta *pb.OracleDelta, committedAt int64) error {
 	// First let's commit all mutations to disk.
 	writer := posting.NewTxnWriter(pstore)
 	toDisk := func(start, commit uint64) {
@@ -771,7 +797,7 @@ func (n *node) commitOrAbort(pkey uint64, delta *pb.OracleDelta) error {
 		}
 		txn.Update()
 		err := x.RetryUntilSuccess(x.WorkerConfig.MaxRetries, 10*time.Millisecond, func() error {
-			return txn.CommitToDisk(writer, commit)
+			return txn.CommitToDisk(writer, commit, committedAt)
 		})
 
 		if err != nil {
@@ -1042,6 +1068,9 @@ func (n *node) drainApplyChan() {
 			for _, entry := range entries {
 				key := binary.BigEndian.Uint64(entry.Data[:8])
 				n.Proposals.Done(key, nil)
+				for _, key := range batchedKeys(entry.Data) {
+					n.Proposals.Done(key, nil)
+				}
 				n.Applied.Done(entry.Index)
 			}
 		default:
@@ -1051,8 +1080,6 @@ func (n *node) drainApplyChan() {
 	}
 }
 
-const tickDur = 100 * time.Millisecond
-
 func (n *node) Run() {
 	defer n.closer.Done() // CLOSER:1
 
@@ -1063,14 +1090,28 @@ func (n *node) Run() {
 	// "tick missed to fire" logs. Etcd uses 100ms and they haven't seen those issues.
 	// Additionally, using 100ms for ticks does not cause proposals to slow down, because they get
 	// sent out asap and don't rely on ticks. So, setting this to 100ms instead of 20ms is a NOOP.
+	// The default of --raft_tick is 100ms.
+	tickDur := x.WorkerConfig.Raft.TickInterval
 	ticker := time.NewTicker(tickDur)
 	defer ticker.Stop()
 
 	done := make(chan struct{})
 	go n.checkpointAndClose(done)
 	go n.ReportRaftComms()
-
-	if !x.WorkerConfig.HardSync {
+	safetyCloser := z.NewCloser(1)
+	defer safetyCloser.SignalAndWait()
+	go n.MonitorSafety(safetyCloser)
+
+	// The read index loop serves the quorum reads. It's only stopped after the loop below has
+	// finished, so that sending to readStateCh can't deadlock.
+	readStateCh := make(chan raft.ReadState, 100)
+	readIndexCloser := z.NewCloser(1)
+	defer readIndexCloser.SignalAndWait()
+	go n.RunReadIndexLoop(readIndexCloser, readStateCh)
+
+	if x.WorkerConfig.SyncInterval > 0 {
+		// Both the write-ahead log and the postings are synced every --sync_interval. With
+		// HardSync, the postings are synced after every commit instead, in commitOrAbort.
 		closer := z.NewCloser(2)
 		defer closer.SignalAndWait()
 		go x.StoreSync(n.Store, closer)
@@ -1107,6 +1148,9 @@ func (n *node) Run() {
 			_, span := otrace.StartSpan(n.ctx, "Alpha.RunLoop",
 				otrace.WithSampler(otrace.ProbabilitySampler(0.001)))
 
+			for _, rs := range rd.ReadStates {
+				readStateCh <- rs
+			}
 			if rd.SoftState != nil {
 				groups().triggerMembershipSync()
 				leader = rd.RaftState == raft.StateLeader
@@ -1231,6 +1275,11 @@ func (n *node) Run() {
 					n.elog.Printf("Skipping over already applied entry: %d", entry.Index)
 					n.Applied.Done(entry.Index)
 				default:
+					for _, key := range batchedKeys(entry.Data) {
+						if pctx := n.Proposals.Get(key); pctx != nil {
+							atomic.AddUint32(&pctx.Found, 1)
+						}
+					}
 					key := binary.BigEndian.Uint64(entry.Data[:8])
 					if pctx := n.Proposals.Get(key); pctx != nil {
 						atomic.AddUint32(&pctx.Found, 1)
@@ -1323,8 +1372,12 @@ func listWrap(kv *bpb.KV) *bpb.KVList {
 	return &bpb.KVList{Kv: []*bpb.KV{kv}}
 }
 
-// calculateTabletSizes updates the tablet sizes for the keys.
+// calculateTabletSizes updates the tablet sizes for the keys, along with their key counts, split
+// counts and rates of reads and writes.
 func (n *node) calculateTabletSizes() {
+	// The rates are taken on every node, so that the ones of a new leader only count since the
+	// last time they were sent.
+	reads, writes := rates.take()
 	if !n.AmLeader() {
 		// Only leader sends the tablet size updates to Zero. No one else does.
 		return
@@ -1341,12 +1394,14 @@ func (n *node) calculateTabletSizes() {
 		if tablet, ok := tablets[pred]; ok {
 			tablet.OnDiskBytes += int64(tinfo.OnDiskSize)
 			tablet.UncompressedBytes += int64(tinfo.UncompressedSize)
+			tablet.KeyCount += int64(tinfo.KeyCount)
 		} else {
 			tablets[pred] = &pb.Tablet{
 				GroupId:           n.gid,
 				Predicate:         pred,
 				OnDiskBytes:       int64(tinfo.OnDiskSize),
 				UncompressedBytes: int64(tinfo.UncompressedSize),
+				KeyCount:          int64(tinfo.KeyCount),
 			}
 		}
 		total += int64(tinfo.OnDiskSize)
@@ -1378,6 +1433,10 @@ func (n *node) calculateTabletSizes() {
 		glog.V(2).Infof("No tablets found.")
 		return
 	}
+	for pred, tablet := range tablets {
+		tablet.SplitCount = countSplits(pred)
+		tablet.ReadRate, tablet.WriteRate = reads[pred], writes[pred]
+	}
 	// Update Zero with the tablet sizes. If Zero sees a tablet which does not belong to
 	// this group, it would send instruction to delete that tablet. There's an edge case
 	// here if the followers are still running Rollup, and happen to read a key before and
@@ -1394,6 +1453,26 @@ func (n *node) calculateTabletSizes() {
 	}
 }
 
+// countSplits returns the number of parts that the split posting lists of pred have.
+func countSplits(pred string) int64 {
+	prefix := x.PredicatePrefix(pred)
+	prefix[0] = x.ByteSplit
+
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iopt := badger.DefaultIteratorOptions
+	iopt.PrefetchValues = false
+	iopt.Prefix = prefix
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	var count int64
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		count++
+	}
+	return count
+}
+
 var errNoConnection = errors.New("No connection exists")
 
 func (n *node) blockingAbort(req *pb.TxnTimestamps) error {
@@ -1730,6 +1809,10 @@ func (n *node) InitAndStartNode() {
 	go n.processApplyCh()
 	go n.BatchAndSendMessages()
 	go n.monitorRaftMetrics()
+	if n.batchCh != nil {
+		n.closer.AddRunning(1)
+		go n.batchProposals()
+	}
 	// Ignoring the error since InitAndStartNode does not return an error and using x.Check would
 	// not be the right thing to do.
 	_, _ = n.startTask(opRollup)
@@ -38,10 +38,11 @@ import (
 )
 
 type subMutation struct {
-	edges   []*pb.DirectedEdge
-	ctx     context.Context
-	startTs uint64
-	index   uint64
+	edges      []*pb.DirectedEdge
+	ctx        context.Context
+	startTs    uint64
+	index      uint64
+	proposedAt int64
 }
 
 type executor struct {
@@ -166,7 +167,7 @@ func (e *executor) worker(mut *mutation) {
 	}
 
 	ptxn.Update()
-	if err := ptxn.CommitToDisk(writer, payload.startTs); err != nil {
+	if err := ptxn.CommitToDisk(writer, payload.startTs, payload.proposedAt); err != nil {
 		glog.Errorf("Error while commiting to disk: %v", err)
 	}
 
@@ -295,9 +296,10 @@ func (e *executor) addEdges(ctx context.Context, proposal *pb.Proposal) {
 		payload, ok := payloadMap[edge.Attr]
 		if !ok {
 			payloadMap[edge.Attr] = &subMutation{
-				ctx:     ctx,
-				startTs: startTs,
-				index:   index,
+				ctx:        ctx,
+				startTs:    startTs,
+				index:      index,
+				proposedAt: proposal.ProposedAt,
 			}
 			payload = payloadMap[edge.Attr]
 		}
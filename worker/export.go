@@ -312,6 +312,9 @@ func toSchema(attr string, update *pb.SchemaUpdate) (*bpb.KVList, error) {
 	if update.GetUpsert() {
 		x.Check2(buf.WriteString(" @upsert"))
 	}
+	if update.GetTtl() > 0 {
+		x.Check2(buf.WriteString(fmt.Sprintf(" @ttl(%ds)", update.GetTtl())))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
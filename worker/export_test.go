@@ -381,7 +381,7 @@ func TestExportJson(t *testing.T) {
 
 const exportRequest = `mutation export($format: String!) {
 	export(input: {format: $format}) {
-		exportedFiles
+		taskId
 		response {
 			code
 		}
@@ -0,0 +1,271 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxDanglingEdges is how many dangling edges FindGarbage returns at most. If there are more, the
+// others are found by running it again once these are deleted.
+const maxDanglingEdges = 10000
+
+// Garbage is the data on this alpha that drops and deletes have left behind.
+type Garbage struct {
+	// OrphanedPredicates are the predicates that have data but aren't in the schema.
+	OrphanedPredicates []string
+	// DanglingEdges are the uid edges to nodes that have been deleted. A node counts as deleted
+	// if its dgraph.type was deleted and it has no data left, like after a <uid> * * delete.
+	// Nodes that never had a type, like leaves that only have incoming edges, are never
+	// counted. They're only looked for when all the predicates are served by one group, as the
+	// data of the nodes could be in other groups otherwise.
+	DanglingEdges []*api.NQuad
+	// CheckedEdges tells whether DanglingEdges were looked for.
+	CheckedEdges bool
+	// Truncated tells whether there were more than maxDanglingEdges dangling edges.
+	Truncated bool
+}
+
+// FindGarbage looks for the data that drops and deletes have left behind on this alpha.
+func FindGarbage(ctx context.Context) (*Garbage, error) {
+	readTs := posting.Oracle().MaxAssigned()
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	preds, err := storedPredicates(txn)
+	if err != nil {
+		return nil, err
+	}
+	g := &Garbage{}
+	var live, uidPreds []string
+	for _, pred := range preds {
+		if x.IsReservedPredicate(pred) {
+			live = append(live, pred)
+			continue
+		}
+		if orphaned, err := isOrphaned(ctx, txn, pred); err != nil {
+			return nil, err
+		} else if orphaned {
+			g.OrphanedPredicates = append(g.OrphanedPredicates, pred)
+			continue
+		}
+		live = append(live, pred)
+		if typ, err := schema.State().TypeOf(pred); err == nil && typ == types.UidID {
+			uidPreds = append(uidPreds, pred)
+		}
+	}
+
+	if len(KnownGroups()) != 1 {
+		glog.Infof("Not looking for dangling edges, as the predicates are served by %d groups",
+			len(KnownGroups()))
+		return g, nil
+	}
+	g.CheckedEdges = true
+
+	// deleted remembers the nodes that have been checked, up to a bound, as most objects are
+	// the objects of several edges.
+	deleted := make(map[uint64]bool)
+	isDeleted := func(uid uint64) (bool, error) {
+		if d, ok := deleted[uid]; ok {
+			return d, nil
+		}
+		d, err := nodeDeleted(txn, live, uid, readTs)
+		if err != nil {
+			return false, err
+		}
+		if len(deleted) >= maxDanglingEdges {
+			deleted = make(map[uint64]bool)
+		}
+		deleted[uid] = d
+		return d, nil
+	}
+
+	errTruncated := errors.New("too many dangling edges")
+	for _, pred := range uidPreds {
+		err := iterateDataKeys(ctx, txn, pred, readTs, func(uid uint64, pl *posting.List) error {
+			uids, err := pl.Uids(posting.ListOptions{ReadTs: readTs})
+			if err != nil {
+				return err
+			}
+			for _, obj := range uids.Uids {
+				d, err := isDeleted(obj)
+				if err != nil {
+					return err
+				}
+				if !d {
+					continue
+				}
+				if len(g.DanglingEdges) == maxDanglingEdges {
+					return errTruncated
+				}
+				g.DanglingEdges = append(g.DanglingEdges, &api.NQuad{
+					Subject:   fmt.Sprintf("%#x", uid),
+					Predicate: pred,
+					ObjectId:  fmt.Sprintf("%#x", obj),
+				})
+			}
+			return nil
+		})
+		if err == errTruncated {
+			g.Truncated = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// nodeDeleted returns whether the node uid has been deleted at readTs: whether it has a
+// dgraph.type key with nothing left in it, and no data in any of the preds.
+func nodeDeleted(txn *badger.Txn, preds []string, uid, readTs uint64) (bool, error) {
+	typeKey := x.DataKey("dgraph.type", uid)
+	if _, err := txn.Get(typeKey); err == badger.ErrKeyNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	for _, pred := range preds {
+		key := x.DataKey(pred, uid)
+		if _, err := txn.Get(key); err == badger.ErrKeyNotFound {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		pl, err := posting.GetNoStore(key, readTs)
+		if err != nil {
+			return false, err
+		}
+		empty, err := pl.IsEmpty(readTs, 0)
+		if err != nil {
+			return false, err
+		}
+		if !empty {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// storedPredicates returns the predicates that have data, index or reverse keys on this alpha.
+func storedPredicates(txn *badger.Txn) ([]string, error) {
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = []byte{x.DefaultPrefix}
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	var preds []string
+	for it.Rewind(); it.Valid(); {
+		pk, err := x.Parse(it.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, pk.Attr)
+		// The key types that follow the predicate are all lower than 0xff, so this skips to the
+		// keys of the next predicate.
+		it.Seek(append(x.PredicatePrefix(pk.Attr), 0xff))
+	}
+	return preds, nil
+}
+
+// iterateDataKeys calls fn with the subject and the list of each data key of pred that has
+// postings at readTs.
+func iterateDataKeys(ctx context.Context, txn *badger.Txn, pred string, readTs uint64,
+	fn func(uid uint64, pl *posting.List) error) error {
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.ParsedKey{Attr: pred}.DataPrefix()
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	for it.Rewind(); it.Valid(); it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := it.Item().KeyCopy(nil)
+		pk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		pl, err := posting.GetNoStore(key, readTs)
+		if err != nil {
+			return err
+		}
+		empty, err := pl.IsEmpty(readTs, 0)
+		if err != nil {
+			return err
+		}
+		if empty {
+			continue
+		}
+		if err := fn(pk.Uid, pl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isOrphaned tells whether pred isn't in the schema, as of txn.
+func isOrphaned(ctx context.Context, txn *badger.Txn, pred string) (bool, error) {
+	if _, ok := schema.State().Get(ctx, pred); ok {
+		return false, nil
+	}
+	// The schema key is written first when a predicate is moved to this group, so a predicate
+	// that's being received has one.
+	switch _, err := txn.Get(x.SchemaKey(pred)); err {
+	case badger.ErrKeyNotFound:
+		return true, nil
+	case nil:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// CleanPredicate deletes all the data of the orphaned predicate pred on the alpha of this group.
+// It's proposed to the group, so that its replicas delete it too. The predicate might have been
+// added back to the schema since it was found, so it's checked again, and nothing is deleted if
+// it's not orphaned anymore.
+func CleanPredicate(ctx context.Context, pred string) error {
+	txn := pstore.NewTransactionAt(posting.Oracle().MaxAssigned(), false)
+	defer txn.Discard()
+	if orphaned, err := isOrphaned(ctx, txn, pred); err != nil {
+		return err
+	} else if !orphaned {
+		glog.Infof("Not cleaning predicate %s, as it's in the schema again", pred)
+		return nil
+	}
+	if groups().Node == nil {
+		return errors.Errorf("the group of this alpha isn't set up yet")
+	}
+	glog.Infof("Cleaning the data of predicate %s", pred)
+	return groups().Node.proposeAndWait(ctx, &pb.Proposal{CleanPredicate: pred})
+}
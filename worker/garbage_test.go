@@ -0,0 +1,90 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestFindGarbage(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		gc_friend: [uid] .
+		gc_name: string .
+		gc_gone: string .
+	`), 1))
+	addEdge(t, &pb.DirectedEdge{Entity: 0x100, Attr: "gc_friend", ValueId: 0x101},
+		getOrCreate(x.DataKey("gc_friend", 0x100)))
+	addEdge(t, &pb.DirectedEdge{Entity: 0x100, Attr: "gc_friend", ValueId: 0x102},
+		getOrCreate(x.DataKey("gc_friend", 0x100)))
+	addEdge(t, &pb.DirectedEdge{Entity: 0x100, Attr: "gc_name", Value: []byte("a")},
+		getOrCreate(x.DataKey("gc_name", 0x100)))
+	addEdge(t, &pb.DirectedEdge{Entity: 0x101, Attr: "gc_name", Value: []byte("b")},
+		getOrCreate(x.DataKey("gc_name", 0x101)))
+	// 0x102 only has data in a predicate that's dropped from the schema below, and its type
+	// is deleted.
+	addEdge(t, &pb.DirectedEdge{Entity: 0x102, Attr: "gc_gone", Value: []byte("c")},
+		getOrCreate(x.DataKey("gc_gone", 0x102)))
+	addEdge(t, &pb.DirectedEdge{Entity: 0x102, Attr: "dgraph.type", Value: []byte("T")},
+		getOrCreate(x.DataKey("dgraph.type", 0x102)))
+	delEdge(t, &pb.DirectedEdge{Entity: 0x102, Attr: "dgraph.type", Value: []byte("T")},
+		getOrCreate(x.DataKey("dgraph.type", 0x102)))
+	// 0x103 is a leaf that never had any data, so it's not garbage.
+	addEdge(t, &pb.DirectedEdge{Entity: 0x100, Attr: "gc_friend", ValueId: 0x103},
+		getOrCreate(x.DataKey("gc_friend", 0x100)))
+	require.NoError(t, schema.ParseBytes([]byte(`
+		gc_friend: [uid] .
+		gc_name: string .
+	`), 1))
+
+	g, err := FindGarbage(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, g.OrphanedPredicates, "gc_gone")
+	require.NotContains(t, g.OrphanedPredicates, "gc_name")
+	require.False(t, g.CheckedEdges)
+
+	defer func() { gr.state = nil }()
+	gr.state = &pb.MembershipState{Groups: map[uint32]*pb.Group{1: {}}}
+	g, err = FindGarbage(context.Background())
+	require.NoError(t, err)
+	require.True(t, g.CheckedEdges)
+	var dangling []string
+	for _, edge := range g.DanglingEdges {
+		if edge.Predicate == "gc_friend" {
+			dangling = append(dangling, edge.Subject+" "+edge.ObjectId)
+		}
+	}
+	require.Equal(t, []string{"0x100 0x102"}, dangling)
+	require.False(t, g.Truncated)
+
+	// A predicate that's added back to the schema before it's purged keeps its data.
+	require.NoError(t, schema.ParseBytes([]byte(`
+		gc_friend: [uid] .
+		gc_name: string .
+		gc_gone: string .
+	`), 1))
+	require.NoError(t, CleanPredicate(context.Background(), "gc_gone"))
+	g, err = FindGarbage(context.Background())
+	require.NoError(t, err)
+	require.NotContains(t, g.OrphanedPredicates, "gc_gone")
+}
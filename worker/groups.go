@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -102,7 +103,7 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 
 	// Connect with Zero leader and figure out what group we should belong to.
 	m := &pb.Member{Id: x.WorkerConfig.RaftId, GroupId: x.WorkerConfig.ProposedGroupId,
-		Addr: x.WorkerConfig.MyAddr}
+		Addr: x.WorkerConfig.MyAddr, Zone: x.WorkerConfig.Zone}
 	if m.GroupId > 0 {
 		m.ForceGroupId = true
 	}
@@ -119,6 +120,12 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 		if err == nil || x.ShouldCrash(err) {
 			break
 		}
+		if strings.Contains(err.Error(), "ZONE_QUORUM") {
+			// Zero holds this alpha back until alphas of other zones have joined the groups
+			// that need replicas.
+			glog.Warningf("Not joining a group yet: %v", err)
+			time.Sleep(5 * time.Second)
+		}
 	}
 	x.CheckfNoTrace(err)
 	if connState.GetMember() == nil || connState.GetState() == nil {
@@ -575,6 +582,35 @@ func (g *groupi) AnyServer(gid uint32) *conn.Pool {
 	return nil
 }
 
+// peersSupport returns whether the other members of the group speak version v of the protocol,
+// or a later one, so that this alpha can send them Raft entries with the features of v.
+func (g *groupi) peersSupport(v uint32) bool {
+	for _, m := range g.members(g.groupId()) {
+		if m.Id == g.Node.Id {
+			continue
+		}
+		pl, err := conn.GetPools().Get(m.Addr)
+		if err != nil || pl.ProtocolVersion() < v {
+			return false
+		}
+	}
+	return true
+}
+
+// discardTs returns the timestamp below which the invalid versions of keys can be discarded. It's
+// readTs, unless Zero holds a snapshot at an earlier timestamp, which queries still read at.
+func (g *groupi) discardTs(readTs uint64) uint64 {
+	g.RLock()
+	defer g.RUnlock()
+	now := time.Now().Unix()
+	for ts, expiresAt := range g.state.GetSnapshotHolds() {
+		if expiresAt > now && ts < readTs {
+			readTs = ts
+		}
+	}
+	return readTs
+}
+
 func (g *groupi) MyPeer() (uint64, bool) {
 	members := g.members(g.groupId())
 	for _, m := range members {
@@ -624,6 +660,32 @@ func GroupId() uint32 {
 	return groups().groupId()
 }
 
+// SafetyCheck returns an error if this worker is in an unsafe partition, or if the clocks of the
+// nodes it talks to are skewed. See conn.Node.SafetyCheck.
+func SafetyCheck() error {
+	if n := groups().Node; n != nil {
+		return n.SafetyCheck()
+	}
+	return nil
+}
+
+// UnsafePartition returns an error if this worker is in an unsafe partition. See
+// conn.Node.UnsafePartition.
+func UnsafePartition() error {
+	if n := groups().Node; n != nil {
+		return n.UnsafePartition()
+	}
+	return nil
+}
+
+// LastAppliedIndex returns the index of the last Raft entry applied by this worker.
+func LastAppliedIndex() uint64 {
+	if n := groups().Node; n != nil {
+		return n.Applied.DoneUntil()
+	}
+	return 0
+}
+
 func (g *groupi) triggerMembershipSync() {
 	// It's ok if we miss the trigger, periodic membership sync runs every minute.
 	select {
@@ -701,6 +763,7 @@ func (g *groupi) doSendMembership(tablets map[string]*pb.Tablet) error {
 		Addr:       x.WorkerConfig.MyAddr,
 		Leader:     leader,
 		LastUpdate: uint64(time.Now().Unix()),
+		Zone:       x.WorkerConfig.Zone,
 	}
 	group := &pb.Group{
 		Members: make(map[uint64]*pb.Member),
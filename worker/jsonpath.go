@@ -0,0 +1,118 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	otrace "go.opencensus.io/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// handleJSONPathFunction keeps the uids being filtered whose values are JSON documents with a
+// value at the path given to jsonpath(). If a value is given too, the one at the path must be
+// equal to it.
+func (qs *queryState) handleJSONPathFunction(ctx context.Context, arg funcArgs) error {
+	span := otrace.FromContext(ctx)
+	stop := x.SpanTimer(span, "handleJSONPathFunction")
+	defer stop()
+
+	attr := arg.q.Attr
+	if typ := arg.srcFn.atype; typ != types.StringID && typ != types.DefaultID {
+		return errors.Errorf("Got non-string type. jsonpath is allowed only on string type.")
+	}
+
+	uids := arg.q.UidList
+	isList := schema.State().IsList(attr)
+	lang := langForFunc(arg.q.Langs)
+	span.Annotatef(nil, "Total uids: %d, list: %t lang: %v", len(uids.Uids), isList, lang)
+	arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+
+	filtered := &pb.List{}
+	for _, uid := range uids.Uids {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+
+		vals := make([]types.Val, 1)
+		switch {
+		case lang != "":
+			vals[0], err = pl.ValueForTag(arg.q.ReadTs, lang)
+
+		case isList:
+			vals, err = pl.AllUntaggedValues(arg.q.ReadTs)
+
+		default:
+			vals[0], err = pl.Value(arg.q.ReadTs)
+		}
+		if err != nil {
+			if err == posting.ErrNoValue {
+				continue
+			}
+			return err
+		}
+
+		for _, val := range vals {
+			strVal, err := types.Convert(val, types.StringID)
+			if err != nil {
+				continue
+			}
+			v, ok := arg.srcFn.jsonPath.Lookup(strVal.Value.(string))
+			if ok && (len(arg.srcFn.tokens) == 0 || jsonValueIs(v, arg.srcFn.tokens[0])) {
+				filtered.Uids = append(filtered.Uids, uid)
+				// NOTE: We only add the uid once.
+				break
+			}
+		}
+	}
+
+	for i := 0; i < len(arg.out.UidMatrix); i++ {
+		algo.IntersectWith(arg.out.UidMatrix[i], filtered, arg.out.UidMatrix[i])
+	}
+	return nil
+}
+
+// jsonValueIs tells whether the value found in a JSON document is the one given to jsonpath().
+// Numbers are compared by their value, so 3 is 3.0.
+func jsonValueIs(v types.Val, want string) bool {
+	switch v.Tid {
+	case types.IntID:
+		w, err := strconv.ParseFloat(want, 64)
+		return err == nil && float64(v.Value.(int64)) == w
+	case types.FloatID:
+		w, err := strconv.ParseFloat(want, 64)
+		return err == nil && v.Value.(float64) == w
+	case types.BoolID:
+		w, err := strconv.ParseBool(want)
+		return err == nil && v.Value.(bool) == w
+	}
+	return v.Value.(string) == want
+}
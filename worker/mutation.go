@@ -60,6 +60,7 @@ func isDeletePredicateEdge(edge *pb.DirectedEdge) bool {
 // runMutation goes through all the edges and applies them.
 func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) error {
 	ctx = schema.GetWriteContext(ctx)
+	rates.write(edge.Attr)
 
 	// We shouldn't check whether this Alpha serves this predicate or not. Membership information
 	// isn't consistent across the entire cluster. We should just apply whatever is given to us.
@@ -357,6 +358,13 @@ func hasEdges(attr string, startTs uint64) bool {
 	}
 	return false
 }
+
+// CheckSchema checks that the schema update s is valid, and that it's a change the current
+// schema of its predicate allows.
+func CheckSchema(s *pb.SchemaUpdate) error {
+	return checkSchema(s)
+}
+
 func checkSchema(s *pb.SchemaUpdate) error {
 	if s == nil {
 		return errors.Errorf("Nil schema")
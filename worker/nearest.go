@@ -0,0 +1,182 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+	otrace "go.opencensus.io/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// nearestMinRadius is the radius in metres of the first circle searched for the nearest
+	// points. It's grown four times over until it holds enough of them.
+	nearestMinRadius = 1000
+	// nearestMaxRadius is the radius in metres of the largest circle searched, a quarter of the
+	// circumference of the earth. At root, points further away than that aren't found, so fewer
+	// than k points are returned if that circle doesn't hold k of them.
+	nearestMaxRadius = 10000 * 1000
+)
+
+// The index is searched with a regular polygon of 100 vertices on the circle, as near() does,
+// so only the points within its inner radius are sure to be found.
+var nearestInnerRadius = math.Cos(math.Pi / 100)
+
+type uidDistance struct {
+	uid      uint64
+	distance float64
+}
+
+// handleNearestFunction finds the k nodes whose points are the nearest to the point given to
+// nearest(). At root, they're looked up in the geo index within a circle that grows until it
+// holds k of them. As a filter, they're picked out of the uids being filtered.
+func (qs *queryState) handleNearestFunction(ctx context.Context, arg funcArgs) error {
+	span := otrace.FromContext(ctx)
+	stop := x.SpanTimer(span, "handleNearestFunction")
+	defer stop()
+
+	if arg.srcFn.atype != types.GeoID {
+		return errors.Errorf("Got non-geo type. nearest is allowed only on geo type.")
+	}
+	point, k := arg.srcFn.point, int(arg.srcFn.threshold[0])
+
+	var nearest []uidDistance
+	if arg.q.UidList != nil {
+		var err error
+		if nearest, err = qs.pointDistances(ctx, arg, point, arg.q.UidList.Uids); err != nil {
+			return err
+		}
+	} else {
+		for radius := float64(nearestMinRadius); ; radius *= 4 {
+			if radius > nearestMaxRadius {
+				radius = nearestMaxRadius
+			}
+			candidates, err := qs.uidsNear(arg, point, radius)
+			if err != nil {
+				return err
+			}
+			if nearest, err = qs.pointDistances(ctx, arg, point, candidates.Uids); err != nil {
+				return err
+			}
+			span.Annotatef(nil, "Found %d points within %.0f metres", len(nearest), radius)
+
+			within := 0
+			for _, n := range nearest {
+				if n.distance <= radius*nearestInnerRadius {
+					within++
+				}
+			}
+			if within >= k || radius == nearestMaxRadius {
+				break
+			}
+		}
+	}
+
+	sort.Slice(nearest, func(i, j int) bool {
+		if nearest[i].distance == nearest[j].distance {
+			return nearest[i].uid < nearest[j].uid
+		}
+		return nearest[i].distance < nearest[j].distance
+	})
+	if len(nearest) > k {
+		nearest = nearest[:k]
+	}
+	result := &pb.List{Uids: make([]uint64, 0, len(nearest))}
+	for _, n := range nearest {
+		result.Uids = append(result.Uids, n.uid)
+	}
+	sort.Slice(result.Uids, func(i, j int) bool { return result.Uids[i] < result.Uids[j] })
+	arg.out.UidMatrix = append(arg.out.UidMatrix, result)
+	return nil
+}
+
+// uidsNear returns the uids that the geo index has within radius metres of point. They can
+// also have some that are a bit further away.
+func (qs *queryState) uidsNear(arg funcArgs, point *geom.Point, radius float64) (
+	*pb.List, error) {
+	toks, err := types.NearTokens(point, radius)
+	if err != nil {
+		return nil, err
+	}
+	tok.EncodeGeoTokens(toks)
+
+	uidMatrix := make([]*pb.List, 0, len(toks))
+	for _, t := range toks {
+		pl, err := qs.cache.Get(x.IndexKey(arg.q.Attr, t))
+		if err != nil {
+			return nil, err
+		}
+		uids, err := pl.Uids(posting.ListOptions{ReadTs: arg.q.ReadTs})
+		if err != nil {
+			return nil, err
+		}
+		uidMatrix = append(uidMatrix, uids)
+	}
+	return algo.MergeSorted(uidMatrix), nil
+}
+
+// pointDistances returns the distance from point to the nearest of the points that each of
+// uids has. Values that aren't points are skipped, so uids that only have those are left out.
+func (qs *queryState) pointDistances(ctx context.Context, arg funcArgs, point *geom.Point,
+	uids []uint64) ([]uidDistance, error) {
+	var res []uidDistance
+	for i, uid := range uids {
+		if i%100 == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+		pl, err := qs.cache.Get(x.DataKey(arg.q.Attr, uid))
+		if err != nil {
+			return nil, err
+		}
+		min := math.Inf(1)
+		err = pl.Iterate(arg.q.ReadTs, 0, func(p *pb.Posting) error {
+			if types.TypeID(p.ValType) != types.GeoID {
+				return nil
+			}
+			gc, err := types.Convert(types.Val{Tid: types.BinaryID, Value: p.Value}, types.GeoID)
+			if err != nil {
+				return nil
+			}
+			if pt, ok := gc.Value.(geom.T).(*geom.Point); ok {
+				min = math.Min(min, types.PointDistance(point, pt))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !math.IsInf(min, 1) {
+			res = append(res, uidDistance{uid: uid, distance: min})
+		}
+	}
+	return res, nil
+}
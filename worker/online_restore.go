@@ -26,9 +26,9 @@ import (
 	"github.com/golang/glog"
 )
 
-func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
+func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) (<-chan error, error) {
 	glog.Warningf("Restore failed: %v", x.ErrNotSupported)
-	return x.ErrNotSupported
+	return nil, x.ErrNotSupported
 }
 
 // Restore implements the Worker interface.
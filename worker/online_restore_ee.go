@@ -38,13 +38,15 @@ const (
 )
 
 // ProcessRestoreRequest verifies the backup data and sends a restore proposal to each group.
-func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
+// It returns once the backup is verified, with a channel that gets the error the restore failed
+// with, or nil, once every group has applied its proposal.
+func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) (<-chan error, error) {
 	if req == nil {
-		return errors.Errorf("restore request cannot be nil")
+		return nil, errors.Errorf("restore request cannot be nil")
 	}
 
 	if err := UpdateMembershipState(ctx); err != nil {
-		return errors.Wrapf(err, "cannot update membership state before restore")
+		return nil, errors.Wrapf(err, "cannot update membership state before restore")
 	}
 	memState := GetMembershipState()
 
@@ -60,10 +62,10 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
 		Anonymous:    req.Anonymous,
 	}
 	if err := VerifyBackup(req, &creds, currentGroups); err != nil {
-		return errors.Wrapf(err, "failed to verify backup")
+		return nil, errors.Wrapf(err, "failed to verify backup")
 	}
 	if err := FillRestoreCredentials(req.Location, req); err != nil {
-		return errors.Wrapf(err, "cannot fill restore proposal with the right credentials")
+		return nil, errors.Wrapf(err, "cannot fill restore proposal with the right credentials")
 	}
 
 	// This check if any restore operation running on the node.
@@ -83,7 +85,7 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
 		return false
 	}
 	if isRestoreRunning() {
-		return errors.Errorf("another restore operation is already running. " +
+		return nil, errors.Errorf("another restore operation is already running. " +
 			"Please retry later.")
 	}
 
@@ -101,15 +103,21 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
 		}()
 	}
 
+	done := make(chan error, 1)
 	go func() {
+		var restoreErr error
 		for range currentGroups {
 			if err := <-errCh; err != nil {
 				glog.Errorf("Error while restoring %v", err)
+				if restoreErr == nil {
+					restoreErr = err
+				}
 			}
 		}
+		done <- restoreErr
 	}()
 
-	return nil
+	return done, nil
 }
 
 func proposeRestoreOrSend(ctx context.Context, req *pb.RestoreRequest) error {
@@ -320,10 +320,16 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 		}
 		return &bpb.KVList{Kv: kvs}, err
 	}
+	// Zero can limit the bandwidth of the move, so that it doesn't slow down the queries and
+	// mutations the groups are serving.
+	limiter := &bandwidthLimiter{}
 	stream.Send = func(buf *z.Buffer) error {
 		kvs := &pb.KVS{
 			Data: buf.Bytes(),
 		}
+		if err := limiter.wait(out.Context(), len(kvs.Data), float64(in.Bandwidth)); err != nil {
+			return err
+		}
 		return out.Send(kvs)
 	}
 	span.Annotatef(nil, "Starting stream list orchestrate")
@@ -33,6 +33,8 @@ import (
 	otrace "go.opencensus.io/trace"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const baseTimeout time.Duration = 4 * time.Second
@@ -51,7 +53,11 @@ var limiter rateLimiter
 type rateLimiter struct {
 	iou int
 	max int
-	c   *sync.Cond
+	// waiting is the number of mutation proposals waiting for the iou to go down, and maxWaiting
+	// the most that can. 0 means no limit.
+	waiting    int
+	maxWaiting int
+	c          *sync.Cond
 }
 
 // Instead of using the time/rate package, we use this simple one, because that
@@ -72,13 +78,28 @@ func (rl *rateLimiter) bleed() {
 	}
 }
 
-func (rl *rateLimiter) incr(ctx context.Context, retry int) error {
+// incr waits for the iou to leave room for the proposal. If bounded is set, and maxWaiting
+// proposals are already waiting, it returns errOverloaded instead.
+func (rl *rateLimiter) incr(ctx context.Context, retry int, bounded bool) error {
 	// Let's not wait here via time.Sleep or similar. Let pendingProposals
 	// channel do its natural rate limiting.
 	weight := 1 << uint(retry) // Use an exponentially increasing weight.
 	c := rl.c
 	c.L.Lock()
 
+	if bounded && rl.iou+weight > rl.max {
+		if rl.maxWaiting > 0 && rl.waiting >= rl.maxWaiting {
+			c.L.Unlock()
+			return errOverloaded("%d mutation proposals are already waiting to be proposed",
+				rl.maxWaiting)
+		}
+		rl.waiting++
+		defer func() {
+			c.L.Lock()
+			rl.waiting--
+			c.L.Unlock()
+		}()
+	}
 	for {
 		if rl.iou+weight <= rl.max {
 			rl.iou += weight
@@ -116,6 +137,27 @@ func uniqueKey() uint64 {
 var errInternalRetry = errors.New("Retry Raft proposal internally")
 var errUnableToServe = errors.New("Server overloaded with pending proposals. Please retry later")
 
+// errOverloaded returns the error that new mutations are rejected with while the group has too
+// big a backlog to take them in. Its Unavailable code tells the clients that they can retry them.
+func errOverloaded(format string, args ...interface{}) error {
+	return status.Errorf(codes.Unavailable, "Server overloaded: "+format+". Please retry later",
+		args...)
+}
+
+// admit returns an error if the entries that this node has yet to apply are more than
+// --max_apply_backlog, so that new mutations are rejected instead of piling up in memory.
+func (n *node) admit() error {
+	limit := x.WorkerConfig.MaxApplyBacklog
+	if limit <= 0 {
+		return nil
+	}
+	if sz := atomic.LoadInt64(&n.pendingSize); sz > limit {
+		return errOverloaded("%d bytes of Raft entries are waiting to be applied, more than "+
+			"the limit of %d", sz, limit)
+	}
+	return nil
+}
+
 // proposeAndWait sends a proposal through RAFT. It waits on a channel for the proposal
 // to be applied(written to WAL) to all the nodes in the group.
 func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr error) {
@@ -155,6 +197,16 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 		}
 	}
 
+	// Mutations are the new work of transactions, which can wait until the group has caught up.
+	if proposal.Mutations != nil {
+		if err := n.UnsafePartition(); err != nil {
+			return errors.Wrapf(err, "Refusing to propose mutations")
+		}
+		if err := n.admit(); err != nil {
+			return err
+		}
+	}
+
 	// Do a type check here if schema is present
 	// In very rare cases invalid entries might pass through raft, which would
 	// be persisted, we do best effort schema check while writing
@@ -191,6 +243,11 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 		}
 	}
 
+	// The replicas apply the proposal with the time it was made, instead of their own clocks.
+	if proposal.ProposedAt == 0 {
+		proposal.ProposedAt = time.Now().Unix()
+	}
+
 	// Let's keep the same key, so multiple retries of the same proposal would
 	// have this shared key. Thus, each server in the group can identify
 	// whether it has already done this work, and if so, skip it.
@@ -205,6 +262,11 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 	// Trim data to the new size after Marshal.
 	data = data[:8+sz]
 
+	// Only data mutations are batched, as they're the ones that are small and many.
+	batch := proposal.Mutations != nil && len(proposal.Mutations.Edges) > 0 &&
+		len(proposal.Mutations.Schema) == 0 && len(proposal.Mutations.Types) == 0 &&
+		proposal.Mutations.DropOp == pb.Mutations_NONE
+
 	span := otrace.FromContext(ctx)
 	stop := x.SpanTimer(span, "n.proposeAndWait")
 	defer stop()
@@ -223,7 +285,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 
 		span.Annotatef(nil, "Proposing with key: %d. Timeout: %v", key, timeout)
 
-		if err = n.Raft().Propose(cctx, data); err != nil {
+		if err = n.propose(cctx, data, batch); err != nil {
 			return errors.Wrapf(err, "While proposing")
 		}
 
@@ -279,7 +341,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 			// might be.
 		default:
 			span.Annotatef(nil, "incr with %d", i)
-			if err := limiter.incr(ctx, i); err != nil {
+			if err := limiter.incr(ctx, i, proposal.Mutations != nil); err != nil {
 				return err
 			}
 			// We have now acquired slots in limiter. We MUST release them before we retry this
@@ -296,3 +358,125 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 	}
 	return errUnableToServe
 }
+
+// batchedProposal is the data of a proposal that's waiting to be batched with others.
+type batchedProposal struct {
+	data  []byte
+	errCh chan error
+}
+
+// propose hands the data of a proposal to Raft. If batch is set, the proposal can be batched with
+// others into one Raft entry, as --proposal_batch_size allows.
+func (n *node) propose(ctx context.Context, data []byte, batch bool) error {
+	if !batch || n.batchCh == nil || len(data) >= x.WorkerConfig.ProposalBatchSize ||
+		!groups().peersSupport(x.ProtocolBatchedProposals) {
+		return n.Raft().Propose(ctx, data)
+	}
+	p := &batchedProposal{data: data, errCh: make(chan error, 1)}
+	select {
+	case n.batchCh <- p:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-p.errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// batchProposals proposes the proposals received on batchCh. It waits up to
+// --proposal_batch_latency after the first one for others, until the batch reaches
+// --proposal_batch_size, and proposes them all in one Raft entry.
+func (n *node) batchProposals() {
+	defer n.closer.Done()
+
+	maxSize := x.WorkerConfig.ProposalBatchSize
+	var batch []*batchedProposal
+	for {
+		batch = batch[:0]
+		select {
+		case p := <-n.batchCh:
+			batch = append(batch, p)
+		case <-n.closer.HasBeenClosed():
+			return
+		}
+
+		size := len(batch[0].data)
+		timer := time.NewTimer(x.WorkerConfig.ProposalBatchLatency)
+	wait:
+		for size < maxSize {
+			select {
+			case p := <-n.batchCh:
+				batch = append(batch, p)
+				size += len(p.data)
+			case <-timer.C:
+				// Take the proposals that are already waiting, without waiting for more.
+				for size < maxSize && len(n.batchCh) > 0 {
+					p := <-n.batchCh
+					batch = append(batch, p)
+					size += len(p.data)
+				}
+				break wait
+			case <-n.closer.HasBeenClosed():
+				break wait
+			}
+		}
+		timer.Stop()
+
+		err := n.proposeBatch(batch)
+		for _, p := range batch {
+			p.errCh <- err
+		}
+	}
+}
+
+// proposeBatch proposes the data of the proposals in one Raft entry. The entry has a key of
+// zero, and the proposals keep their own keys, so that they're applied and acknowledged
+// like the ones that aren't batched.
+func (n *node) proposeBatch(batch []*batchedProposal) error {
+	data := batch[0].data
+	if len(batch) > 1 {
+		var err error
+		if data, err = marshalBatch(batch); err != nil {
+			return err
+		}
+		ostats.Record(n.ctx, x.RaftProposalBatchSize.M(int64(len(batch))))
+	}
+
+	ctx, cancel := context.WithTimeout(n.ctx, baseTimeout)
+	defer cancel()
+	return n.Raft().Propose(ctx, data)
+}
+
+// marshalBatch returns the data of the entry that holds the batch.
+func marshalBatch(batch []*batchedProposal) ([]byte, error) {
+	proposal := &pb.Proposal{Batch: make([][]byte, 0, len(batch))}
+	for _, p := range batch {
+		proposal.Batch = append(proposal.Batch, p.data)
+	}
+	data := make([]byte, 8+proposal.Size())
+	sz, err := proposal.MarshalToSizedBuffer(data[8:])
+	if err != nil {
+		return nil, err
+	}
+	return data[:8+sz], nil
+}
+
+// batchedKeys returns the keys of the proposals batched in the data of an entry. It returns nil
+// if the entry isn't a batch.
+func batchedKeys(data []byte) []uint64 {
+	if binary.BigEndian.Uint64(data[:8]) != 0 {
+		return nil
+	}
+	var p pb.Proposal
+	if err := p.Unmarshal(data[8:]); err != nil {
+		return nil
+	}
+	keys := make([]uint64, 0, len(p.Batch))
+	for _, b := range p.Batch {
+		keys = append(keys, binary.BigEndian.Uint64(b[:8]))
+	}
+	return keys
+}
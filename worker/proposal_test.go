@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -26,6 +27,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
 )
 
 // proposeAndWaitEmulator emulates proposeAndWait. It has one function(propose) inside it,
@@ -48,7 +53,7 @@ func proposeAndWaitEmulator(l *rateLimiter, r *rand.Rand, maxRetry int, sleep bo
 	}
 
 	runPropose := func(i int) error {
-		if err := l.incr(context.Background(), i); err != nil {
+		if err := l.incr(context.Background(), i, false); err != nil {
 			return err
 		}
 		defer l.decr(i)
@@ -63,6 +68,32 @@ func proposeAndWaitEmulator(l *rateLimiter, r *rand.Rand, maxRetry int, sleep bo
 	return errUnableToServe
 }
 
+func TestLimiterMaxWaiting(t *testing.T) {
+	l := &rateLimiter{c: sync.NewCond(&sync.Mutex{}), max: 1, maxWaiting: 1}
+	ctx := context.Background()
+	require.NoError(t, l.incr(ctx, 0, true))
+
+	// The second proposal waits for the first one to be done, and the third is rejected.
+	done := make(chan error)
+	go func() { done <- l.incr(ctx, 0, true) }()
+	for {
+		l.c.L.Lock()
+		waiting := l.waiting
+		l.c.L.Unlock()
+		if waiting == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	err := l.incr(ctx, 0, true)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	l.decr(0)
+	require.NoError(t, <-done)
+	l.decr(0)
+	require.Zero(t, l.waiting)
+}
+
 // This test tests for deadlock in rate limiter. It tried some fixed number of proposals in
 // multiple goroutines. At the end it matches if sum of completed and aborted proposals is
 // equal to tried proposals or not.
@@ -116,6 +147,36 @@ func TestLimiterDeadlock(t *testing.T) {
 		fmt.Sprintf("Tried: %d, Compteted: %d, Aborted: %d", toTry, completed, aborted))
 }
 
+func TestBatchedKeys(t *testing.T) {
+	proposalData := func(key uint64, startTs uint64) []byte {
+		proposal := &pb.Proposal{Mutations: &pb.Mutations{StartTs: startTs}}
+		data := make([]byte, 8+proposal.Size())
+		binary.BigEndian.PutUint64(data, key)
+		sz, err := proposal.MarshalToSizedBuffer(data[8:])
+		require.NoError(t, err)
+		return data[:8+sz]
+	}
+
+	single := proposalData(7, 10)
+	require.Nil(t, batchedKeys(single))
+
+	batch := []*batchedProposal{
+		{data: single},
+		{data: proposalData(8, 11)},
+		{data: proposalData(9, 12)},
+	}
+	data, err := marshalBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7, 8, 9}, batchedKeys(data))
+
+	var proposal pb.Proposal
+	require.NoError(t, proposal.Unmarshal(data[8:]))
+	require.Len(t, proposal.Batch, 3)
+	var second pb.Proposal
+	require.NoError(t, second.Unmarshal(proposal.Batch[1][8:]))
+	require.Equal(t, uint64(11), second.Mutations.StartTs)
+}
+
 func BenchmarkRateLimiter(b *testing.B) {
 	ious := []int{256}
 	retries := []int{3}
@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	otrace "go.opencensus.io/trace"
@@ -56,7 +57,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang", "noconflict"}
+			"lang", "noconflict", "ttl"}
 	}
 
 	myGid := groups().groupId()
@@ -111,6 +112,8 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Lang = schema.State().HasLang(attr)
 		case "noconflict":
 			schemaNode.NoConflict = schema.State().HasNoConflict(attr)
+		case "ttl":
+			schemaNode.Ttl = uint64(schema.State().TTL(attr) / time.Second)
 		default:
 			//pass
 		}
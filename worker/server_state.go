@@ -76,6 +76,8 @@ func setBadgerOptions(opt badger.Options) badger.Options {
 	glog.Infof("Setting Posting Dir Compression Level: %d", Config.PostingDirCompressionLevel)
 	opt.Compression = Config.PostingDirCompression
 	opt.ZSTDCompressionLevel = Config.PostingDirCompressionLevel
+	opt.BlockSize = Config.PostingDirBlockSize
+	opt.BloomFalsePositive = Config.PostingDirBloomFalsePositive
 
 	// Settings for the data directory.
 	return opt
@@ -97,17 +99,24 @@ func (s *ServerState) initStorage() {
 
 	{
 		// Write Ahead Log directory
-		x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
-		s.WALstore, err = raftwal.InitEncrypted(Config.WALDir, x.WorkerConfig.EncryptionKey)
-		x.Check(err)
+		if x.WorkerConfig.InMemory {
+			s.WALstore = raftwal.InitMemory()
+		} else {
+			x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
+			s.WALstore, err = raftwal.InitEncrypted(Config.WALDir, x.WorkerConfig.EncryptionKey)
+			x.Check(err)
+		}
 	}
 	{
 		// Postings directory
 		// All the writes to posting store should be synchronous. We use batched writers
 		// for posting lists, so the cost of sync writes is amortized.
-		x.Check(os.MkdirAll(Config.PostingDir, 0700))
-		opt := badger.DefaultOptions(Config.PostingDir).
-			WithValueThreshold(1 << 10 /* 1KB */).
+		opt := badger.DefaultOptions("").WithInMemory(true)
+		if !x.WorkerConfig.InMemory {
+			x.Check(os.MkdirAll(Config.PostingDir, 0700))
+			opt = badger.DefaultOptions(Config.PostingDir)
+		}
+		opt = opt.WithValueThreshold(1 << 10 /* 1KB */).
 			WithNumVersionsToKeep(math.MaxInt32).
 			WithBlockCacheSize(Config.PBlockCacheSize).
 			WithIndexCacheSize(Config.PIndexCacheSize)
@@ -127,7 +136,9 @@ func (s *ServerState) initStorage() {
 		opt.EncryptionKey = nil
 	}
 	// Temp directory
-	x.Check(os.MkdirAll(x.WorkerConfig.TmpDir, 0700))
+	if !x.WorkerConfig.InMemory {
+		x.Check(os.MkdirAll(x.WorkerConfig.TmpDir, 0700))
+	}
 
 	s.gcCloser = z.NewCloser(2)
 	go x.RunVlogGC(s.Pstore, s.gcCloser)
@@ -17,7 +17,10 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -28,6 +31,7 @@ import (
 	"go.etcd.io/etcd/raft"
 
 	"github.com/dgraph-io/badger/v2"
+	bpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -57,6 +61,20 @@ func (n *node) populateSnapshot(snap pb.Snapshot, pl *conn.Pool) error {
 
 	// Set my RaftContext on the snapshot, so it's easier to locate me.
 	snap.Context = n.RaftContext
+
+	// If an earlier stream of this snapshot was interrupted, the keys it wrote are kept, and the
+	// leader is asked to skip them.
+	resume := n.snapResume
+	n.snapResume = nil
+	if resume != nil && !resume.matches(&snap) {
+		resume = nil
+	}
+	if resume != nil {
+		glog.Infof("Resuming the snapshot at index %d, skipping %d key ranges",
+			snap.Index, len(resume.ranges))
+		snap.ResumeRanges = resume.ranges.encode()
+	}
+
 	stream, err := c.StreamSnapshot(ctx)
 	if err != nil {
 		return err
@@ -67,7 +85,7 @@ func (n *node) populateSnapshot(snap pb.Snapshot, pl *conn.Pool) error {
 	}
 
 	var writer badgerWriter
-	if snap.SinceTs == 0 {
+	if snap.SinceTs == 0 && resume == nil {
 		sw := pstore.NewStreamWriter()
 		defer sw.Cancel()
 
@@ -80,13 +98,37 @@ func (n *node) populateSnapshot(snap pb.Snapshot, pl *conn.Pool) error {
 		writer = pstore.NewManagedWriteBatch()
 	}
 
+	// received holds the range of keys received in each stream of the leader. The keys of a
+	// stream come in order, so all the ones in between have been received too. The last one is
+	// left out, as only some of its versions might have been received.
+	received := make(map[uint32]*keyRange)
+	// keep flushes what has been received when the stream is interrupted, so that the next
+	// stream of this snapshot can resume from it.
+	keep := func(err error) error {
+		ranges := resume.keyRanges()
+		for _, r := range received {
+			ranges = append(ranges, *r)
+		}
+		if ferr := writer.Flush(); ferr != nil {
+			glog.Warningf("Unable to keep the partial snapshot: %v", ferr)
+			return err
+		}
+		n.snapResume = &snapshotResume{
+			index:   snap.Index,
+			readTs:  snap.ReadTs,
+			sinceTs: snap.SinceTs,
+			ranges:  mergeKeyRanges(ranges),
+		}
+		return err
+	}
+
 	// We can use count to check the number of posting lists returned in tests.
 	size := 0
 	var done *pb.KVS
 	for {
 		kvs, err := stream.Recv()
 		if err != nil {
-			return err
+			return keep(err)
 		}
 		if kvs.Done {
 			done = kvs
@@ -95,7 +137,7 @@ func (n *node) populateSnapshot(snap pb.Snapshot, pl *conn.Pool) error {
 		}
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return keep(ctx.Err())
 		default:
 		}
 
@@ -107,6 +149,21 @@ func (n *node) populateSnapshot(snap pb.Snapshot, pl *conn.Pool) error {
 		if err := writer.Write(buf); err != nil {
 			return err
 		}
+		err = buf.SliceIterate(func(s []byte) error {
+			var kv bpb.KV
+			if err := kv.Unmarshal(s); err != nil {
+				return err
+			}
+			if r, ok := received[kv.StreamId]; ok {
+				r.end = kv.Key
+			} else {
+				received[kv.StreamId] = &keyRange{start: kv.Key, end: kv.Key}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 	}
 	if err := writer.Flush(); err != nil {
 		return err
@@ -210,9 +267,21 @@ func doStreamSnapshot(snap *pb.Snapshot, out pb.Worker_StreamSnapshotServer) err
 	stream.KeyToList = nil
 	stream.Send = func(buf *z.Buffer) error {
 		kvs := &pb.KVS{Data: buf.Bytes()}
+		if err := snapshotLimiter.wait(out.Context(), len(kvs.Data),
+			x.WorkerConfig.SnapshotBandwidth); err != nil {
+			return err
+		}
 		return out.Send(kvs)
 	}
+	resumed, err := decodeKeyRanges(snap.ResumeRanges)
+	if err != nil {
+		return err
+	}
 	stream.ChooseKey = func(item *badger.Item) bool {
+		// Skip the keys that the follower already has from an interrupted stream.
+		if resumed.contains(item.Key()) {
+			return false
+		}
 		if item.Version() >= snap.SinceTs {
 			return true
 		}
@@ -298,3 +367,118 @@ func (w *grpcWorker) StreamSnapshot(stream pb.Worker_StreamSnapshotServer) error
 	glog.Infof("Stream snapshot: OK")
 	return nil
 }
+
+// snapshotResume is what a follower has kept of a snapshot whose stream was interrupted.
+type snapshotResume struct {
+	index   uint64
+	readTs  uint64
+	sinceTs uint64
+	ranges  keyRanges
+}
+
+// matches tells whether snap is the snapshot that was kept.
+func (r *snapshotResume) matches(snap *pb.Snapshot) bool {
+	return r.index == snap.Index && r.readTs == snap.ReadTs && r.sinceTs == snap.SinceTs
+}
+
+func (r *snapshotResume) keyRanges() keyRanges {
+	if r == nil {
+		return nil
+	}
+	return r.ranges
+}
+
+// keyRange is a range of keys. It includes its start key, but not its end key.
+type keyRange struct {
+	start, end []byte
+}
+
+// keyRanges is a list of key ranges sorted by their start, that don't overlap.
+type keyRanges []keyRange
+
+// mergeKeyRanges sorts ranges, and merges the ones that overlap or touch.
+func mergeKeyRanges(ranges []keyRange) keyRanges {
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].start, ranges[j].start) < 0
+	})
+	var out keyRanges
+	for _, r := range ranges {
+		if bytes.Compare(r.start, r.end) >= 0 {
+			continue
+		}
+		if last := len(out) - 1; last >= 0 && bytes.Compare(r.start, out[last].end) <= 0 {
+			if bytes.Compare(r.end, out[last].end) > 0 {
+				out[last].end = r.end
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// contains tells whether key is in one of the ranges.
+func (kr keyRanges) contains(key []byte) bool {
+	i := sort.Search(len(kr), func(i int) bool { return bytes.Compare(kr[i].end, key) > 0 })
+	return i < len(kr) && bytes.Compare(kr[i].start, key) <= 0
+}
+
+// encode returns the ranges as pairs of start and end keys, for pb.Snapshot.ResumeRanges.
+func (kr keyRanges) encode() [][]byte {
+	out := make([][]byte, 0, 2*len(kr))
+	for _, r := range kr {
+		out = append(out, r.start, r.end)
+	}
+	return out
+}
+
+func decodeKeyRanges(keys [][]byte) (keyRanges, error) {
+	if len(keys)%2 != 0 {
+		return nil, errors.Errorf("the resume ranges of a snapshot must be pairs of keys, got %d",
+			len(keys))
+	}
+	ranges := make([]keyRange, 0, len(keys)/2)
+	for i := 0; i < len(keys); i += 2 {
+		ranges = append(ranges, keyRange{start: keys[i], end: keys[i+1]})
+	}
+	return mergeKeyRanges(ranges), nil
+}
+
+// snapshotLimiter limits the rate at which this alpha sends snapshots, to
+// --snapshot_bandwidth across all the followers it streams to.
+var snapshotLimiter = &bandwidthLimiter{}
+
+type bandwidthLimiter struct {
+	sync.Mutex
+	// next is when the data sent so far is done being sent, at the limit.
+	next time.Time
+}
+
+// wait blocks until size more bytes can be sent without going over rate bytes per second. A rate
+// of zero or less doesn't limit it.
+func (l *bandwidthLimiter) wait(ctx context.Context, size int, rate float64) error {
+	if rate <= 0 {
+		return nil
+	}
+	l.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	start := l.next
+	l.next = l.next.Add(time.Duration(float64(size) / rate * float64(time.Second)))
+	l.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -186,3 +186,46 @@ func waitForSnapshot(t *testing.T, prevSnapTs uint64) uint64 {
 		time.Sleep(time.Second)
 	}
 }
+
+func TestKeyRanges(t *testing.T) {
+	ranges := mergeKeyRanges([]keyRange{
+		{start: []byte("m"), end: []byte("p")},
+		{start: []byte("a"), end: []byte("c")},
+		{start: []byte("b"), end: []byte("d")},
+		{start: []byte("d"), end: []byte("f")},
+		{start: []byte("x"), end: []byte("x")},
+	})
+	require.Equal(t, keyRanges{
+		{start: []byte("a"), end: []byte("f")},
+		{start: []byte("m"), end: []byte("p")},
+	}, ranges)
+
+	for key, contains := range map[string]bool{
+		"": false, "a": true, "c": true, "e": true, "f": false, "l": false, "m": true,
+		"o": true, "p": false, "x": false,
+	} {
+		require.Equal(t, contains, ranges.contains([]byte(key)), "key %q", key)
+	}
+
+	decoded, err := decodeKeyRanges(ranges.encode())
+	require.NoError(t, err)
+	require.Equal(t, ranges, decoded)
+	_, err = decodeKeyRanges([][]byte{[]byte("a")})
+	require.Error(t, err)
+}
+
+func TestBandwidthLimiter(t *testing.T) {
+	l := &bandwidthLimiter{}
+	start := time.Now()
+	// The first 100 bytes go at once, and the ones after wait for them at 1000 bytes per second.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.wait(context.Background(), 100, 1000))
+	}
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+
+	// The last 100 bytes haven't been sent yet, so these have to wait.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Equal(t, context.Canceled, l.wait(ctx, 100, 1000))
+	require.NoError(t, l.wait(ctx, 100, 0))
+}
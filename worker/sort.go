@@ -357,6 +357,7 @@ func multiSort(ctx context.Context, r *sortresult, ts *pb.SortMessage) error {
 			UidList: dest,
 			Langs:   ts.Order[i].Langs,
 			ReadTs:  ts.ReadTs,
+			Quorum:  ts.Quorum,
 		}
 		go fetchValues(ctx, in, i, och)
 	}
@@ -434,6 +435,9 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 	stop := x.SpanTimer(span, "processSort")
 	defer stop()
 
+	if err := waitForQuorum(ctx, ts.Quorum); err != nil {
+		return nil, err
+	}
 	span.Annotatef(nil, "Waiting for startTs: %d", ts.ReadTs)
 	if err := posting.Oracle().WaitForTs(ctx, ts.ReadTs); err != nil {
 		return nil, err
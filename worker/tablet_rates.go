@@ -0,0 +1,131 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// tabletRates counts the reads and writes of each predicate, so that the rates at which they
+// happen can be sent to Zero along with the tablet sizes.
+type tabletRates struct {
+	sync.RWMutex
+	counts map[string]*tabletCount
+	since  time.Time
+	// smoothed has the rates that take returned last, which it averages with the new ones, so
+	// that a burst doesn't make them jump.
+	smoothed map[string]tabletRate
+	// lastWrite is the Unix time in nanoseconds of the last edge written to any predicate.
+	lastWrite int64
+}
+
+type tabletCount struct {
+	reads  uint64
+	writes uint64
+}
+
+type tabletRate struct {
+	reads  float64
+	writes float64
+}
+
+const (
+	// rateWeight is the weight of the rates of the last period in the smoothed ones.
+	rateWeight = 0.5
+	// minRate is the rate below which a predicate is taken to be idle, and isn't kept anymore.
+	minRate = 0.01
+)
+
+var rates = newTabletRates()
+
+func newTabletRates() *tabletRates {
+	return &tabletRates{
+		counts:   make(map[string]*tabletCount),
+		since:    time.Now(),
+		smoothed: make(map[string]tabletRate),
+	}
+}
+
+// read counts a task that reads the predicate attr.
+func (r *tabletRates) read(attr string) {
+	atomic.AddUint64(&r.count(attr).reads, 1)
+}
+
+// write counts an edge written to the predicate attr.
+func (r *tabletRates) write(attr string) {
+	atomic.AddUint64(&r.count(attr).writes, 1)
+	atomic.StoreInt64(&r.lastWrite, time.Now().UnixNano())
+}
+
+// writtenWithin returns whether an edge was written to any predicate in the last d.
+func (r *tabletRates) writtenWithin(d time.Duration) bool {
+	last := atomic.LoadInt64(&r.lastWrite)
+	return last > 0 && time.Since(time.Unix(0, last)) < d
+}
+
+func (r *tabletRates) count(attr string) *tabletCount {
+	r.RLock()
+	c, ok := r.counts[attr]
+	r.RUnlock()
+	if ok {
+		return c
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if c, ok = r.counts[attr]; !ok {
+		c = &tabletCount{}
+		r.counts[attr] = c
+	}
+	return c
+}
+
+// take returns the reads and writes per second of each predicate, averaged over the periods
+// between the calls to it with more weight on the recent ones, and starts counting them again.
+func (r *tabletRates) take() (reads, writes map[string]float64) {
+	r.Lock()
+	defer r.Unlock()
+	counts, secs := r.counts, time.Since(r.since).Seconds()
+	r.counts, r.since = make(map[string]*tabletCount), time.Now()
+
+	// The predicates that weren't used in the period have rates of zero in it.
+	for attr := range r.smoothed {
+		if _, ok := counts[attr]; !ok {
+			counts[attr] = &tabletCount{}
+		}
+	}
+	reads = make(map[string]float64, len(counts))
+	writes = make(map[string]float64, len(counts))
+	for attr, c := range counts {
+		last := r.smoothed[attr]
+		rate := tabletRate{
+			reads: rateWeight*float64(atomic.LoadUint64(&c.reads))/secs +
+				(1-rateWeight)*last.reads,
+			writes: rateWeight*float64(atomic.LoadUint64(&c.writes))/secs +
+				(1-rateWeight)*last.writes,
+		}
+		if rate.reads < minRate && rate.writes < minRate {
+			delete(r.smoothed, attr)
+			continue
+		}
+		r.smoothed[attr] = rate
+		reads[attr], writes[attr] = rate.reads, rate.writes
+	}
+	return reads, writes
+}
@@ -44,6 +44,7 @@ import (
 	cindex "github.com/google/codesearch/index"
 	cregexp "github.com/google/codesearch/regexp"
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 )
 
 func invokeNetworkRequest(ctx context.Context, addr string,
@@ -220,6 +221,8 @@ const (
 	uidInFn
 	customIndexFn
 	matchFn
+	nearestFn
+	jsonPathFn
 	standardFn = 100
 )
 
@@ -262,6 +265,10 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "nearest":
+		return nearestFn, f
+	case "jsonpath":
+		return jsonPathFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -282,10 +289,32 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 		return true
 	case geoFn, fullTextSearchFn, standardFn, matchFn:
 		return true
+	case nearestFn:
+		// As a filter, the nearest uids are picked by the distances of their values.
+		return uidList == nil
 	}
 	return false
 }
 
+// UsesIndex returns whether the function with the given name reads the index of its predicate,
+// rather than the values of the uids it's given. isFilter tells whether it's run as a filter,
+// with uids to check, or at root.
+func UsesIndex(fnName string, isFilter bool) bool {
+	fnType, _ := parseFuncTypeHelper(fnName)
+	switch fnType {
+	case regexFn:
+		// As a filter, the values of the uids are matched instead.
+		return !isFilter
+	case customIndexFn:
+		return true
+	}
+	var uidList *pb.List
+	if isFilter {
+		uidList = &pb.List{}
+	}
+	return needsIndex(fnType, uidList)
+}
+
 // needsIntersect checks if the function type needs algo.IntersectSorted() after the results
 // are collected. This is needed for functions that require all values to  match, like
 // "allofterms", "alloftext", and custom functions with "allof".
@@ -311,12 +340,16 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn,
+		nearestFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
 		// Operate on uid postings
 		return false, nil
+	case jsonPathFn:
+		// The values are read by handleJSONPathFunction.
+		return false, nil
 	case notAFunction:
 		return typ.IsScalar(), nil
 	}
@@ -874,6 +907,18 @@ const (
 	NoCache
 )
 
+// waitForQuorum waits, for a quorum read, until this alpha has applied all the entries that its
+// group had committed when the read came in. The index of those is asked to the leader of the
+// group, which checks that it's still the leader with a quorum of the group first.
+func waitForQuorum(ctx context.Context, quorum bool) error {
+	if !quorum {
+		return nil
+	}
+	otrace.FromContext(ctx).Annotate(nil, "Waiting for a quorum read")
+	err := groups().Node.WaitLinearizableRead(ctx)
+	return errors.Wrapf(err, "while waiting for a quorum read")
+}
+
 // processTask processes the query, accumulates and returns the result.
 func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, error) {
 	ctx, span := otrace.StartSpan(ctx, "processTask."+q.Attr)
@@ -882,6 +927,9 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	stop := x.SpanTimer(span, "processTask"+q.Attr)
 	defer stop()
 
+	if err := waitForQuorum(ctx, q.Quorum); err != nil {
+		return nil, err
+	}
 	span.Annotatef(nil, "Waiting for startTs: %d at node: %d, gid: %d",
 		q.ReadTs, groups().Node.Id, gid)
 	if err := posting.Oracle().WaitForTs(ctx, q.ReadTs); err != nil {
@@ -912,6 +960,7 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	case knownGid != groups().groupId():
 		return nil, errUnservedTablet
 	}
+	rates.read(q.Attr)
 
 	var qs queryState
 	if q.Cache == UseTxnCache {
@@ -1001,7 +1050,7 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		if err = qs.handleValuePostings(ctx, args); err != nil {
 			return nil, err
 		}
-	} else {
+	} else if !srcFn.useCountIndex {
 		span.Annotate(nil, "handleUidPostings")
 		if err = qs.handleUidPostings(ctx, args, opts); err != nil {
 			return nil, err
@@ -1015,7 +1064,7 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
-	if srcFn.fnType == compareScalarFn && srcFn.isFuncAtRoot {
+	if srcFn.fnType == compareScalarFn && (srcFn.isFuncAtRoot || srcFn.useCountIndex) {
 		span.Annotate(nil, "handleCompareScalarFunction")
 		if err := qs.handleCompareScalarFunction(ctx, args); err != nil {
 			return nil, err
@@ -1036,6 +1085,20 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	if srcFn.fnType == nearestFn {
+		span.Annotate(nil, "handleNearestFunction")
+		if err := qs.handleNearestFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == jsonPathFn {
+		span.Annotate(nil, "handleJSONPathFunction")
+		if err := qs.handleJSONPathFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == compareAttrFn && len(srcFn.tokens) > 0 {
@@ -1098,7 +1161,44 @@ func (qs *queryState) handleCompareScalarFunction(ctx context.Context, arg funcA
 		readTs:  arg.q.ReadTs,
 		reverse: arg.q.Reverse,
 	}
-	return qs.evaluate(cp, arg.out)
+	if err := qs.evaluate(cp, arg.out); err != nil {
+		return err
+	}
+	if arg.q.UidList != nil {
+		// This is a filter, so only the uids being filtered are kept.
+		for _, uids := range arg.out.UidMatrix {
+			algo.IntersectWith(uids, arg.q.UidList, uids)
+		}
+	}
+	return nil
+}
+
+// countIndexCovers tells whether all of the uids whose counts match the count function fn are
+// in the count index. Zero counts aren't in it, so functions that match them can't use it.
+func countIndexCovers(fn string, counts []int64) bool {
+	switch fn {
+	case "eq", "ge":
+		return counts[0] > 0
+	case "gt":
+		return counts[0] >= 0
+	case between:
+		return counts[0] > 0 && counts[1] > 0
+	}
+	return false
+}
+
+// countIndexPays tells whether filtering numUids uids by the count function fn is cheaper with
+// the count index than by counting the postings of each of them. eq reads a single count from the
+// index, and between one for each count in its range, but ge and gt read every count above theirs,
+// however many there are.
+func countIndexPays(fn string, counts []int64, numUids int) bool {
+	switch fn {
+	case "eq":
+		return true
+	case between:
+		return int64(numUids) > counts[1]-counts[0]+1
+	}
+	return false
 }
 
 func (qs *queryState) handleRegexFunction(ctx context.Context, arg funcArgs) error {
@@ -1653,9 +1753,14 @@ type functionContext struct {
 	fname          string
 	fnType         FuncType
 	regex          *cregexp.Regexp
+	point          *geom.Point
+	jsonPath       types.JSONPath
 	isFuncAtRoot   bool
-	isStringFn     bool
-	atype          types.TypeID
+	// useCountIndex is set for count filters that are done with the count index, instead of
+	// counting the postings of each of the uids being filtered.
+	useCountIndex bool
+	isStringFn    bool
+	atype         types.TypeID
 }
 
 const (
@@ -1814,6 +1919,9 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		fc.threshold = thresholds
 		checkRoot(q, fc)
+		fc.useCountIndex = !fc.isFuncAtRoot && countIndexCovers(f, thresholds) &&
+			countIndexPays(f, thresholds, len(q.UidList.GetUids())) &&
+			schema.State().HasCount(ctx, attr)
 	case geoFn:
 		// For geo functions, we get extra information used for filtering.
 		fc.tokens, fc.geoQuery, err = types.GetGeoTokens(q.SrcFunc)
@@ -1822,6 +1930,38 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = len(fc.tokens)
+	case nearestFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		if fc.point, err = types.ParsePoint(q.SrcFunc.Args[0]); err != nil {
+			return nil, errors.Wrapf(err, "Invalid point for nearest")
+		}
+		k, err := strconv.ParseInt(q.SrcFunc.Args[1], 10, 32)
+		if err != nil || k <= 0 {
+			return nil, errors.Errorf("The number of nodes of nearest must be a positive int, "+
+				"got %v", q.SrcFunc.Args[1])
+		}
+		if q.UidList == nil && !schema.State().HasTokenizer(ctx, tok.IdentGeo, attr) {
+			return nil, errors.Errorf("Attribute %s is not indexed with type geo", attr)
+		}
+		fc.threshold = []int64{k}
+		fc.n = 0
+	case jsonPathFn:
+		if len(q.SrcFunc.Args) != 1 && len(q.SrcFunc.Args) != 2 {
+			return nil, errors.Errorf("Function '%s' requires 1 or 2 arguments, but got %d (%v)",
+				q.SrcFunc.Name, len(q.SrcFunc.Args), q.SrcFunc.Args)
+		}
+		if q.UidList == nil {
+			return nil, errors.Errorf("jsonpath() can only be used in a filter, like "+
+				"has(%s) @filter(jsonpath(%s, \"$.key\"))", attr, attr)
+		}
+		if fc.jsonPath, err = types.ParseJSONPath(q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		// The value that's looked for at the path, if one is given.
+		fc.tokens = q.SrcFunc.Args[1:]
+		fc.n = 0
 	case passwordFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
@@ -2010,23 +2150,32 @@ func applyFacetsTree(postingFacets []*api.Facet, ftree *facetsTree) (bool, error
 		}
 
 		switch ftree.function.fnType {
-		case compareAttrFn: // lt, gt, le, ge, eq
+		case compareAttrFn: // lt, gt, le, ge, eq, between
 			fVal, err := facets.ValFor(fc)
 			if err != nil {
 				return false, err
 			}
 
-			v, ok := ftree.function.typesToVal[fVal.Tid]
-			if !ok {
-				// Not found in map and hence convert it here.
-				v, err = types.Convert(ftree.function.val, fVal.Tid)
-				if err != nil {
-					// ignore facet if not of appropriate type.
-					return false, nil
+			// A function has at most two vals, as preprocessFilter checks, so they're kept in an
+			// array rather than a slice allocated for every posting that's filtered.
+			var vals [2]types.Val
+			for i, typesToVal := range ftree.function.typesToVal {
+				v, ok := typesToVal[fVal.Tid]
+				if !ok {
+					// Not found in map and hence convert it here.
+					v, err = types.Convert(ftree.function.vals[i], fVal.Tid)
+					if err != nil {
+						// ignore facet if not of appropriate type.
+						return false, nil
+					}
 				}
+				vals[i] = v
 			}
 
-			return types.CompareVals(ftree.function.name, fVal, v), nil
+			if ftree.function.name == between {
+				return types.CompareBetween(fVal, vals[0], vals[1]), nil
+			}
+			return types.CompareVals(ftree.function.name, fVal, vals[0]), nil
 
 		case standardFn: // allofterms, anyofterms
 			facetType, err := facets.TypeIDFor(fc)
@@ -2108,12 +2257,12 @@ type facetsFunc struct {
 	key    string
 	args   []string
 	tokens []string
-	val    types.Val
+	vals   []types.Val
 	fnType FuncType
-	// typesToVal stores converted vals of the function val for all common types. Converting
+	// typesToVal stores converted vals of each function val for all common types. Converting
 	// function val to particular type val(check applyFacetsTree()) consumes significant amount of
 	// time. This maps helps in doing conversion only once(check preprocessFilter()).
-	typesToVal map[types.TypeID]types.Val
+	typesToVal []map[types.TypeID]types.Val
 }
 type facetsTree struct {
 	op       string
@@ -2138,7 +2287,12 @@ func preprocessFilter(tree *pb.FilterTree) (*facetsTree, error) {
 		ftree.function.args = tree.Func.Args
 
 		fnType, fname := parseFuncTypeHelper(tree.Func.Name)
-		if len(tree.Func.Args) != 1 {
+		if fname == between {
+			if len(tree.Func.Args) != 2 {
+				return nil, errors.Errorf("Two arguments expected in %s, but got %d.",
+					fname, len(tree.Func.Args))
+			}
+		} else if len(tree.Func.Args) != 1 {
 			return nil, errors.Errorf("One argument expected in %s, but got %d.",
 				fname, len(tree.Func.Args))
 		}
@@ -2148,17 +2302,21 @@ func preprocessFilter(tree *pb.FilterTree) (*facetsTree, error) {
 
 		switch fnType {
 		case compareAttrFn:
-			ftree.function.val = types.Val{Tid: types.StringID, Value: []byte(tree.Func.Args[0])}
-			ftree.function.typesToVal = make(map[types.TypeID]types.Val, len(commonTypeIDs))
-			for _, typeID := range commonTypeIDs {
-				// TODO: if conversion is not possible we are not putting anything to map. In
-				// applyFacetsTree we check if entry for a type is not present, we try to convert
-				// it. This double conversion can be avoided.
-				cv, err := types.Convert(ftree.function.val, typeID)
-				if err != nil {
-					continue
+			for _, arg := range tree.Func.Args {
+				val := types.Val{Tid: types.StringID, Value: []byte(arg)}
+				typesToVal := make(map[types.TypeID]types.Val, len(commonTypeIDs))
+				for _, typeID := range commonTypeIDs {
+					// TODO: if conversion is not possible we are not putting anything to map. In
+					// applyFacetsTree we check if entry for a type is not present, we try to
+					// convert it. This double conversion can be avoided.
+					cv, err := types.Convert(val, typeID)
+					if err != nil {
+						continue
+					}
+					typesToVal[typeID] = cv
 				}
-				ftree.function.typesToVal[typeID] = cv
+				ftree.function.vals = append(ftree.function.vals, val)
+				ftree.function.typesToVal = append(ftree.function.typesToVal, typesToVal)
 			}
 		case standardFn:
 			argTokens, aerr := tok.GetTermTokens(tree.Func.Args)
@@ -2335,6 +2493,21 @@ func (qs *queryState) handleHasFunction(ctx context.Context, q *pb.Query, out *p
 	lang := langForFunc(q.Langs)
 	needFiltering := needsStringFiltering(srcFn, q.Langs, q.Attr)
 
+	// With DoCount, the query also wants the number of all the nodes that have the predicate, for
+	// @total, and not only the first ones. The count index has it without iterating over them.
+	countIndex := q.DoCount && !needFiltering && q.AfterUid == 0 &&
+		schema.State().HasCount(ctx, q.Attr)
+	countAll := q.DoCount && !countIndex
+	var found int
+	// include adds uid to the result, and returns whether the iteration should go on.
+	include := func(uid uint64) bool {
+		found++
+		if len(result.Uids) < int(q.First) {
+			result.Uids = append(result.Uids, uid)
+		}
+		return countAll || len(result.Uids) < int(q.First)
+	}
+
 	// This function checks if we should include uid in result or not when has is queried with
 	// @lang(eg: has(name@en)). We need to do this inside this function to return correct result
 	// for first.
@@ -2388,10 +2561,8 @@ loop:
 			case err != nil:
 				return err
 			}
-			result.Uids = append(result.Uids, pk.Uid)
-
 			// We'll stop fetching if we fetch the required count.
-			if len(result.Uids) >= int(q.First) {
+			if !include(pk.Uid) {
 				break
 			}
 			continue
@@ -2414,15 +2585,13 @@ loop:
 			case err != nil:
 				return err
 			}
-			result.Uids = append(result.Uids, pk.Uid)
-
 			// We'll stop fetching if we fetch the required count.
-			if len(result.Uids) >= int(q.First) {
+			if !include(pk.Uid) {
 				break loop
 			}
 		}
 
-		if len(result.Uids)%100000 == 0 {
+		if found%100000 == 0 {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
@@ -2434,5 +2603,42 @@ loop:
 		span.Annotatef(nil, "handleHasFunction found %d uids", len(result.Uids))
 	}
 	out.UidMatrix = append(out.UidMatrix, result)
+	if countIndex {
+		var err error
+		if found, err = qs.countWithCountIndex(q); err != nil {
+			return err
+		}
+	}
+	if q.DoCount {
+		out.Counts = []uint32{uint32(found)}
+	}
 	return nil
 }
+
+// countWithCountIndex returns the number of nodes that have the predicate of q, from its count
+// index. Each of them is in the posting list of exactly one count, the number of its edges.
+func (qs *queryState) countWithCountIndex(q *pb.Query) (int, error) {
+	txn := pstore.NewTransactionAt(q.ReadTs, false)
+	defer txn.Discard()
+
+	pk := x.ParsedKey{Attr: q.Attr}
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = pk.CountPrefix(q.Reverse)
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	var n int
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		pl, err := qs.cache.Get(itr.Item().KeyCopy(nil))
+		if err != nil {
+			return 0, err
+		}
+		l := pl.Length(q.ReadTs, 0)
+		if l < 0 {
+			return 0, errors.Errorf("Unable to read the count index of %s", q.Attr)
+		}
+		n += l
+	}
+	return n, nil
+}
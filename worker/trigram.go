@@ -54,7 +54,10 @@ func uidsForRegex(attr string, arg funcArgs,
 	switch query.Op {
 	case cindex.QAnd:
 		tok.EncodeRegexTokens(query.Trigram)
-		for _, t := range query.Trigram {
+		for i, t := range query.Trigram {
+			if results != nil && fewCandidates(results, query, i) {
+				return results, nil
+			}
 			trigramUids, err := uidsForTrigram(t)
 			if err != nil {
 				return nil, err
@@ -69,9 +72,11 @@ func uidsForRegex(attr string, arg funcArgs,
 				return results, nil
 			}
 		}
-		for _, sub := range query.Sub {
+		for i, sub := range query.Sub {
 			if results == nil {
 				results = intersect
+			} else if fewCandidates(results, query, len(query.Trigram)+i) {
+				return results, nil
 			}
 			// current list of result is passed for intersection
 			var err error
@@ -109,3 +114,30 @@ func uidsForRegex(attr string, arg funcArgs,
 	}
 	return results, nil
 }
+
+// fewCandidates tells whether it's cheaper to check the results against the regular expression
+// than to narrow them down further with the trigrams and subqueries of the AND query, from the
+// one at position next on. Every candidate costs a read of its value, and every trigram costs a
+// read of its posting list. Case-insensitive expressions have a subquery with all the cases of
+// each trigram, so they mostly get down to a few candidates long before running out of them.
+func fewCandidates(results *pb.List, query *cindex.Query, next int) bool {
+	remaining := 0
+	if next < len(query.Trigram) {
+		remaining = len(query.Trigram) - next
+	}
+	for i, sub := range query.Sub {
+		if len(query.Trigram)+i >= next {
+			remaining += countTrigrams(sub)
+		}
+	}
+	return len(results.GetUids()) <= remaining
+}
+
+// countTrigrams returns how many posting lists are read to run query.
+func countTrigrams(query *cindex.Query) int {
+	n := len(query.Trigram)
+	for _, sub := range query.Sub {
+		n += countTrigrams(sub)
+	}
+	return n
+}
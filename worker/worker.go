@@ -19,15 +19,20 @@
 package worker
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"math"
 	"net"
+	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	badgerpb "github.com/dgraph-io/badger/v2/pb"
+	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -44,6 +49,8 @@ var (
 	pstore       *badger.DB
 	workerServer *grpc.Server
 	raftServer   conn.RaftServer
+	// compacting is 1 while CompactPostings is running.
+	compacting int32
 
 	// In case of flaky network connectivity we would try to keep upto maxPendingEntries in wal
 	// so that the nodes which have lagged behind leader can just replay entries instead of
@@ -59,7 +66,8 @@ func workerPort() int {
 func Init(ps *badger.DB) {
 	pstore = ps
 	// needs to be initialized after group config
-	limiter = rateLimiter{c: sync.NewCond(&sync.Mutex{}), max: x.WorkerConfig.NumPendingProposals}
+	limiter = rateLimiter{c: sync.NewCond(&sync.Mutex{}), max: x.WorkerConfig.NumPendingProposals,
+		maxWaiting: x.WorkerConfig.QueuedProposals}
 	go limiter.bleed()
 
 	grpcOpts := []grpc.ServerOption{
@@ -88,6 +96,61 @@ func (w *grpcWorker) Subscribe(
 	}, req.GetPrefixes()...)
 }
 
+// TransferLeadership hands the leadership of the group to another member if this alpha, which
+// must be member m, is the leader. Zero calls it before removing the alpha from the group.
+func (w *grpcWorker) TransferLeadership(ctx context.Context, m *pb.Member) (*api.Payload, error) {
+	g := groups()
+	if m.GetId() != g.Node.Id {
+		return &api.Payload{}, errors.Errorf("This alpha is %#x, not %#x", g.Node.Id, m.GetId())
+	}
+	if !g.Node.AmLeader() {
+		return &api.Payload{Data: []byte("OK")}, nil
+	}
+	peerId, has := g.MyPeer()
+	if !has {
+		return &api.Payload{}, errors.Errorf("No other member of group %d to transfer the "+
+			"leadership to", g.groupId())
+	}
+	glog.Infof("Transferring leadership to %#x", peerId)
+	g.Node.Raft().TransferLeadership(ctx, g.Node.Id, peerId)
+
+	// The transfer happens in the background, so wait for it.
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for g.Node.AmLeader() {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return &api.Payload{}, errors.Wrapf(ctx.Err(), "while transferring leadership to %#x",
+				peerId)
+		}
+	}
+	return &api.Payload{Data: []byte("OK")}, nil
+}
+
+// TakeLeadership makes the alpha given by m the leader of its group, by asking the current leader
+// to transfer the leadership to it. It's used by Zero to spread the leaderships across hosts.
+func (w *grpcWorker) TakeLeadership(ctx context.Context, m *pb.Member) (*api.Payload, error) {
+	g := groups()
+	if m.GetId() != g.Node.Id {
+		return &api.Payload{}, errors.Errorf("This alpha is %#x, not %#x", g.Node.Id, m.GetId())
+	}
+	// The leader is given by Raft, as the membership state can be behind.
+	for !g.Node.AmLeader() {
+		if lead := g.Node.Raft().Status().Lead; lead != 0 {
+			glog.Infof("Taking the leadership of group %d from %#x", g.groupId(), lead)
+			g.Node.Raft().TransferLeadership(ctx, lead, g.Node.Id)
+		}
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			return &api.Payload{}, errors.Wrapf(ctx.Err(), "while taking the leadership of "+
+				"group %d", g.groupId())
+		}
+	}
+	return &api.Payload{Data: []byte("OK")}, nil
+}
+
 // RunServer initializes a tcp server on port which listens to requests from
 // other workers for pb.communication.
 func RunServer(bindall bool) {
@@ -156,6 +219,58 @@ func UpdateCacheMb(memoryMB int64) error {
 	return nil
 }
 
+// CheckCompactPostings returns the error that CompactPostings would refuse to run with, so that
+// it can be reported before it's started in the background.
+func CheckCompactPostings(workers int, force bool) error {
+	if workers <= 0 {
+		return errors.Errorf("workers must be greater than zero, got %d", workers)
+	}
+	if !force && rates.writtenWithin(compactQuietPeriod) {
+		return errors.Errorf("edges were written to this alpha in the last %s, and compacting "+
+			"would stall the writes until it's done. Compact once the writes have stopped, or "+
+			"force it", compactQuietPeriod)
+	}
+	if atomic.LoadInt32(&compacting) == 1 {
+		return errors.Errorf("the postings are already being compacted")
+	}
+	return nil
+}
+
+// compactQuietPeriod is how long no edges must have been written to this alpha for
+// CompactPostings to run without being forced.
+const compactQuietPeriod = 30 * time.Second
+
+// CompactPostings compacts all the tables of the postings directory on this alpha into one
+// level, which reclaims the space taken by deleted and overwritten data, and then runs the value
+// log GC. It runs workers compactions at a time, so fewer workers leave more of the disk to
+// queries and mutations.
+//
+// Badger stops its own compactions while the tables are compacted into one level, so the writes
+// made meanwhile pile up in level 0 until it stalls them, which can last until the compaction is
+// done. So unless force is set, it refuses to run if edges have been written to this alpha in
+// the last compactQuietPeriod.
+func CompactPostings(workers int, force bool) error {
+	if err := CheckCompactPostings(workers, force); err != nil {
+		return err
+	}
+	if !atomic.CompareAndSwapInt32(&compacting, 0, 1) {
+		return errors.Errorf("the postings are already being compacted")
+	}
+	defer atomic.StoreInt32(&compacting, 0)
+
+	glog.Infof("Compacting the postings with %d workers", workers)
+	start := time.Now()
+	if err := pstore.Flatten(workers); err != nil {
+		return errors.Wrapf(err, "while compacting the postings")
+	}
+	for err := error(nil); err == nil; {
+		// If a GC is successful, immediately run it again.
+		err = pstore.RunValueLogGC(0.5)
+	}
+	glog.Infof("Compacted the postings in %s", time.Since(start).Round(time.Second))
+	return nil
+}
+
 // UpdateLogRequest updates value of x.WorkerConfig.LogRequest.
 func UpdateLogRequest(val bool) {
 	if val {
@@ -170,3 +285,49 @@ func UpdateLogRequest(val bool) {
 func LogRequestEnabled() bool {
 	return atomic.LoadInt32(&x.WorkerConfig.LogRequest) > 0
 }
+
+// UpdateLogVerbosity sets the verbosity of the logs, like the -v flag does at startup.
+func UpdateLogVerbosity(v int) error {
+	if v < 0 {
+		return errors.Errorf("log verbosity must be non-negative")
+	}
+	glog.Infof("Updating log verbosity to %d", v)
+	return flag.Lookup("v").Value.Set(strconv.Itoa(v))
+}
+
+// LogVerbosity returns the current verbosity of the logs.
+func LogVerbosity() int {
+	v, _ := strconv.Atoi(flag.Lookup("v").Value.String())
+	return v
+}
+
+// UpdateQueryTimeout sets the longest a query can run for. 0 means no limit.
+func UpdateQueryTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return errors.Errorf("query timeout must be non-negative")
+	}
+	glog.Infof("Updating query timeout to %s", timeout)
+	atomic.StoreInt64(&x.WorkerConfig.QueryTimeout, int64(timeout))
+	return nil
+}
+
+// QueryTimeout returns the longest a query can run for, or 0 if there's no limit.
+func QueryTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64(&x.WorkerConfig.QueryTimeout))
+}
+
+// UpdateMaxPendingQueries sets the most queries that can be pending at once. 0 means no limit.
+func UpdateMaxPendingQueries(limit int64) error {
+	if limit < 0 {
+		return errors.Errorf("max pending queries must be non-negative")
+	}
+	glog.Infof("Updating max pending queries to %d", limit)
+	atomic.StoreInt64(&x.WorkerConfig.MaxPendingQueries, limit)
+	return nil
+}
+
+// MaxPendingQueries returns the most queries that can be pending at once, or 0 if there's no
+// limit.
+func MaxPendingQueries() int64 {
+	return atomic.LoadInt64(&x.WorkerConfig.MaxPendingQueries)
+}
@@ -25,12 +25,14 @@ import (
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/badger/v2"
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	cindex "github.com/google/codesearch/index"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -62,7 +64,7 @@ func commitTransaction(t *testing.T, edge *pb.DirectedEdge, l *posting.List) {
 
 	txn.Update()
 	writer := posting.NewTxnWriter(pstore)
-	require.NoError(t, txn.CommitToDisk(writer, commit))
+	require.NoError(t, txn.CommitToDisk(writer, commit, 0))
 	require.NoError(t, writer.Flush())
 }
 
@@ -360,6 +362,74 @@ func TestProcessTaskIndex(t *testing.T) {
 	)
 }
 
+func TestCompactPostings(t *testing.T) {
+	require.Error(t, CompactPostings(0, false))
+
+	// Writes that are flowing are stalled by a compaction, so it's only done if forced.
+	rates.write("name")
+	require.True(t, rates.writtenWithin(compactQuietPeriod))
+	err := CompactPostings(1, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "edges were written to this alpha")
+
+	atomic.StoreInt32(&compacting, 1)
+	require.Error(t, CompactPostings(1, true))
+	atomic.StoreInt32(&compacting, 0)
+
+	require.NoError(t, CompactPostings(1, true))
+	require.Zero(t, atomic.LoadInt32(&compacting))
+
+	// Once the writes have stopped, it doesn't have to be forced.
+	atomic.StoreInt64(&rates.lastWrite, time.Now().Add(-2*compactQuietPeriod).UnixNano())
+	require.NoError(t, CheckCompactPostings(1, false))
+}
+
+func TestCountIndexPays(t *testing.T) {
+	// eq reads a single count, whatever the number of uids.
+	require.True(t, countIndexPays("eq", []int64{3}, 1))
+	// between reads a count for each one in its range, so it only pays for more uids than that.
+	require.False(t, countIndexPays(between, []int64{2, 5}, 4))
+	require.True(t, countIndexPays(between, []int64{2, 5}, 5))
+	// ge and gt read all the counts above theirs, which could be any number of them.
+	require.False(t, countIndexPays("ge", []int64{1}, 1000))
+	require.False(t, countIndexPays("gt", []int64{1}, 1000))
+}
+
+func TestFewCandidates(t *testing.T) {
+	query := &cindex.Query{
+		Op:      cindex.QAnd,
+		Trigram: []string{"abc", "bcd"},
+		Sub:     []*cindex.Query{{Op: cindex.QOr, Trigram: []string{"cde", "Cde"}}},
+	}
+	// Four trigrams are left to read from the start, and two from the subquery on.
+	require.True(t, fewCandidates(&pb.List{Uids: []uint64{1, 2, 3, 4}}, query, 0))
+	require.False(t, fewCandidates(&pb.List{Uids: []uint64{1, 2, 3, 4, 5}}, query, 0))
+	require.False(t, fewCandidates(&pb.List{Uids: []uint64{1, 2, 3}}, query, 2))
+	// The number of uids counts, not the size of the list once encoded.
+	require.True(t, fewCandidates(&pb.List{Uids: []uint64{math.MaxUint64}}, query, 2))
+}
+
+func TestTabletRatesSmoothed(t *testing.T) {
+	r := newTabletRates()
+	r.since = time.Now().Add(-time.Second)
+	for i := 0; i < 100; i++ {
+		r.read("name")
+	}
+	reads, _ := r.take()
+	require.InDelta(t, 50, reads["name"], 5)
+
+	// An idle predicate decays towards zero, and is dropped once it gets there.
+	r.since = time.Now().Add(-time.Second)
+	reads, _ = r.take()
+	require.InDelta(t, 25, reads["name"], 3)
+	for i := 0; i < 20; i++ {
+		r.since = time.Now().Add(-time.Second)
+		reads, _ = r.take()
+	}
+	require.NotContains(t, reads, "name")
+	require.Empty(t, r.smoothed)
+}
+
 func TestMain(m *testing.M) {
 	x.Init()
 	posting.Config.CommitFraction = 0.10
@@ -391,3 +461,21 @@ func TestMain(m *testing.M) {
 
 	os.Exit(m.Run())
 }
+
+func TestUpdateRuntimeConfig(t *testing.T) {
+	defer func(conf x.WorkerOptions) { x.WorkerConfig = conf }(x.WorkerConfig)
+	defer func(v int) { require.NoError(t, UpdateLogVerbosity(v)) }(LogVerbosity())
+
+	require.NoError(t, UpdateLogVerbosity(3))
+	require.Equal(t, 3, LogVerbosity())
+	require.EqualError(t, UpdateLogVerbosity(-1), "log verbosity must be non-negative")
+
+	require.NoError(t, UpdateQueryTimeout(30*time.Second))
+	require.Equal(t, 30*time.Second, QueryTimeout())
+	require.EqualError(t, UpdateQueryTimeout(-time.Second),
+		"query timeout must be non-negative")
+
+	require.NoError(t, UpdateMaxPendingQueries(100))
+	require.Equal(t, int64(100), MaxPendingQueries())
+	require.EqualError(t, UpdateMaxPendingQueries(-1), "max pending queries must be non-negative")
+}
@@ -19,6 +19,7 @@ package x
 import (
 	"crypto/tls"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/spf13/viper"
@@ -44,11 +45,65 @@ type Options struct {
 	GraphqlDebug bool
 	// GraphqlLambdaUrl stores the URL of lambda functions for custom GraphQL resolvers
 	GraphqlLambdaUrl string
+	// GraphqlSchemaWebhooks are the URLs that are sent the old and new GraphQL schemas, and the
+	// diff between them, whenever updateGQLSchema succeeds.
+	GraphqlSchemaWebhooks []string
+	// GraphqlMaxDepth is the maximum depth of fields allowed in a GraphQL query. 0 means no limit.
+	GraphqlMaxDepth int
+	// GraphqlMaxRootFields is the maximum number of root fields allowed in a GraphQL operation.
+	// 0 means no limit.
+	GraphqlMaxRootFields int
+	// GraphqlMaxCost is the maximum estimated cost allowed for a GraphQL query. 0 means no limit.
+	GraphqlMaxCost uint64
+	// GraphqlTimeout is the longest a request to /graphql can take, after which its queries and
+	// mutations are cancelled. 0 means no limit, though the X-Dgraph-Timeout header of a request
+	// can still give one.
+	GraphqlTimeout time.Duration
+	// GraphqlSlowQueryThreshold is how long a GraphQL request can take before it's logged as a
+	// slow request. 0 means no request is logged.
+	GraphqlSlowQueryThreshold time.Duration
+	// GraphqlSlowQueryLogLiterals tells whether slow requests are logged with the literals of
+	// their query and DQL, instead of having them redacted.
+	GraphqlSlowQueryLogLiterals bool
+	// GraphqlRateLimit is how many requests per second each client can send to /graphql.
+	// 0 means no limit.
+	GraphqlRateLimit float64
+	// GraphqlRateBurst is how many requests a client can send to /graphql at once, before
+	// GraphqlRateLimit applies. 0 means GraphqlRateLimit rounded up.
+	GraphqlRateBurst int
+	// AdminCorsOrigins are the origins allowed to make CORS requests to /admin. Empty means any
+	// origin is allowed.
+	AdminCorsOrigins map[string]struct{}
+	// CorsAllowedMethods is the Access-Control-Allow-Methods returned by /graphql and /admin.
+	// Empty means the default of "POST, OPTIONS".
+	CorsAllowedMethods string
+	// CorsAllowedHeaders is the Access-Control-Allow-Headers returned by /graphql and /admin,
+	// before the headers forwarded by the GraphQL schema are added. Empty means the default of
+	// AccessControlAllowedHeaders.
+	CorsAllowedHeaders string
 }
 
 // Config stores the global instance of this package's options.
 var Config Options
 
+// lambdaUrlLock guards Config.GraphqlLambdaUrl, which the registerLambda admin mutation can change
+// while GraphQL schemas are being built.
+var lambdaUrlLock sync.RWMutex
+
+// LambdaUrl returns the URL of the lambda server.
+func LambdaUrl() string {
+	lambdaUrlLock.RLock()
+	defer lambdaUrlLock.RUnlock()
+	return Config.GraphqlLambdaUrl
+}
+
+// SetLambdaUrl changes the URL of the lambda server.
+func SetLambdaUrl(url string) {
+	lambdaUrlLock.Lock()
+	defer lambdaUrlLock.Unlock()
+	Config.GraphqlLambdaUrl = url
+}
+
 // IPRange represents an IP range.
 type IPRange struct {
 	Lower, Upper net.IP
@@ -59,16 +114,36 @@ type IPRange struct {
 type WorkerOptions struct {
 	// TmpDir is a directory to store temporary buffers.
 	TmpDir string
+	// InMemory keeps the postings and the write-ahead log in memory only, so that nothing is
+	// written to disk. They're lost when the process exits.
+	InMemory bool
 	// ExportPath indicates the folder to which exported data will be saved.
 	ExportPath string
 	// NumPendingProposals indicates the maximum number of pending mutation proposals.
 	NumPendingProposals int
+	// QueuedProposals is the most mutation proposals that can wait for one of the
+	// NumPendingProposals slots. Mutations beyond that are rejected. 0 means no limit.
+	QueuedProposals int
+	// MaxApplyBacklog is the most bytes of committed Raft entries that can wait to be applied
+	// before new mutations are rejected. 0 means no limit.
+	MaxApplyBacklog int64
+	// ProposalBatchSize is the most bytes of mutation proposals that are batched into one Raft
+	// entry. 0 means that they aren't batched.
+	ProposalBatchSize int
+	// ProposalBatchLatency is the longest a mutation proposal waits for others to be batched with.
+	ProposalBatchLatency time.Duration
+	// SnapshotBandwidth is the most bytes per second this alpha sends snapshots at, to all the
+	// followers. 0 means no limit.
+	SnapshotBandwidth float64
 	// Tracing tells Dgraph to only sample a percentage of the traces equal to its value.
 	// The value of this option must be between 0 and 1.
 	// TODO: Get rid of this here.
 	Tracing float64
 	// MyAddr stores the address and port for this alpha.
 	MyAddr string
+	// Zone is the failure domain of this alpha, like a zone or a rack. Zero doesn't put a quorum
+	// of the replicas of a group in one zone.
+	Zone string
 	// ZeroAddr stores the list of address:port for the zero instances associated with this alpha.
 	// Alpha would communicate via only one zero address from the list. All
 	// the other addresses serve as fallback.
@@ -109,24 +184,95 @@ type WorkerOptions struct {
 	// queries hence it has been kept as int32. LogRequest value 1 enables logging of requests
 	// coming to alphas and 0 disables it.
 	LogRequest int32
+	// QueryTimeout is the longest a query can run for, in nanoseconds. 0 means no limit. Like
+	// LogRequest, it can be changed at runtime, so it's accessed with atomics.
+	QueryTimeout int64
+	// MaxPendingQueries is the most queries that can be pending at once. Queries that come in
+	// while there are as many pending are rejected. 0 means no limit. It's accessed with atomics.
+	MaxPendingQueries int64
 	// If true, we should call msync or fsync after every write to survive hard reboots.
 	HardSync bool
+	// SyncInterval is how often the write-ahead log, and on Alpha the postings, are synced to disk
+	// when HardSync isn't set. 0 means that it's left to the OS.
+	SyncInterval time.Duration
+	// Raft holds the parameters of the Raft nodes.
+	Raft RaftOptions
+	// MaxClockSkew is how far the clocks of the nodes can be off from each other before their
+	// health is degraded. 0 means no limit.
+	MaxClockSkew time.Duration
+}
+
+// RaftOptions are the parameters of the Raft nodes of Alpha and Zero, which can be raised for
+// deployments with a high latency between the nodes.
+type RaftOptions struct {
+	// TickInterval is how often the Raft nodes tick. The timeouts below are counted in ticks.
+	TickInterval time.Duration
+	// ElectionTicks is how long a follower waits without hearing from the leader before it
+	// starts an election.
+	ElectionTicks int
+	// HeartbeatTicks is how often the leader sends heartbeats to its followers.
+	HeartbeatTicks int
+	// MaxInflightMsgs is the most append messages that the leader can have in flight to a
+	// follower.
+	MaxInflightMsgs int
+}
+
+// DefaultRaftOptions are the Raft parameters used unless flags say otherwise: a 2s election
+// timeout and 100ms heartbeats.
+var DefaultRaftOptions = RaftOptions{
+	TickInterval:    100 * time.Millisecond,
+	ElectionTicks:   20,
+	HeartbeatTicks:  1,
+	MaxInflightMsgs: 256,
 }
 
 // WorkerConfig stores the global instance of the worker package's options.
-var WorkerConfig WorkerOptions
+var WorkerConfig = WorkerOptions{Raft: DefaultRaftOptions}
 
 func (w *WorkerOptions) Parse(conf *viper.Viper) {
 	w.MyAddr = conf.GetString("my")
 	w.Tracing = conf.GetFloat64("trace")
 
-	if w.LudicrousMode {
-		w.HardSync = false
+	survive := conf.GetString("survive")
+	AssertTruef(survive == "process" || survive == "filesystem",
+		"Invalid survival mode: %s", survive)
+	sync := conf.GetString("sync")
+	switch {
+	case sync == "" && survive == "filesystem":
+		sync = "write"
+	case sync == "":
+		sync = "periodic"
+	default:
+		AssertTruef(survive == "process" || sync == "write",
+			"--sync=%s can't be used with --survive=filesystem", sync)
+	}
+	if w.LudicrousMode && sync == "write" {
+		sync = "periodic"
+	}
+
+	switch sync {
+	case "write":
+		w.HardSync = true
+	case "periodic":
+		w.SyncInterval = conf.GetDuration("sync_interval")
+		AssertTruef(w.SyncInterval > 0, "Invalid sync interval: %s", w.SyncInterval)
+	case "none":
+	default:
+		AssertTruef(false, "Invalid sync mode: %s", sync)
+	}
 
-	} else {
-		survive := conf.GetString("survive")
-		AssertTruef(survive == "process" || survive == "filesystem",
-			"Invalid survival mode: %s", survive)
-		w.HardSync = survive == "filesystem"
+	w.Raft = RaftOptions{
+		TickInterval:    conf.GetDuration("raft_tick"),
+		ElectionTicks:   conf.GetInt("raft_election_ticks"),
+		HeartbeatTicks:  conf.GetInt("raft_heartbeat_ticks"),
+		MaxInflightMsgs: conf.GetInt("raft_max_inflight"),
 	}
+	AssertTruef(w.Raft.TickInterval > 0, "Invalid Raft tick: %s", w.Raft.TickInterval)
+	AssertTruef(w.Raft.HeartbeatTicks > 0, "Invalid Raft heartbeat ticks: %d",
+		w.Raft.HeartbeatTicks)
+	AssertTruef(w.Raft.ElectionTicks > w.Raft.HeartbeatTicks,
+		"--raft_election_ticks must be greater than --raft_heartbeat_ticks")
+	AssertTruef(w.Raft.MaxInflightMsgs > 0, "Invalid Raft max inflight: %d",
+		w.Raft.MaxInflightMsgs)
+	w.MaxClockSkew = conf.GetDuration("max_clock_skew")
 }
@@ -16,7 +16,11 @@
 
 package x
 
-import "github.com/spf13/pflag"
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
 
 // FillCommonFlags stores flags common to Alpha and Zero.
 func FillCommonFlags(flag *pflag.FlagSet) {
@@ -39,6 +43,34 @@ func FillCommonFlags(flag *pflag.FlagSet) {
 		blocking sync would be called after every write, hence guaranteeing no data loss in case
 		of hard reboot. Most users should be OK with choosing "process".
 		`)
+	flag.String("sync", "",
+		`Choose between "write", "periodic" or "none". It sets when the write-ahead log, and on
+		Alpha the postings, are synced to disk. "write" syncs them after every write, "periodic"
+		every --sync_interval, and "none" leaves it to the OS. Writes survive process crashes
+		with all of them, but only "write" guarantees that they survive a hard reboot, at the
+		cost of a higher write latency on slow or network disks. Defaults to "write" with
+		--survive=filesystem, and to "periodic" otherwise.
+		`)
+	flag.Duration("sync_interval", time.Minute,
+		"How often the write-ahead log, and on Alpha the postings, are synced to disk with "+
+			"--sync=periodic.")
+
+	// Raft flags.
+	flag.Duration("raft_tick", DefaultRaftOptions.TickInterval,
+		"How often the Raft nodes tick. The election and heartbeat timeouts are counted in ticks.")
+	flag.Int("raft_election_ticks", DefaultRaftOptions.ElectionTicks,
+		"The number of ticks a follower waits without hearing from the leader before it starts "+
+			"an election. Raise it when the latency between the nodes causes spurious elections.")
+	flag.Int("raft_heartbeat_ticks", DefaultRaftOptions.HeartbeatTicks,
+		"The number of ticks between the heartbeats that the leader sends to its followers. It "+
+			"must be lower than --raft_election_ticks.")
+	flag.Int("raft_max_inflight", DefaultRaftOptions.MaxInflightMsgs,
+		"The most append messages the leader can have in flight to a follower. Raise it on "+
+			"links with a high latency, to replicate faster.")
+
+	flag.Duration("max_clock_skew", 0,
+		"How far the clock of a node this node talks to can be off from its own before this "+
+			"node reports the skew. 0 turns the check off.")
 
 	// Cache flags.
 	flag.Int64("cache_mb", 1024, "Total size of cache (in MB) to be used in Dgraph.")
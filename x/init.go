@@ -112,6 +112,22 @@ func Version() string {
 	return dgraphVersion
 }
 
+// The versions of the protocol between alphas and zeros. Each one adds features that the nodes of
+// older builds don't understand, so a node only uses them once the nodes it talks to have been
+// upgraded. Nodes send their version in their heartbeats. The nodes of builds from before these
+// versions send none, which is version 0.
+const (
+	// ProtocolBatchedProposals adds Raft proposals that batch several mutations.
+	ProtocolBatchedProposals uint32 = iota + 1
+	// ProtocolTransferLeadership adds the TransferLeadership RPC of the alphas.
+	ProtocolTransferLeadership
+	// ProtocolTakeLeadership adds the TakeLeadership RPC of the alphas.
+	ProtocolTakeLeadership
+
+	// ProtocolVersion is the version of the protocol of this build.
+	ProtocolVersion = ProtocolTakeLeadership
+)
+
 // pattern for  dev version = min. 7 hex digits of commit-hash.
 var versionRe *regexp.Regexp = regexp.MustCompile(`-g[[:xdigit:]]{7,}`)
 
@@ -96,12 +96,32 @@ var (
 		"Number of proposals in Raft apply channel", stats.UnitDimensionless)
 	RaftPendingSize = stats.Int64("pending_proposal_bytes",
 		"Size of Raft pending proposal", stats.UnitBytes)
+	// RaftProposalBatchSize records the number of proposals batched into one Raft entry.
+	RaftProposalBatchSize = stats.Int64("raft_proposal_batch_size",
+		"Number of proposals batched into one Raft entry", stats.UnitDimensionless)
 	// MaxAssignedTs records the latest max assigned timestamp.
 	MaxAssignedTs = stats.Int64("max_assigned_ts",
 		"Latest max assigned timestamp", stats.UnitDimensionless)
 	// TxnAborts records count of aborted transactions.
 	TxnAborts = stats.Int64("txn_aborts_total",
 		"Number of transaction aborts", stats.UnitDimensionless)
+	// NumGraphQLRateLimited records the count of GraphQL requests rejected by the rate limit.
+	NumGraphQLRateLimited = stats.Int64("num_graphql_rate_limited_total",
+		"Number of GraphQL requests rejected by the rate limit", stats.UnitDimensionless)
+	// NumGraphQLFieldRequests records the count of root fields of GraphQL requests resolved.
+	NumGraphQLFieldRequests = stats.Int64("num_graphql_field_requests_total",
+		"Number of GraphQL root fields resolved", stats.UnitDimensionless)
+	// NumGraphQLFieldErrors records the count of root fields of GraphQL requests that resolved
+	// with errors.
+	NumGraphQLFieldErrors = stats.Int64("num_graphql_field_errors_total",
+		"Number of GraphQL root fields resolved with errors", stats.UnitDimensionless)
+	// GraphQLFieldLatencyMs is the latency of resolving root fields of GraphQL requests.
+	GraphQLFieldLatencyMs = stats.Float64("graphql_field_latency",
+		"Latency of resolving GraphQL root fields", stats.UnitMilliseconds)
+	// GraphQLFieldDQLLatencyMs is the latency of the DQL run for root fields of GraphQL
+	// requests.
+	GraphQLFieldDQLLatencyMs = stats.Float64("graphql_field_dql_latency",
+		"Latency of the DQL run for GraphQL root fields", stats.UnitMilliseconds)
 	// PBlockHitRatio records the hit ratio of posting store block cache.
 	PBlockHitRatio = stats.Float64("hit_ratio_postings_block",
 		"Hit ratio of p store block cache", stats.UnitDimensionless)
@@ -122,6 +142,11 @@ var (
 	KeyStatus, _ = tag.NewKey("status")
 	// KeyMethod is the tag key used to record the method (e.g read or mutate).
 	KeyMethod, _ = tag.NewKey("method")
+	// KeyGraphQLOperation is the tag key used to record the type of a GraphQL operation. It's
+	// not the name, which clients choose freely and so could make any number of series.
+	KeyGraphQLOperation, _ = tag.NewKey("graphql_operation")
+	// KeyGraphQLField is the tag key used to record the root field of a GraphQL operation.
+	KeyGraphQLField, _ = tag.NewKey("graphql_field")
 
 	// Tag values.
 
@@ -141,6 +166,11 @@ var (
 		KeyStatus, KeyMethod,
 	}
 
+	// graphQLFieldTagKeys are the tags of the metrics of GraphQL root fields.
+	graphQLFieldTagKeys = []tag.Key{
+		KeyGraphQLOperation, KeyGraphQLField,
+	}
+
 	allViews = []*view.View{
 		{
 			Name:        LatencyMs.Name(),
@@ -184,6 +214,13 @@ var (
 			Aggregation: view.LastValue(),
 			TagKeys:     allTagKeys,
 		},
+		{
+			Name:        RaftProposalBatchSize.Name(),
+			Measure:     RaftProposalBatchSize,
+			Description: RaftProposalBatchSize.Description(),
+			Aggregation: view.Distribution(2, 4, 8, 16, 32, 64, 128, 256, 512, 1024),
+			TagKeys:     allTagKeys,
+		},
 		{
 			Name:        MaxAssignedTs.Name(),
 			Measure:     MaxAssignedTs,
@@ -198,6 +235,41 @@ var (
 			Aggregation: view.Count(),
 			TagKeys:     allTagKeys,
 		},
+		{
+			Name:        NumGraphQLRateLimited.Name(),
+			Measure:     NumGraphQLRateLimited,
+			Description: NumGraphQLRateLimited.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     allTagKeys,
+		},
+		{
+			Name:        NumGraphQLFieldRequests.Name(),
+			Measure:     NumGraphQLFieldRequests,
+			Description: NumGraphQLFieldRequests.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     graphQLFieldTagKeys,
+		},
+		{
+			Name:        NumGraphQLFieldErrors.Name(),
+			Measure:     NumGraphQLFieldErrors,
+			Description: NumGraphQLFieldErrors.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     graphQLFieldTagKeys,
+		},
+		{
+			Name:        GraphQLFieldLatencyMs.Name(),
+			Measure:     GraphQLFieldLatencyMs,
+			Description: GraphQLFieldLatencyMs.Description(),
+			Aggregation: defaultLatencyMsDistribution,
+			TagKeys:     graphQLFieldTagKeys,
+		},
+		{
+			Name:        GraphQLFieldDQLLatencyMs.Name(),
+			Measure:     GraphQLFieldDQLLatencyMs,
+			Description: GraphQLFieldDQLLatencyMs.Description(),
+			Aggregation: defaultLatencyMsDistribution,
+			TagKeys:     graphQLFieldTagKeys,
+		},
 
 		// Last value aggregations
 		{
@@ -123,7 +123,8 @@ const (
 	// bulk load.
 	GroupIdFileName = "group_id"
 
-	AccessControlAllowedHeaders = "X-Dgraph-AccessToken, " +
+	AccessControlAllowedHeaders = "X-Dgraph-AccessToken, X-Dgraph-Timeout, " +
+		"X-Dgraph-BestEffort, X-Dgraph-ReadOnly, X-Dgraph-Query-Timeout, " +
 		"Content-Type, Content-Length, Accept-Encoding, Cache-Control, " +
 		"X-CSRF-Token, X-Auth-Token, X-Requested-With"
 	DgraphCostHeader = "Dgraph-TouchedUids"
@@ -335,6 +336,41 @@ func AddCorsHeaders(w http.ResponseWriter) {
 	w.Header().Set("Connection", "close")
 }
 
+// CorsAllowedMethods returns the methods that /graphql and /admin allow CORS requests to use.
+func CorsAllowedMethods() string {
+	if Config.CorsAllowedMethods == "" {
+		return "POST, OPTIONS"
+	}
+	return Config.CorsAllowedMethods
+}
+
+// CorsAllowedHeaders returns the headers that /graphql and /admin allow CORS requests to send,
+// not counting the ones forwarded by the GraphQL schema.
+func CorsAllowedHeaders() string {
+	if Config.CorsAllowedHeaders == "" {
+		return AccessControlAllowedHeaders
+	}
+	return Config.CorsAllowedHeaders
+}
+
+// AddAdminCorsHeaders adds the CORS headers to a response from an /admin endpoint, for a
+// request from the given origin. If the origin isn't one of Config.AdminCorsOrigins, no CORS
+// headers are added, so browsers won't let pages from that origin read the response.
+func AddAdminCorsHeaders(w http.ResponseWriter, origin string) {
+	w.Header().Set("Connection", "close")
+	if len(Config.AdminCorsOrigins) == 0 {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else if _, ok := Config.AdminCorsOrigins[origin]; ok {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+	} else {
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Methods", CorsAllowedMethods())
+	w.Header().Set("Access-Control-Allow-Headers", CorsAllowedHeaders())
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+}
+
 // QueryResWithData represents a response that holds errors as well as data.
 type QueryResWithData struct {
 	Errors GqlErrorList `json:"errors"`
@@ -1057,8 +1093,9 @@ type DB interface {
 func StoreSync(db DB, closer *z.Closer) {
 	defer closer.Done()
 	// We technically don't need to call this due to mmap being able to survive process crashes.
-	// But, once a minute is infrequent enough that we won't lose any performance due to this.
-	ticker := time.NewTicker(time.Minute)
+	// But, once a minute (the default --sync_interval) is infrequent enough that we won't lose
+	// any performance due to this.
+	ticker := time.NewTicker(WorkerConfig.SyncInterval)
 	for {
 		select {
 		case <-ticker.C:
@@ -1176,6 +1213,19 @@ func ParseCompression(cStr string) (bo.CompressionType, int) {
 	return 0, 0
 }
 
+// ParseStorageMode returns whether the data has to be kept in memory only, given the value of
+// the badger.mode flag. It's either disk or memory.
+func ParseStorageMode(mode string) bool {
+	switch mode {
+	case "disk":
+		return false
+	case "memory":
+		return true
+	}
+	glog.Fatalf("ERROR: badger.mode (%s) invalid, it must be disk or memory", mode)
+	return false
+}
+
 // ToHex converts a uint64 to a hex byte array. If rdf is true it will
 // use < > brackets to delimit the value. Otherwise it will use quotes
 // like JSON requires.
@@ -19,6 +19,7 @@ package x
 import (
 	"fmt"
 	"math"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -197,3 +198,27 @@ func TestToHex(t *testing.T) {
 	require.Equal(t, []byte(`"0xffffffffffffffff"`), ToHex(math.MaxUint64, false))
 	require.Equal(t, []byte(`<0xffffffffffffffff>`), ToHex(math.MaxUint64, true))
 }
+
+func TestAddAdminCorsHeaders(t *testing.T) {
+	defer func(conf Options) { Config = conf }(Config)
+
+	w := httptest.NewRecorder()
+	AddAdminCorsHeaders(w, "https://example.com")
+	require.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "POST, OPTIONS", w.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, AccessControlAllowedHeaders, w.Header().Get("Access-Control-Allow-Headers"))
+
+	Config.AdminCorsOrigins = map[string]struct{}{"https://example.com": {}}
+	Config.CorsAllowedMethods = "POST"
+	Config.CorsAllowedHeaders = "Content-Type"
+	w = httptest.NewRecorder()
+	AddAdminCorsHeaders(w, "https://example.com")
+	require.Equal(t, "https://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "POST", w.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "Content-Type", w.Header().Get("Access-Control-Allow-Headers"))
+
+	w = httptest.NewRecorder()
+	AddAdminCorsHeaders(w, "https://evil.com")
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+}